@@ -1,9 +1,13 @@
 package main
 
 import (
+	"backend/internal/blobstore"
 	"backend/internal/config"
 	"backend/internal/embedding_client"
 	"backend/internal/handler"
+	"backend/internal/mailer"
+	"backend/internal/oidc_client"
+	"backend/internal/ratelimit"
 	"backend/internal/repository"
 	"backend/internal/server"
 	"backend/internal/service"
@@ -58,17 +62,72 @@ func main() {
 
 	embeddingClient := embedding_client.NewClient(cfg.Embedding.GetUrl())
 
+	var oidcClient *oidc_client.Client
+	if cfg.OIDC.Enabled {
+		oidcClient, err = oidc_client.NewClient(
+			ctx,
+			cfg.OIDC.Issuer,
+			cfg.OIDC.ClientID,
+			cfg.OIDC.ClientSecret,
+			cfg.OIDC.RedirectURL,
+			cfg.OIDC.Scopes,
+		)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to init oidc client")
+		}
+	}
+
+	mailSender, err := mailer.New(cfg.Mail, &log)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to init mailer")
+	}
+
+	rateLimitStore, err := ratelimit.NewStore(cfg.RateLimit, repo)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to init rate limit store")
+	}
+	limiter := ratelimit.New(rateLimitStore, cfg.RateLimit, &log)
+	go limiter.RunCleanup(ctx)
+
+	blobStore, err := blobstore.New(ctx, cfg.Blob)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to init blob store")
+	}
+
 	service := service.New(
 		repo,
 		tokenAuth,
 		embeddingClient,
+		oidcClient,
+		mailSender,
+		cfg.Mail,
+		limiter,
+		cfg.TwoFactor,
+		cfg.Quota,
+		blobStore,
+		cfg.Upload,
+		cfg.Trash,
+		cfg.EmbeddingCounters,
+		cfg.Webhook,
 		&log,
 	)
 
+	go service.RunUploadCleanup(ctx)
+	go service.RunTrashPurge(ctx)
+	go service.RunEmbeddingCountersReconcile(ctx)
+	go service.RunDocumentEvents(ctx)
+	go service.RunWebhookDelivery(ctx)
+	go service.RunWebhookMaintenance(ctx)
+
+	if err := service.BootstrapAdmins(ctx, cfg.Admin.Emails); err != nil {
+		log.Fatal().Err(err).Msg("failed to bootstrap admins")
+	}
+
 	handler := handler.NewHandler(
 		cfg.Handler,
 		service,
 		tokenAuth,
+		limiter,
 		&log,
 	).Init()
 
@@ -0,0 +1,74 @@
+// Webhook-receiver — локальный получатель вебхуков для ручной проверки доставки.
+// Он проверяет подпись каждого запроса, выводит событие в лог и отвечает 200;
+// с флагом -fail отвечает 500, чтобы проверить повторные попытки.
+package main
+
+import (
+	"backend/pkg/logger"
+	"backend/pkg/webhooksig"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const maxBodySize = 1 << 20
+
+func main() {
+	var addr string
+	var secret string
+	var tolerance time.Duration
+	var fail bool
+
+	flag.StringVar(&addr, "addr", ":9090", "listen address")
+	flag.StringVar(&secret, "secret", "", "webhook secret; signatures are not checked when empty")
+	flag.DurationVar(&tolerance, "tolerance", 5*time.Minute, "allowed signature timestamp skew")
+	flag.BoolVar(&fail, "fail", false, "respond with 500 to every delivery")
+
+	flag.Parse()
+
+	log := logger.NewConsole(zerolog.DebugLevel)
+
+	http.HandleFunc("POST /", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		event := log.Info().
+			Str("event", r.Header.Get(webhooksig.EventHeader)).
+			Str("event_id", r.Header.Get(webhooksig.EventIDHeader)).
+			Str("delivery_id", r.Header.Get(webhooksig.DeliveryHeader))
+
+		if secret != "" {
+			err := webhooksig.Verify(secret, r.Header.Get(webhooksig.TimestampHeader), r.Header.Get(webhooksig.SignatureHeader), body, tolerance, time.Now())
+			if err != nil {
+				log.Warn().Err(err).Str("delivery_id", r.Header.Get(webhooksig.DeliveryHeader)).Msg("Подпись вебхука не прошла проверку")
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		if json.Valid(body) {
+			event = event.RawJSON("payload", body)
+		} else {
+			event = event.Bytes("payload", body)
+		}
+		event.Msg("Получен вебхук")
+
+		if fail {
+			http.Error(w, "delivery rejected by -fail", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	log.Info().Str("addr", addr).Bool("verify_signature", secret != "").Bool("fail", fail).Msg("Получатель вебхуков запущен")
+	if err := http.ListenAndServe(addr, nil); err != nil {
+		log.Fatal().Err(err).Msg("webhook receiver stopped")
+	}
+}
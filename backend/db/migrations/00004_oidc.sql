@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users alter column password_hash drop not null;
+
+create table user_identities (
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    issuer text not null,
+    subject text not null,
+    email varchar(255),
+    created_at timestamptz not null default now(),
+    unique (issuer, subject)
+);
+create index if not exists user_identities_user_id_idx on user_identities (user_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_identities;
+
+update users set password_hash = '' where password_hash is null;
+alter table users alter column password_hash set not null;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column email_verified boolean not null default false;
+
+create table user_tokens (
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    purpose text not null check (purpose in ('email_verification', 'password_reset')),
+    token_hash text unique not null,
+    expires_at timestamptz not null,
+    used_at timestamptz,
+    created_at timestamptz not null default now()
+);
+create index if not exists user_tokens_user_id_purpose_idx on user_tokens (user_id, purpose);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_tokens;
+
+alter table users drop column if exists email_verified;
+-- +goose StatementEnd
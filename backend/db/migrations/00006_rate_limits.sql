@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create unlogged table rate_limits (
+    key text primary key,
+    count bigint not null,
+    expires_at timestamptz not null
+);
+create index if not exists rate_limits_expires_at_idx on rate_limits (expires_at);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists rate_limits;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+create table user_totp (
+    user_id bigint primary key references users(id) on delete cascade,
+    secret_encrypted text not null,
+    enabled_at timestamptz,
+    last_used_step bigint not null default 0,
+    created_at timestamptz not null default now()
+);
+
+create table user_recovery_codes (
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    code_hash text not null,
+    used_at timestamptz,
+    unique (user_id, code_hash)
+);
+
+create table login_challenges (
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    token_hash text unique not null,
+    attempts int not null default 0,
+    expires_at timestamptz not null
+);
+create index if not exists login_challenges_user_id_idx on login_challenges (user_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists login_challenges;
+
+drop table if exists user_recovery_codes;
+
+drop table if exists user_totp;
+-- +goose StatementEnd
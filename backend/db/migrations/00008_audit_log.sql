@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table audit_log (
+    id bigserial primary key,
+    user_id bigint,
+    action text not null,
+    details jsonb not null default '{}',
+    created_at timestamptz not null default now()
+);
+create index if not exists audit_log_user_id_idx on audit_log (user_id);
+create index if not exists audit_log_created_at_idx on audit_log (created_at);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users add column role text not null default 'user' check (role in ('user', 'admin'));
+alter table users add column disabled_at timestamptz;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users drop column if exists disabled_at;
+alter table users drop column if exists role;
+-- +goose StatementEnd
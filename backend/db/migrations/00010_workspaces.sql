@@ -0,0 +1,93 @@
+-- +goose Up
+-- +goose StatementBegin
+create table workspaces (
+    id bigserial primary key,
+    name text not null,
+    personal_user_id bigint unique references users(id) on delete cascade,
+    created_at timestamptz not null default now()
+);
+
+create table workspace_members (
+    workspace_id bigint not null references workspaces(id) on delete cascade,
+    user_id bigint not null references users(id) on delete cascade,
+    role text not null check (role in ('owner', 'editor', 'viewer')),
+    created_at timestamptz not null default now(),
+    primary key (workspace_id, user_id)
+);
+create index if not exists workspace_members_user_id_idx on workspace_members (user_id);
+
+insert into workspaces (name, personal_user_id)
+select 'Personal', u.id
+from users u;
+
+insert into workspace_members (workspace_id, user_id, role)
+select w.id, w.personal_user_id, 'owner'
+from workspaces w;
+
+alter table documents add column workspace_id bigint references workspaces(id) on delete cascade;
+update documents d
+set workspace_id = w.id
+from workspaces w
+where w.personal_user_id = d.user_id;
+alter table documents alter column workspace_id set not null;
+create index if not exists documents_workspace_id_idx on documents (workspace_id);
+
+alter table chunks add column workspace_id bigint references workspaces(id) on delete cascade;
+update chunks c
+set workspace_id = d.workspace_id
+from documents d
+where d.id = c.document_id;
+alter table chunks alter column workspace_id set not null;
+create index if not exists chunks_workspace_id_idx on chunks (workspace_id);
+
+-- Документы и чанки принадлежат рабочему пространству, а user_id лишь указывает, кто их загрузил.
+-- При удалении аккаунта документы общих пространств должны остаться, поэтому ссылка обнуляется.
+alter table documents alter column user_id drop not null;
+alter table documents drop constraint if exists documents_user_id_fkey;
+alter table documents add constraint documents_user_id_fkey
+    foreign key (user_id) references users(id) on delete set null;
+
+alter table chunks alter column user_id drop not null;
+alter table chunks drop constraint if exists chunks_user_id_fkey;
+alter table chunks add constraint chunks_user_id_fkey
+    foreign key (user_id) references users(id) on delete set null;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+-- Документы удаленных пользователей переходят к одному из владельцев пространства.
+update documents d
+set user_id = (
+    select m.user_id
+    from workspace_members m
+    where m.workspace_id = d.workspace_id and m.role = 'owner'
+    order by m.created_at, m.user_id
+    limit 1
+)
+where d.user_id is null;
+delete from documents where user_id is null;
+
+update chunks c
+set user_id = d.user_id
+from documents d
+where d.id = c.document_id and c.user_id is null;
+
+alter table chunks drop constraint if exists chunks_user_id_fkey;
+alter table chunks add constraint chunks_user_id_fkey
+    foreign key (user_id) references users(id) on delete cascade;
+alter table chunks alter column user_id set not null;
+
+alter table documents drop constraint if exists documents_user_id_fkey;
+alter table documents add constraint documents_user_id_fkey
+    foreign key (user_id) references users(id) on delete cascade;
+alter table documents alter column user_id set not null;
+
+alter table chunks drop column if exists workspace_id;
+
+alter table documents drop column if exists workspace_id;
+
+drop table if exists workspace_members;
+
+drop table if exists workspaces;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table document_shares (
+    document_id bigint not null references documents(id) on delete cascade,
+    user_id bigint not null references users(id) on delete cascade,
+    permission text not null check (permission in ('read', 'write')),
+    granted_by bigint references users(id) on delete set null,
+    created_at timestamptz not null default now(),
+    primary key (document_id, user_id)
+);
+create index if not exists document_shares_user_id_idx on document_shares (user_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists document_shares;
+-- +goose StatementEnd
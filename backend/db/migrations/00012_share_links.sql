@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table share_links (
+    id bigserial primary key,
+    document_id bigint not null references documents(id) on delete cascade,
+    created_by bigint references users(id) on delete set null,
+    token_hash text not null unique,
+    expires_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz not null default now()
+);
+create index if not exists share_links_document_id_idx on share_links (document_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists share_links;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table documents add column size_bytes bigint not null default 0;
+
+-- Для уже загруженных документов исходный размер неизвестен, оцениваем его по тексту чанков.
+update documents d
+set size_bytes = coalesce((
+    select sum(octet_length(c.text)) from chunks c where c.document_id = d.id
+), 0);
+
+create table search_usage (
+    user_id bigint not null references users(id) on delete cascade,
+    day date not null,
+    count integer not null default 0,
+    primary key (user_id, day)
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists search_usage;
+
+alter table documents drop column if exists size_bytes;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create table blobs (
+    id bigserial primary key,
+    storage_key text not null unique,
+    sha256 text not null,
+    size_bytes bigint not null,
+    content_type text not null,
+    created_at timestamptz not null default now()
+);
+
+alter table documents add column blob_id bigint references blobs(id) on delete set null;
+create index if not exists documents_blob_id_idx on documents (blob_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+alter table documents drop column if exists blob_id;
+
+drop table if exists blobs;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Данные tus-загрузок хранятся частями в хранилище блобов, а не на диске одного узла,
+-- чтобы PATCH-запросы одной загрузки могли приходить на разные реплики.
+-- Писать в загрузку может только один запрос: он берет аренду (lock_token, locked_until) и продлевает ее, пока принимает данные.
+create table uploads (
+    id text primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    workspace_id bigint not null references workspaces(id) on delete cascade,
+    filename text not null,
+    content_type text not null,
+    upload_length bigint not null,
+    upload_offset bigint not null default 0,
+    document_id bigint references documents(id) on delete set null,
+    expires_at timestamptz not null,
+    lock_token text,
+    locked_until timestamptz,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists uploads_expires_at_idx on uploads (expires_at);
+
+-- Внешнего ключа на uploads нет: части загрузок, удаленных вместе с пользователем или рабочим пространством,
+-- находит фоновая очистка и удаляет их объекты из хранилища.
+create table upload_parts (
+    id bigserial primary key,
+    upload_id text not null,
+    part_offset bigint not null,
+    size_bytes bigint not null,
+    storage_key text not null unique,
+    created_at timestamptz not null default now(),
+    unique (upload_id, part_offset)
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists upload_parts;
+
+drop table if exists uploads;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table documents add column content_sha256 text;
+create index if not exists documents_workspace_id_content_sha256_idx on documents (workspace_id, content_sha256);
+
+-- Готовый эмбеддинг ищется только среди чанков того же рабочего пространства,
+-- поэтому индекс для поиска одинаковых чанков строится по паре (пространство, хеш текста).
+create index if not exists chunks_workspace_id_content_md5_idx on chunks (workspace_id, md5(title || ' ' || text));
+
+-- Убирает из очереди векторизатора чанки, эмбеддинг которых скопирован из чанка с тем же заголовком и текстом.
+create or replace function dequeue_chunk_embeddings(chunk_ids bigint[]) returns void
+language plpgsql as $$
+declare
+    q record;
+begin
+    select v.queue_schema, v.queue_table into q
+    from ai.vectorizer v
+    where v.name = 'document_chunks_vectorizer';
+
+    if not found then
+        return;
+    end if;
+
+    execute format('delete from %I.%I where id = any($1)', q.queue_schema, q.queue_table) using chunk_ids;
+end;
+$$;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop function if exists dequeue_chunk_embeddings(bigint[]);
+
+drop index if exists chunks_workspace_id_content_md5_idx;
+
+alter table documents drop column if exists content_sha256;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+create table document_versions (
+    id bigserial primary key,
+    document_id bigint not null references documents(id) on delete cascade,
+    version int not null,
+    size_bytes bigint not null,
+    blob_id bigint references blobs(id) on delete set null,
+    content_sha256 text,
+    created_by bigint references users(id) on delete set null,
+    created_at timestamptz not null default now(),
+    unique (document_id, version)
+);
+create index if not exists document_versions_blob_id_idx on document_versions (blob_id);
+create index if not exists document_versions_created_by_idx on document_versions (created_by);
+
+alter table documents add column current_version int not null default 1;
+
+alter table chunks add column version int not null default 1;
+create index if not exists chunks_document_id_version_idx on chunks (document_id, version);
+-- Длина в байтах начала чанка, повторяющего конец предыдущего чанка той же версии.
+-- Записывается при разбиении текста, чтобы собирать документ из чанков без угадывания перекрытий.
+-- У уже существующих чанков остается NULL.
+alter table chunks add column overlap_bytes integer;
+
+insert into document_versions (document_id, version, size_bytes, blob_id, content_sha256, created_by)
+select d.id, 1, d.size_bytes, d.blob_id, d.content_sha256, d.user_id
+from documents d;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+delete from chunks c
+using documents d
+where c.document_id = d.id and c.version <> d.current_version;
+
+drop index if exists chunks_document_id_version_idx;
+alter table chunks drop column if exists overlap_bytes;
+alter table chunks drop column if exists version;
+
+alter table documents drop column if exists current_version;
+
+drop table if exists document_versions;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table documents add column deleted_at timestamptz;
+alter table documents add column deleted_by bigint references users(id) on delete set null;
+create index if not exists documents_deleted_at_idx on documents (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+delete from documents where deleted_at is not null;
+
+drop index if exists documents_deleted_at_idx;
+alter table documents drop column if exists deleted_by;
+alter table documents drop column if exists deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table documents add column created_at timestamptz not null default now();
+alter table documents add column updated_at timestamptz not null default now();
+alter table documents add column description text not null default '';
+alter table documents add column tags text[] not null default '{}';
+alter table documents add column metadata jsonb not null default '{}';
+
+update documents d
+set created_at = v.created_at, updated_at = v.created_at
+from document_versions v
+where v.document_id = d.id and v.version = d.current_version;
+
+create index if not exists documents_tags_idx on documents using gin (tags);
+create index if not exists documents_metadata_idx on documents using gin (metadata jsonb_path_ops);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists documents_metadata_idx;
+drop index if exists documents_tags_idx;
+
+alter table documents drop column if exists metadata;
+alter table documents drop column if exists tags;
+alter table documents drop column if exists description;
+alter table documents drop column if exists updated_at;
+alter table documents drop column if exists created_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+create table folders (
+    id bigserial primary key,
+    workspace_id bigint not null references workspaces(id) on delete cascade,
+    parent_id bigint references folders(id) on delete cascade,
+    name text not null,
+    created_by bigint references users(id) on delete set null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+create index if not exists folders_workspace_id_idx on folders (workspace_id);
+create index if not exists folders_parent_id_idx on folders (parent_id);
+create unique index if not exists folders_sibling_name_idx on folders (workspace_id, coalesce(parent_id, 0), lower(name));
+
+alter table documents add column folder_id bigint references folders(id) on delete set null;
+create index if not exists documents_folder_id_idx on documents (folder_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists documents_folder_id_idx;
+alter table documents drop column if exists folder_id;
+
+drop table if exists folders;
+-- +goose StatementEnd
@@ -0,0 +1,87 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table document_versions add column chunks_count bigint not null default 0;
+alter table document_versions add column null_embeddings_count bigint not null default 0;
+
+update document_versions v
+set chunks_count = c.chunks_count, null_embeddings_count = c.null_embeddings_count
+from (
+    select document_id, version, count(*) as chunks_count, count(*) filter (where embedding is null) as null_embeddings_count
+    from chunks
+    group by document_id, version
+) c
+where v.document_id = c.document_id and v.version = c.version;
+
+-- Счетчики меняются один раз на оператор, а не на каждую строку: векторизатор и загрузка документа
+-- меняют чанки пачками, и построчный триггер обновлял бы одну и ту же запись версии тысячи раз.
+create or replace function update_version_chunk_counters() returns trigger
+language plpgsql as $$
+begin
+    if tg_op = 'INSERT' then
+        update document_versions v
+        set chunks_count = v.chunks_count + d.chunks_count,
+            null_embeddings_count = v.null_embeddings_count + d.null_embeddings_count
+        from (
+            select document_id, version, count(*) as chunks_count, count(*) filter (where embedding is null) as null_embeddings_count
+            from new_chunks
+            group by document_id, version
+        ) d
+        where v.document_id = d.document_id and v.version = d.version;
+    elsif tg_op = 'DELETE' then
+        update document_versions v
+        set chunks_count = v.chunks_count - d.chunks_count,
+            null_embeddings_count = v.null_embeddings_count - d.null_embeddings_count
+        from (
+            select document_id, version, count(*) as chunks_count, count(*) filter (where embedding is null) as null_embeddings_count
+            from old_chunks
+            group by document_id, version
+        ) d
+        where v.document_id = d.document_id and v.version = d.version;
+    else
+        update document_versions v
+        set chunks_count = v.chunks_count + d.chunks_count,
+            null_embeddings_count = v.null_embeddings_count + d.null_embeddings_count
+        from (
+            select document_id, version, sum(delta) as chunks_count, coalesce(sum(delta) filter (where is_null), 0) as null_embeddings_count
+            from (
+                select document_id, version, embedding is null as is_null, 1 as delta from new_chunks
+                union all
+                select document_id, version, embedding is null as is_null, -1 as delta from old_chunks
+            ) changes
+            group by document_id, version
+        ) d
+        where v.document_id = d.document_id
+          and v.version = d.version
+          and (d.chunks_count <> 0 or d.null_embeddings_count <> 0);
+    end if;
+    return null;
+end;
+$$;
+
+create trigger chunks_counters_insert
+after insert on chunks
+referencing new table as new_chunks
+for each statement execute function update_version_chunk_counters();
+
+create trigger chunks_counters_update
+after update on chunks
+referencing old table as old_chunks new table as new_chunks
+for each statement execute function update_version_chunk_counters();
+
+create trigger chunks_counters_delete
+after delete on chunks
+referencing old table as old_chunks
+for each statement execute function update_version_chunk_counters();
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger if exists chunks_counters_delete on chunks;
+drop trigger if exists chunks_counters_update on chunks;
+drop trigger if exists chunks_counters_insert on chunks;
+drop function if exists update_version_chunk_counters();
+
+alter table document_versions drop column if exists null_embeddings_count;
+alter table document_versions drop column if exists chunks_count;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сообщает слушателям канала document_changes ID документа, у которого изменился прогресс обработки,
+-- текущая версия или который перенесли в корзину. Одинаковые уведомления одной транзакции
+-- Postgres доставляет один раз, поэтому пачка обработанных чанков дает одно уведомление на документ.
+create or replace function notify_document_change() returns trigger
+language plpgsql as $$
+begin
+    if tg_table_name = 'documents' then
+        perform pg_notify('document_changes', new.id::text);
+    else
+        perform pg_notify('document_changes', new.document_id::text);
+    end if;
+    return null;
+end;
+$$;
+
+create trigger document_versions_notify_change
+after update of chunks_count, null_embeddings_count on document_versions
+for each row
+when (old.chunks_count is distinct from new.chunks_count or old.null_embeddings_count is distinct from new.null_embeddings_count)
+execute function notify_document_change();
+
+create trigger documents_notify_change
+after update of current_version, deleted_at on documents
+for each row
+when (old.current_version is distinct from new.current_version or old.deleted_at is distinct from new.deleted_at)
+execute function notify_document_change();
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger if exists documents_notify_change on documents;
+drop trigger if exists document_versions_notify_change on document_versions;
+drop function if exists notify_document_change();
+-- +goose StatementEnd
@@ -0,0 +1,192 @@
+-- +goose Up
+-- +goose StatementBegin
+create table webhooks (
+    id bigserial primary key,
+    workspace_id bigint not null references workspaces(id) on delete cascade,
+    url text not null,
+    -- Секрет подписи хранится зашифрованным (AES-GCM, ключ WEBHOOK_ENCRYPTION_KEY).
+    secret_encrypted text not null,
+    events text[] not null default '{}',
+    enabled boolean not null default true,
+    created_by bigint references users(id) on delete set null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+create index if not exists webhooks_workspace_id_idx on webhooks (workspace_id);
+create index if not exists webhooks_created_by_idx on webhooks (created_by);
+
+-- Исходящий журнал событий (transactional outbox): событие и доставки ему записываются
+-- в той же транзакции, что и изменение документа, а отправляет их фоновая задача.
+create table webhook_events (
+    id bigserial primary key,
+    workspace_id bigint not null references workspaces(id) on delete cascade,
+    type text not null,
+    data jsonb not null,
+    created_at timestamptz not null default now()
+);
+create index if not exists webhook_events_workspace_id_idx on webhook_events (workspace_id);
+create index if not exists webhook_events_created_at_idx on webhook_events (created_at);
+
+create table webhook_deliveries (
+    id bigserial primary key,
+    webhook_id bigint not null references webhooks(id) on delete cascade,
+    event_id bigint not null references webhook_events(id) on delete cascade,
+    status text not null default 'pending' check (status in ('pending', 'succeeded', 'failed')),
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    last_attempt_at timestamptz,
+    response_status int,
+    response_body text,
+    error text,
+    created_at timestamptz not null default now()
+);
+create index if not exists webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at) where status = 'pending';
+create index if not exists webhook_deliveries_webhook_id_idx on webhook_deliveries (webhook_id, id);
+create index if not exists webhook_deliveries_event_id_idx on webhook_deliveries (event_id);
+
+alter table document_versions add column ready_at timestamptz;
+alter table document_versions add column processing_failed_at timestamptz;
+
+-- Эмбеддинги уже существующих версий посчитаны до появления вебхуков, событие о них не нужно.
+update document_versions set ready_at = now() where chunks_count > 0 and null_embeddings_count = 0;
+
+-- Записывает событие и доставки всем включенным вебхукам пространства, подписанным на этот тип.
+-- Если таких вебхуков нет, событие не сохраняется.
+create or replace function enqueue_webhook_event(event_workspace_id bigint, event_type text, event_data jsonb) returns void
+language plpgsql as $$
+declare
+    new_event_id bigint;
+begin
+    if not exists (
+        select 1
+        from webhooks w
+        where w.workspace_id = event_workspace_id
+          and w.enabled
+          and (cardinality(w.events) = 0 or event_type = any(w.events))
+    ) then
+        return;
+    end if;
+
+    insert into webhook_events (workspace_id, type, data)
+    values (event_workspace_id, event_type, event_data)
+    returning id into new_event_id;
+
+    insert into webhook_deliveries (webhook_id, event_id)
+    select w.id, new_event_id
+    from webhooks w
+    where w.workspace_id = event_workspace_id
+      and w.enabled
+      and (cardinality(w.events) = 0 or event_type = any(w.events));
+end;
+$$;
+
+create or replace function webhook_document_event_data(doc documents, doc_version int) returns jsonb
+language sql stable as $$
+    select jsonb_build_object(
+        'documentID', doc.id,
+        'workspaceID', doc.workspace_id,
+        'filename', doc.filename,
+        'version', doc_version
+    );
+$$;
+
+create or replace function webhook_documents_event() returns trigger
+language plpgsql as $$
+begin
+    if tg_op = 'INSERT' then
+        perform enqueue_webhook_event(new.workspace_id, 'document.created', webhook_document_event_data(new, new.current_version));
+    else
+        perform enqueue_webhook_event(new.workspace_id, 'document.deleted', webhook_document_event_data(new, new.current_version));
+    end if;
+    return null;
+end;
+$$;
+
+create trigger documents_webhook_created
+after insert on documents
+for each row
+execute function webhook_documents_event();
+
+create trigger documents_webhook_deleted
+after update of deleted_at on documents
+for each row
+when (old.deleted_at is null and new.deleted_at is not null)
+execute function webhook_documents_event();
+
+-- Готовность проверяется при фиксации транзакции: чанки версии вставляются пачками,
+-- и промежуточное состояние (например, первая пачка целиком с готовыми эмбеддингами) не должно считаться готовностью.
+create or replace function webhook_document_ready() returns trigger
+language plpgsql as $$
+declare
+    v document_versions;
+    d documents;
+begin
+    select * into v from document_versions where id = new.id for update;
+    if not found or v.ready_at is not null or v.chunks_count = 0 or v.null_embeddings_count > 0 then
+        return null;
+    end if;
+
+    select * into d from documents where id = v.document_id;
+    -- Вытесненные версии документа не интересны: их чанки больше не ищутся.
+    if not found or d.deleted_at is not null or v.version < d.current_version then
+        return null;
+    end if;
+
+    update document_versions set ready_at = now() where id = v.id;
+    perform enqueue_webhook_event(d.workspace_id, 'document.ready',
+        webhook_document_event_data(d, v.version) || jsonb_build_object('totalEmbeddings', v.chunks_count));
+    return null;
+end;
+$$;
+
+create constraint trigger document_versions_webhook_ready
+after update of chunks_count, null_embeddings_count on document_versions
+deferrable initially deferred
+for each row
+when (new.chunks_count > 0 and new.null_embeddings_count = 0 and new.ready_at is null)
+execute function webhook_document_ready();
+
+create or replace function webhook_document_failed() returns trigger
+language plpgsql as $$
+declare
+    d documents;
+begin
+    select * into d from documents where id = new.document_id;
+    if found then
+        perform enqueue_webhook_event(d.workspace_id, 'document.failed',
+            webhook_document_event_data(d, new.version) || jsonb_build_object(
+                'totalEmbeddings', new.chunks_count,
+                'nullEmbeddings', new.null_embeddings_count
+            ));
+    end if;
+    return null;
+end;
+$$;
+
+create trigger document_versions_webhook_failed
+after update of processing_failed_at on document_versions
+for each row
+when (old.processing_failed_at is null and new.processing_failed_at is not null)
+execute function webhook_document_failed();
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger if exists document_versions_webhook_failed on document_versions;
+drop trigger if exists document_versions_webhook_ready on document_versions;
+drop trigger if exists documents_webhook_deleted on documents;
+drop trigger if exists documents_webhook_created on documents;
+drop function if exists webhook_document_failed();
+drop function if exists webhook_document_ready();
+drop function if exists webhook_documents_event();
+drop function if exists webhook_document_event_data(documents, int);
+drop function if exists enqueue_webhook_event(bigint, text, jsonb);
+
+alter table document_versions drop column if exists processing_failed_at;
+alter table document_versions drop column if exists ready_at;
+
+drop table if exists webhook_deliveries;
+drop table if exists webhook_events;
+drop table if exists webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- name: ListUsersWithStats :many
+-- Возвращает страницу пользователей с количеством документов и чанков (для администратора).
+SELECT
+  u.id,
+  u.email,
+  u.email_verified,
+  u.role,
+  u.disabled_at,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.user_id = u.id
+  ) AS documents_count,
+  (
+    SELECT COUNT(*) FROM chunks c WHERE c.user_id = u.id
+  ) AS chunks_count
+FROM users u
+ORDER BY u.id
+LIMIT $1 OFFSET $2;
+
+-- name: CountUsers :one
+-- Возвращает общее количество пользователей.
+SELECT COUNT(*)
+FROM users;
+
+-- name: GetUserWithStats :one
+-- Возвращает пользователя с количеством документов и чанков (для администратора).
+SELECT
+  u.id,
+  u.email,
+  u.email_verified,
+  u.role,
+  u.disabled_at,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.user_id = u.id
+  ) AS documents_count,
+  (
+    SELECT COUNT(*) FROM chunks c WHERE c.user_id = u.id
+  ) AS chunks_count
+FROM users u
+WHERE u.id = $1
+LIMIT 1;
+
+-- name: DisableUser :execrows
+-- Блокирует пользователя.
+UPDATE users
+SET disabled_at = NOW()
+WHERE id = $1 AND disabled_at IS NULL;
+
+-- name: EnableUser :execrows
+-- Снимает блокировку с пользователя.
+UPDATE users
+SET disabled_at = NULL
+WHERE id = $1 AND disabled_at IS NOT NULL;
+
+-- name: SetUserRoleByEmail :execrows
+-- Назначает роль пользователю по email.
+UPDATE users
+SET role = $2
+WHERE email = $1 AND role <> $2;
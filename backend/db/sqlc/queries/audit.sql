@@ -0,0 +1,5 @@
+-- name: CreateAuditEvent :exec
+-- Записывает событие в журнал аудита.
+-- user_id намеренно не является внешним ключом: записи переживают удаление пользователя.
+INSERT INTO audit_log (user_id, action, details)
+VALUES ($1, $2, $3);
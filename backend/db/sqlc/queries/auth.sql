@@ -19,3 +19,21 @@ WHERE token_hash = $1;
 -- Удаляет все сессии пользователя.
 DELETE FROM refresh_tokens
 WHERE user_id = $1;
+
+-- name: CreateUserToken :exec
+-- Сохраняет хеш одноразового токена (подтверждение email или сброс пароля).
+INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ConsumeUserToken :one
+-- Помечает одноразовый токен использованным и возвращает его.
+-- Истекшие и уже использованные токены не находятся.
+UPDATE user_tokens
+SET used_at = NOW()
+WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING *;
+
+-- name: DeleteUserTokens :exec
+-- Удаляет все одноразовые токены пользователя с указанным назначением.
+DELETE FROM user_tokens
+WHERE user_id = $1 AND purpose = $2;
@@ -0,0 +1,34 @@
+-- name: CreateBlob :one
+-- Сохраняет метаданные загруженного файла из хранилища блобов.
+INSERT INTO blobs (storage_key, sha256, size_bytes, content_type)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetBlobByID :one
+-- Возвращает метаданные файла по его ID.
+SELECT *
+FROM blobs
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetWorkspaceBlobIDs :many
+-- Возвращает ID файлов всех версий документов рабочего пространства.
+SELECT DISTINCT v.blob_id::bigint
+FROM document_versions v
+JOIN documents d ON d.id = v.document_id
+WHERE d.workspace_id = $1 AND v.blob_id IS NOT NULL;
+
+-- name: GetDocumentsBlobIDs :many
+-- Возвращает ID файлов всех версий переданных документов.
+SELECT DISTINCT blob_id::bigint
+FROM document_versions
+WHERE document_id = ANY(sqlc.arg(document_ids)::bigint[]) AND blob_id IS NOT NULL;
+
+-- name: DeleteOrphanBlobs :many
+-- Удаляет из переданных файлов те, на которые больше не ссылается ни один документ или его версия.
+-- Возвращает ключи удаленных файлов, чтобы удалить их из хранилища.
+DELETE FROM blobs b
+WHERE b.id = ANY(sqlc.arg(ids)::bigint[])
+  AND NOT EXISTS (SELECT 1 FROM documents d WHERE d.blob_id = b.id)
+  AND NOT EXISTS (SELECT 1 FROM document_versions v WHERE v.blob_id = b.id)
+RETURNING b.storage_key;
@@ -1,40 +1,202 @@
 -- name: CreateChunk :one
--- Создает один чанк для документа.
--- Поле 'embedding' здесь не передается, оно будет NULL при первичной вставке.
-INSERT INTO chunks (user_id, document_id, title, text)
-VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, document_id, title, text;
+-- Создает один чанк для версии документа.
+-- Если в том же рабочем пространстве уже есть чанк с тем же заголовком и текстом, его эмбеддинг копируется,
+-- иначе поле 'embedding' остается NULL до обработки векторизатором.
+-- ВАЖНО: поиск не выходит за пределы рабочего пространства, иначе по числу скопированных эмбеддингов
+-- можно было бы узнать, есть ли такой текст у других пользователей.
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, embedding)
+VALUES (
+  sqlc.arg(user_id)::bigint,
+  sqlc.arg(workspace_id),
+  sqlc.arg(document_id),
+  sqlc.arg(version),
+  sqlc.arg(title),
+  sqlc.arg(text),
+  (
+    SELECT e.embedding
+    FROM chunks e
+    WHERE e.workspace_id = sqlc.arg(workspace_id)
+      AND md5(e.title || ' ' || e.text) = md5(sqlc.arg(title)::text || ' ' || sqlc.arg(text)::text)
+      AND e.title = sqlc.arg(title)
+      AND e.text = sqlc.arg(text)
+      AND e.embedding IS NOT NULL
+    LIMIT 1
+  )
+)
+RETURNING id, user_id, workspace_id, document_id, title, text, (embedding IS NOT NULL)::bool AS embedded;
 
--- name: GetChunksByDocumentID :many
--- Возвращает все чанки для конкретного документа (для отображения или сборки полного текста).
--- ВАЖНО: также проверяет user_id для безопасности.
-SELECT *
+-- name: CreateChunks :many
+-- Создает чанки версии документа одним запросом; тексты и длины их перекрытий с предыдущим чанком
+-- передаются массивами в порядке следования в документе.
+-- Эмбеддинги идентичных чанков того же рабочего пространства копируются так же, как в CreateChunk.
+-- Возвращает ID новых чанков, их текст и перекрытие, по которым строки сопоставляются с входными
+-- (порядок строк RETURNING не гарантирован), и признак того, что эмбеддинг скопирован.
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, overlap_bytes, embedding)
+SELECT
+  sqlc.arg(user_id)::bigint,
+  sqlc.arg(workspace_id)::bigint,
+  sqlc.arg(document_id)::bigint,
+  sqlc.arg(version)::int,
+  sqlc.arg(title)::text,
+  t.text,
+  o.overlap_bytes,
+  (
+    SELECT e.embedding
+    FROM chunks e
+    WHERE e.workspace_id = sqlc.arg(workspace_id)::bigint
+      AND md5(e.title || ' ' || e.text) = md5(sqlc.arg(title)::text || ' ' || t.text)
+      AND e.title = sqlc.arg(title)::text
+      AND e.text = t.text
+      AND e.embedding IS NOT NULL
+    LIMIT 1
+  )
+FROM unnest(sqlc.arg(texts)::text[]) WITH ORDINALITY AS t(text, ord)
+JOIN unnest(sqlc.arg(overlap_bytes)::int[]) WITH ORDINALITY AS o(overlap_bytes, ord) ON o.ord = t.ord
+ORDER BY t.ord
+RETURNING id, text, overlap_bytes, (embedding IS NOT NULL)::bool AS embedded;
+
+-- name: DequeueChunkEmbeddings :exec
+-- Убирает из очереди векторизатора чанки, которым эмбеддинг достался от идентичного чанка.
+SELECT dequeue_chunk_embeddings(sqlc.arg(chunk_ids)::bigint[]);
+
+-- name: CopyVersionChunks :many
+-- Копирует чанки одной версии документа в новую версию вместе с эмбеддингами (используется при восстановлении версии).
+-- Возвращает ID новых чанков и признак того, что эмбеддинг скопирован.
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, overlap_bytes, embedding)
+SELECT sqlc.arg(user_id)::bigint, c.workspace_id, c.document_id, sqlc.arg(to_version), c.title, c.text, c.overlap_bytes, c.embedding
+FROM chunks c
+WHERE c.document_id = sqlc.arg(document_id) AND c.version = sqlc.arg(from_version)
+ORDER BY c.id
+RETURNING id, (embedding IS NOT NULL)::bool AS embedded;
+
+-- name: GetVersionChunkTexts :many
+-- Возвращает тексты чанков версии документа в порядке их создания (для сравнения версий).
+SELECT text
+FROM chunks
+WHERE document_id = $1 AND version = $2
+ORDER BY id;
+
+-- name: GetUnembeddedVersionChunkIDs :many
+-- Возвращает ID чанков версии документа, эмбеддинг которых еще не посчитан.
+SELECT id
 FROM chunks
-WHERE document_id = $1 AND user_id = $2
-ORDER BY id; -- Сортировка по ID, чтобы чанки шли в порядке их создания
+WHERE document_id = $1 AND version = $2 AND embedding IS NULL;
+
+-- name: GetChunksByDocumentID :many
+-- Возвращает все чанки текущей версии документа (для отображения или сборки полного текста).
+-- ВАЖНО: также проверяет членство пользователя в рабочем пространстве или выданный ему доступ к документу.
+SELECT
+  c.id,
+  c.user_id,
+  c.workspace_id,
+  c.document_id,
+  c.title,
+  c.text,
+  c.overlap_bytes,
+  (c.embedding IS NOT NULL)::bool AS embedded
+FROM chunks c
+WHERE c.document_id = sqlc.arg(document_id)
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = sqlc.arg(document_id) AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = sqlc.arg(user_id))
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = sqlc.arg(user_id))
+  )
+ORDER BY c.id; -- Сортировка по ID, чтобы чанки шли в порядке их создания
+
+-- name: GetChunksByDocumentIDPage :many
+-- Возвращает страницу чанков текущей версии документа в порядке их создания вместе со статусом эмбеддинга.
+-- ВАЖНО: также проверяет членство пользователя в рабочем пространстве или выданный ему доступ к документу.
+SELECT
+  c.id,
+  c.user_id,
+  c.workspace_id,
+  c.document_id,
+  c.title,
+  c.text,
+  c.overlap_bytes,
+  (c.embedding IS NOT NULL)::bool AS embedded
+FROM chunks c
+WHERE c.document_id = sqlc.arg(document_id)
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = sqlc.arg(document_id) AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = sqlc.arg(user_id))
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = sqlc.arg(user_id))
+  )
+ORDER BY c.id
+LIMIT sqlc.arg(limit_count) OFFSET sqlc.arg(offset_count);
 
 -- name: SearchUserChunks :many
 -- Самый важный запрос: выполняет семантический поиск по чанкам.
--- Находит N самых похожих чанков для заданного вектора-запроса, но только среди документов конкретного пользователя.
+-- Находит N самых похожих чанков для заданного вектора-запроса, но только среди рабочих пространств, в которых состоит пользователь,
+-- и документов, которыми с ним поделились.
+-- Если передан workspace_id, поиск ограничивается этим пространством. Ищет только по текущим версиям документов не из корзины.
+-- Если переданы теги или пользовательские поля, документ должен содержать их все. Если переданы folder_ids, ищет только в документах из этих папок.
 SELECT
-    id,
-    document_id,
-    title,
-    text,
-    embedding <=> $1 AS distance -- Рассчитываем косинусное расстояние до вектора-запроса
-FROM chunks
-WHERE user_id = $2 -- ВАЖНО: строгая фильтрация по пользователю
+    c.id,
+    c.document_id,
+    c.title,
+    c.text,
+    c.embedding <=> sqlc.arg(embedding) AS distance -- Рассчитываем косинусное расстояние до вектора-запроса
+FROM chunks c
+JOIN documents d ON d.id = c.document_id AND d.current_version = c.version AND d.deleted_at IS NULL
+WHERE ( -- ВАЖНО: строгая фильтрация по доступным пользователю пространствам и документам
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = sqlc.arg(user_id))
+    OR (
+      sqlc.narg(workspace_id)::bigint IS NULL
+      AND c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = sqlc.arg(user_id))
+    )
+  )
+  AND (sqlc.narg(workspace_id)::bigint IS NULL OR c.workspace_id = sqlc.narg(workspace_id))
+  AND (sqlc.narg(tags)::text[] IS NULL OR d.tags @> sqlc.narg(tags)::text[])
+  AND (sqlc.narg(metadata)::jsonb IS NULL OR d.metadata @> sqlc.narg(metadata)::jsonb)
+  AND (sqlc.narg(folder_ids)::bigint[] IS NULL OR d.folder_id = ANY(sqlc.narg(folder_ids)::bigint[]))
 ORDER BY distance ASC -- Сортируем по возрастанию расстояния (самые похожие - в начале)
-LIMIT $3; -- Ограничиваем количество результатов
+LIMIT sqlc.arg(limit_count); -- Ограничиваем количество результатов
 
 -- name: SearchChunksInDocument :many
--- Выполняет семантический поиск по чанкам ОДНОГО документа.
+-- Выполняет семантический поиск по чанкам текущей версии ОДНОГО документа.
+SELECT
+    c.id,
+    c.document_id,
+    c.text,
+    c.embedding <=> $1 AS distance
+FROM chunks c
+WHERE c.document_id = $3
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = $3 AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = $2)
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = $2)
+  )
+ORDER BY distance ASC
+LIMIT $4;
+
+-- name: GetDocumentChunks :many
+-- Возвращает все чанки текущей версии документа БЕЗ проверки доступа.
+-- ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
+SELECT
+  id,
+  user_id,
+  workspace_id,
+  document_id,
+  title,
+  text,
+  overlap_bytes,
+  (embedding IS NOT NULL)::bool AS embedded
+FROM chunks
+WHERE document_id = $1
+  AND version = (SELECT d.current_version FROM documents d WHERE d.id = $1 AND d.deleted_at IS NULL)
+ORDER BY id;
+
+-- name: SearchDocumentChunks :many
+-- Выполняет семантический поиск по чанкам текущей версии документа БЕЗ проверки доступа.
+-- ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
 SELECT
     id,
     document_id,
     text,
     embedding <=> $1 AS distance
 FROM chunks
-WHERE user_id = $2 AND document_id = $3
+WHERE document_id = $2
+  AND version = (SELECT d.current_version FROM documents d WHERE d.id = $2 AND d.deleted_at IS NULL)
 ORDER BY distance ASC
-LIMIT $4;
+LIMIT $3;
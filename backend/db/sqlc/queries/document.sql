@@ -1,44 +1,279 @@
 -- name: CreateDocument :one
--- Создает запись о новом документе для указанного пользователя.
+-- Создает запись о новом документе в рабочем пространстве.
 -- Возвращает полную запись о новом документе.
-INSERT INTO documents (user_id, filename)
-VALUES ($1, $2)
+INSERT INTO documents (user_id, workspace_id, filename, size_bytes, blob_id, content_sha256)
+VALUES (
+  sqlc.arg(user_id)::bigint,
+  sqlc.arg(workspace_id),
+  sqlc.arg(filename),
+  sqlc.arg(size_bytes),
+  sqlc.narg(blob_id),
+  sqlc.narg(content_sha256)
+)
+RETURNING *;
+
+-- name: LockDocumentCurrentVersion :one
+-- Блокирует документ до конца транзакции и возвращает номер его текущей версии.
+-- Нужна, чтобы параллельные загрузки новых версий не получили одинаковый номер.
+SELECT current_version
+FROM documents
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: SetDocumentCurrentVersion :one
+-- Делает версию текущей: переносит в документ ее размер, файл и хеш содержимого.
+UPDATE documents
+SET current_version = sqlc.arg(version),
+    size_bytes = sqlc.arg(size_bytes),
+    blob_id = sqlc.narg(blob_id),
+    content_sha256 = sqlc.narg(content_sha256),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
 RETURNING *;
 
 -- name: GetUserDocuments :many
--- Возвращает список всех документов для конкретного пользователя, включая количество необработанных и общее количество эмбеддингов.
+-- Возвращает страницу документов из рабочих пространств, в которых состоит пользователь,
+-- а также документов, которыми с ним поделились, включая количество необработанных и общее количество эмбеддингов текущей версии.
+-- Если передан workspace_id, список ограничивается этим пространством, если folder_ids — документами из этих папок.
+-- filename ищется как подстрока без учета регистра, tags должны быть у документа все, status: processing или ready.
+-- Сортировка по sort (name, createdAt или size) с ID для однозначности; страница начинается после документа из after_*.
+-- Без limit_count возвращаются все подходящие документы.
 SELECT
   d.id,
   d.user_id,
+  d.workspace_id,
   d.filename,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id AND c.embedding IS NULL
-  ) AS null_embeddings_count,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id
-  ) AS total_embeddings_count
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(m.role, '')::text AS workspace_role,
+  coalesce(s.permission, '')::text AS share_permission,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
 FROM documents d
-WHERE d.user_id = $1;
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = sqlc.arg(user_id)
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = sqlc.arg(user_id)
+WHERE (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
+  AND (sqlc.narg(workspace_id)::bigint IS NULL OR d.workspace_id = sqlc.narg(workspace_id))
+  AND (sqlc.narg(folder_ids)::bigint[] IS NULL OR d.folder_id = ANY(sqlc.narg(folder_ids)::bigint[]))
+  AND (sqlc.narg(filename)::text IS NULL OR strpos(lower(d.filename), lower(sqlc.narg(filename)::text)) > 0)
+  AND (sqlc.narg(tags)::text[] IS NULL OR d.tags @> sqlc.narg(tags)::text[])
+  AND (
+    sqlc.narg(status)::text IS NULL
+    OR (sqlc.narg(status)::text = 'processing') = (coalesce(v.null_embeddings_count, 0) > 0)
+  )
+  AND (
+    sqlc.narg(after_id)::bigint IS NULL
+    OR CASE
+      WHEN sqlc.arg(sort)::text = 'name' AND NOT sqlc.arg(descending)::bool
+        THEN (lower(d.filename), d.id) > (lower(sqlc.narg(after_name)::text), sqlc.narg(after_id)::bigint)
+      WHEN sqlc.arg(sort)::text = 'name'
+        THEN (lower(d.filename), d.id) < (lower(sqlc.narg(after_name)::text), sqlc.narg(after_id)::bigint)
+      WHEN sqlc.arg(sort)::text = 'size' AND NOT sqlc.arg(descending)::bool
+        THEN (d.size_bytes, d.id) > (sqlc.narg(after_size)::bigint, sqlc.narg(after_id)::bigint)
+      WHEN sqlc.arg(sort)::text = 'size'
+        THEN (d.size_bytes, d.id) < (sqlc.narg(after_size)::bigint, sqlc.narg(after_id)::bigint)
+      WHEN NOT sqlc.arg(descending)::bool
+        THEN (d.created_at, d.id) > (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::bigint)
+      ELSE (d.created_at, d.id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::bigint)
+    END
+  )
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'name' AND NOT sqlc.arg(descending)::bool THEN lower(d.filename) END ASC,
+  CASE WHEN sqlc.arg(sort)::text = 'name' AND sqlc.arg(descending)::bool THEN lower(d.filename) END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'size' AND NOT sqlc.arg(descending)::bool THEN d.size_bytes END ASC,
+  CASE WHEN sqlc.arg(sort)::text = 'size' AND sqlc.arg(descending)::bool THEN d.size_bytes END DESC,
+  CASE WHEN sqlc.arg(sort)::text NOT IN ('name', 'size') AND NOT sqlc.arg(descending)::bool THEN d.created_at END ASC,
+  CASE WHEN sqlc.arg(sort)::text NOT IN ('name', 'size') AND sqlc.arg(descending)::bool THEN d.created_at END DESC,
+  CASE WHEN NOT sqlc.arg(descending)::bool THEN d.id END ASC,
+  CASE WHEN sqlc.arg(descending)::bool THEN d.id END DESC
+LIMIT sqlc.narg(limit_count)::int;
+
+-- name: CountUserDocuments :one
+-- Возвращает количество документов, доступных пользователю, с теми же фильтрами, что и GetUserDocuments.
+SELECT COUNT(*)
+FROM documents d
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = sqlc.arg(user_id)
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = sqlc.arg(user_id)
+WHERE (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
+  AND (sqlc.narg(workspace_id)::bigint IS NULL OR d.workspace_id = sqlc.narg(workspace_id))
+  AND (sqlc.narg(folder_ids)::bigint[] IS NULL OR d.folder_id = ANY(sqlc.narg(folder_ids)::bigint[]))
+  AND (sqlc.narg(filename)::text IS NULL OR strpos(lower(d.filename), lower(sqlc.narg(filename)::text)) > 0)
+  AND (sqlc.narg(tags)::text[] IS NULL OR d.tags @> sqlc.narg(tags)::text[])
+  AND (
+    sqlc.narg(status)::text IS NULL
+    OR (sqlc.narg(status)::text = 'processing') = (coalesce(v.null_embeddings_count, 0) > 0)
+  );
 
 -- name: GetUserDocumentByID :one
 -- Находит конкретный документ по его ID.
--- ВАЖНО: также проверяет user_id для безопасности, чтобы пользователь не мог получить чужой документ.
+-- ВАЖНО: также проверяет членство пользователя в рабочем пространстве документа или выданный ему доступ,
+-- чтобы нельзя было получить чужой документ.
+SELECT
+  d.id,
+  d.user_id,
+  d.workspace_id,
+  d.filename,
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(m.role, '')::text AS workspace_role,
+  coalesce(s.permission, '')::text AS share_permission,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
+FROM documents d
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = sqlc.arg(user_id)
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = sqlc.arg(user_id)
+WHERE d.id = sqlc.arg(id)
+  AND (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetDocumentByID :one
+-- Находит документ по его ID БЕЗ проверки доступа.
+-- ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
 SELECT
   d.id,
   d.user_id,
+  d.workspace_id,
   d.filename,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id AND c.embedding IS NULL
-  ) AS null_embeddings_count,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id
-  ) AS total_embeddings_count
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
 FROM documents d
-WHERE d.id = $1 AND d.user_id = $2
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+WHERE d.id = $1 AND d.deleted_at IS NULL
 LIMIT 1;
 
--- name: DeleteUserDocument :exec
--- Удаляет документ по его ID.
--- ВАЖНО: также проверяет user_id для безопасности.
+-- name: UpdateDocumentMetadata :execrows
+-- Изменяет описание, теги и пользовательские поля документа. Поля, переданные как NULL, не меняются.
+UPDATE documents
+SET description = coalesce(sqlc.narg(description), description),
+    tags = coalesce(sqlc.narg(tags)::text[], tags),
+    metadata = coalesce(sqlc.narg(metadata)::jsonb, metadata),
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL;
+
+-- name: TrashUserDocument :execrows
+-- Перемещает документ в корзину: он пропадает из списков и поиска, но чанки и эмбеддинги сохраняются до очистки.
+-- ВАЖНО: удалять могут только владельцы и редакторы рабочего пространства документа.
+UPDATE documents d
+SET deleted_at = now(), deleted_by = m.user_id
+FROM workspace_members m
+WHERE d.id = sqlc.arg(id)
+  AND d.deleted_at IS NULL
+  AND m.workspace_id = d.workspace_id
+  AND m.user_id = sqlc.arg(user_id)
+  AND m.role IN ('owner', 'editor');
+
+-- name: RestoreUserDocument :execrows
+-- Возвращает документ из корзины.
+-- ВАЖНО: восстанавливать могут только владельцы и редакторы рабочего пространства документа.
+UPDATE documents d
+SET deleted_at = NULL, deleted_by = NULL
+FROM workspace_members m
+WHERE d.id = sqlc.arg(id)
+  AND d.deleted_at IS NOT NULL
+  AND m.workspace_id = d.workspace_id
+  AND m.user_id = sqlc.arg(user_id)
+  AND m.role IN ('owner', 'editor');
+
+-- name: GetDocumentQuotaUserIDs :many
+-- Возвращает пользователей, квоты которых занимает документ: загрузившего его и авторов версий.
+SELECT d.user_id::bigint AS user_id
+FROM documents d
+WHERE d.id = sqlc.arg(id) AND d.user_id IS NOT NULL
+UNION
+SELECT v.created_by::bigint
+FROM document_versions v
+WHERE v.document_id = sqlc.arg(id) AND v.created_by IS NOT NULL
+ORDER BY user_id;
+
+-- name: GetUserTrashedDocuments :many
+-- Возвращает документы в корзине из рабочих пространств, где пользователь владелец или редактор.
+-- Если передан workspace_id, список ограничивается этим пространством.
+SELECT
+  d.id,
+  d.user_id,
+  d.workspace_id,
+  d.filename,
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  m.role AS workspace_role,
+  d.deleted_at::timestamptz AS deleted_at,
+  d.deleted_by
+FROM documents d
+JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = sqlc.arg(user_id)
+WHERE d.deleted_at IS NOT NULL
+  AND m.role IN ('owner', 'editor')
+  AND (sqlc.narg(workspace_id)::bigint IS NULL OR d.workspace_id = sqlc.narg(workspace_id))
+ORDER BY d.deleted_at DESC;
+
+-- name: GetUserTrashedDocumentIDs :many
+-- Возвращает ID документов в корзине, которые пользователь может удалить окончательно.
+SELECT d.id
+FROM documents d
+JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = sqlc.arg(user_id)
+WHERE d.deleted_at IS NOT NULL
+  AND m.role IN ('owner', 'editor')
+  AND (sqlc.narg(workspace_id)::bigint IS NULL OR d.workspace_id = sqlc.narg(workspace_id));
+
+-- name: GetExpiredTrashedDocumentIDs :many
+-- Возвращает ID документов, пролежавших в корзине дольше срока хранения, начиная с самых старых.
+SELECT id
+FROM documents
+WHERE deleted_at < sqlc.arg(deleted_before)
+ORDER BY deleted_at
+LIMIT sqlc.arg(limit_count);
+
+-- name: DeleteTrashedDocuments :many
+-- Окончательно удаляет документы из корзины вместе с версиями и чанками.
+-- Документы, которые успели восстановить, не удаляются. Возвращает ID удаленных документов.
 DELETE FROM documents
-WHERE id = $1 AND user_id = $2;
+WHERE id = ANY(sqlc.arg(ids)::bigint[])
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: GetWorkspaceDocumentIDByContentHash :one
+-- Ищет в рабочем пространстве документ с таким же хешем нормализованного содержимого.
+SELECT id
+FROM documents
+WHERE workspace_id = $1 AND content_sha256 = $2 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1;
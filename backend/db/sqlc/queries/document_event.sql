@@ -0,0 +1,24 @@
+-- name: GetDocumentProgress :one
+-- Возвращает состояние документа для события об его изменении: текущую версию, счетчики чанков
+-- и признак того, что документ в корзине. Проверка доступа не нужна: получатели события выбираются GetDocumentAudience.
+SELECT
+  d.id,
+  d.workspace_id,
+  d.current_version,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  (d.deleted_at IS NOT NULL)::bool AS deleted
+FROM documents d
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+WHERE d.id = $1;
+
+-- name: GetDocumentAudience :many
+-- Возвращает ID пользователей, которые видят документ: участников его рабочего пространства и тех, кому он выдан.
+SELECT m.user_id
+FROM documents d
+JOIN workspace_members m ON m.workspace_id = d.workspace_id
+WHERE d.id = sqlc.arg(document_id)
+UNION
+SELECT s.user_id
+FROM document_shares s
+WHERE s.document_id = sqlc.arg(document_id);
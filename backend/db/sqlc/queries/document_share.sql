@@ -0,0 +1,24 @@
+-- name: UpsertDocumentShare :exec
+-- Выдает пользователю доступ к документу или меняет уровень уже выданного доступа.
+INSERT INTO document_shares (document_id, user_id, permission, granted_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (document_id, user_id) DO UPDATE
+SET permission = excluded.permission,
+    granted_by = excluded.granted_by;
+
+-- name: GetDocumentShares :many
+-- Возвращает пользователей, которым выдан доступ к документу.
+SELECT
+  s.user_id,
+  u.email,
+  s.permission,
+  s.created_at
+FROM document_shares s
+JOIN users u ON u.id = s.user_id
+WHERE s.document_id = $1
+ORDER BY s.created_at, s.user_id;
+
+-- name: DeleteDocumentShare :execrows
+-- Отзывает доступ пользователя к документу.
+DELETE FROM document_shares
+WHERE document_id = $1 AND user_id = $2;
@@ -0,0 +1,67 @@
+-- name: CreateDocumentVersion :one
+-- Сохраняет запись о версии документа.
+INSERT INTO document_versions (document_id, version, size_bytes, blob_id, content_sha256, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetDocumentVersions :many
+-- Возвращает все версии документа от новой к старой вместе с количеством их чанков.
+SELECT
+  v.id,
+  v.document_id,
+  v.version,
+  v.size_bytes,
+  v.blob_id,
+  v.content_sha256,
+  v.created_by,
+  v.created_at,
+  v.chunks_count
+FROM document_versions v
+WHERE v.document_id = $1
+ORDER BY v.version DESC;
+
+-- name: GetDocumentVersion :one
+-- Возвращает одну версию документа по ее номеру.
+SELECT
+  v.id,
+  v.document_id,
+  v.version,
+  v.size_bytes,
+  v.blob_id,
+  v.content_sha256,
+  v.created_by,
+  v.created_at,
+  v.chunks_count
+FROM document_versions v
+WHERE v.document_id = $1 AND v.version = $2
+LIMIT 1;
+
+-- name: LockDocumentVersionsBatch :many
+-- Блокирует до конца транзакции очередную пачку версий после after_id и возвращает их ID.
+-- Нужна для сверки счетчиков: пока версии заблокированы, триггер на чанках не может изменить их счетчики.
+SELECT id
+FROM document_versions
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count)
+FOR UPDATE;
+
+-- name: ReconcileVersionChunkCounters :many
+-- Пересчитывает по таблице чанков счетчики перечисленных версий и исправляет те, что разошлись.
+-- Возвращает ID исправленных версий.
+UPDATE document_versions v
+SET chunks_count = a.chunks_count,
+    null_embeddings_count = a.null_embeddings_count
+FROM (
+  SELECT
+    b.id,
+    COUNT(c.id) AS chunks_count,
+    COUNT(c.id) FILTER (WHERE c.embedding IS NULL) AS null_embeddings_count
+  FROM document_versions b
+  LEFT JOIN chunks c ON c.document_id = b.document_id AND c.version = b.version
+  WHERE b.id = ANY(sqlc.arg(ids)::bigint[])
+  GROUP BY b.id
+) a
+WHERE v.id = a.id
+  AND (v.chunks_count <> a.chunks_count OR v.null_embeddings_count <> a.null_embeddings_count)
+RETURNING v.id;
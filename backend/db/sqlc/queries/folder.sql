@@ -0,0 +1,105 @@
+-- name: CreateFolder :one
+-- Создает папку в рабочем пространстве. Папка без parent_id находится в корне пространства.
+INSERT INTO folders (workspace_id, parent_id, name, created_by)
+VALUES (sqlc.arg(workspace_id), sqlc.narg(parent_id), sqlc.arg(name), sqlc.arg(created_by))
+RETURNING *;
+
+-- name: GetUserFolders :many
+-- Возвращает папки из рабочих пространств, в которых состоит пользователь, вместе с его ролью
+-- и количеством документов непосредственно в папке. Если передан workspace_id, список ограничивается этим пространством.
+SELECT
+  f.id,
+  f.workspace_id,
+  f.parent_id,
+  f.name,
+  f.created_by,
+  f.created_at,
+  f.updated_at,
+  m.role AS workspace_role,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.folder_id = f.id AND d.deleted_at IS NULL
+  ) AS documents_count
+FROM folders f
+JOIN workspace_members m ON m.workspace_id = f.workspace_id AND m.user_id = sqlc.arg(user_id)
+WHERE (sqlc.narg(workspace_id)::bigint IS NULL OR f.workspace_id = sqlc.narg(workspace_id))
+ORDER BY f.workspace_id, lower(f.name), f.id;
+
+-- name: GetUserFolderByID :one
+-- Находит папку по ID вместе с ролью пользователя в ее рабочем пространстве.
+-- ВАЖНО: папка находится, только если пользователь состоит в ее рабочем пространстве.
+SELECT
+  f.id,
+  f.workspace_id,
+  f.parent_id,
+  f.name,
+  f.created_by,
+  f.created_at,
+  f.updated_at,
+  m.role AS workspace_role,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.folder_id = f.id AND d.deleted_at IS NULL
+  ) AS documents_count
+FROM folders f
+JOIN workspace_members m ON m.workspace_id = f.workspace_id AND m.user_id = sqlc.arg(user_id)
+WHERE f.id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: FolderNameExists :one
+-- Проверяет, есть ли у родительской папки другая вложенная папка с таким же именем без учета регистра.
+SELECT EXISTS (
+  SELECT 1
+  FROM folders
+  WHERE workspace_id = sqlc.arg(workspace_id)
+    AND parent_id IS NOT DISTINCT FROM sqlc.narg(parent_id)
+    AND lower(name) = lower(sqlc.arg(name))
+    AND id <> sqlc.arg(exclude_id)
+);
+
+-- name: LockWorkspaceFolders :exec
+-- Блокирует рабочее пространство до конца транзакции, чтобы параллельные перемещения папок не образовали цикл.
+SELECT id
+FROM workspaces
+WHERE id = $1
+FOR UPDATE;
+
+-- name: RenameFolder :exec
+-- Переименовывает папку.
+UPDATE folders
+SET name = $2, updated_at = now()
+WHERE id = $1;
+
+-- name: MoveFolder :exec
+-- Переносит папку в другую родительскую папку того же пространства или в корень, если parent_id NULL.
+UPDATE folders
+SET parent_id = sqlc.narg(parent_id), updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: GetFolderSubtreeIDs :many
+-- Возвращает ID папки и всех вложенных в нее папок на любую глубину.
+WITH RECURSIVE subtree AS (
+  SELECT f.id FROM folders f WHERE f.id = $1
+  UNION
+  SELECT f.id FROM folders f JOIN subtree s ON f.parent_id = s.id
+)
+SELECT id FROM subtree;
+
+-- name: DeleteFolder :exec
+-- Удаляет папку вместе со всеми вложенными папками.
+-- Документы из них вызывающий код перед этим перемещает в корзину (TrashFolderDocuments); здесь они лишь теряют привязку к папке.
+DELETE FROM folders
+WHERE id = $1;
+
+-- name: TrashFolderDocuments :many
+-- Перемещает в корзину документы из перечисленных папок. Возвращает ID перемещенных документов.
+-- ВАЖНО: вызывающий код должен проверить, что пользователь владелец или редактор пространства папок.
+UPDATE documents
+SET deleted_at = now(), deleted_by = sqlc.arg(user_id)
+WHERE folder_id = ANY(sqlc.arg(folder_ids)::bigint[])
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- name: SetDocumentFolder :execrows
+-- Переносит документ в папку или в корень пространства, если folder_id NULL.
+UPDATE documents
+SET folder_id = sqlc.narg(folder_id), updated_at = now()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL;
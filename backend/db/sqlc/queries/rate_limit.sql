@@ -0,0 +1,27 @@
+-- name: IncrRateLimit :one
+-- Увеличивает счетчик в окне ограничения частоты запросов.
+-- Если окно истекло, счетчик начинается заново с новым сроком действия.
+INSERT INTO rate_limits (key, count, expires_at)
+VALUES ($1, 1, $2)
+ON CONFLICT (key) DO UPDATE
+SET
+  count = CASE WHEN rate_limits.expires_at <= NOW() THEN 1 ELSE rate_limits.count + 1 END,
+  expires_at = CASE WHEN rate_limits.expires_at <= NOW() THEN excluded.expires_at ELSE rate_limits.expires_at END
+RETURNING count, expires_at;
+
+-- name: GetRateLimit :one
+-- Возвращает текущее значение счетчика, если окно еще не истекло.
+SELECT count, expires_at
+FROM rate_limits
+WHERE key = $1 AND expires_at > NOW()
+LIMIT 1;
+
+-- name: DeleteRateLimit :exec
+-- Сбрасывает счетчик.
+DELETE FROM rate_limits
+WHERE key = $1;
+
+-- name: DeleteExpiredRateLimits :exec
+-- Удаляет истекшие счетчики.
+DELETE FROM rate_limits
+WHERE expires_at <= NOW();
@@ -0,0 +1,27 @@
+-- name: CreateShareLink :one
+-- Создает публичную ссылку на документ. Хранится только хеш токена.
+INSERT INTO share_links (document_id, created_by, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetDocumentShareLinks :many
+-- Возвращает все публичные ссылки документа, включая отозванные и истекшие.
+SELECT *
+FROM share_links
+WHERE document_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: GetActiveShareLinkByTokenHash :one
+-- Находит действующую ссылку по хешу токена: не отозванную и не истекшую.
+SELECT *
+FROM share_links
+WHERE token_hash = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+LIMIT 1;
+
+-- name: RevokeShareLink :execrows
+-- Отзывает публичную ссылку документа.
+UPDATE share_links
+SET revoked_at = now()
+WHERE id = $1 AND document_id = $2 AND revoked_at IS NULL;
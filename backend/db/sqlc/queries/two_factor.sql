@@ -0,0 +1,70 @@
+-- name: UpsertPendingUserTOTP :execrows
+-- Сохраняет новый (еще не подтвержденный) TOTP-секрет пользователя.
+-- Уже включенную двухфакторную аутентификацию не перезаписывает.
+INSERT INTO user_totp (user_id, secret_encrypted)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_encrypted = excluded.secret_encrypted, last_used_step = 0, created_at = NOW()
+WHERE user_totp.enabled_at IS NULL;
+
+-- name: GetUserTOTP :one
+-- Возвращает TOTP-настройки пользователя.
+SELECT *
+FROM user_totp
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: EnableUserTOTP :exec
+-- Включает двухфакторную аутентификацию после подтверждения первого кода.
+UPDATE user_totp
+SET enabled_at = NOW()
+WHERE user_id = $1;
+
+-- name: UseUserTOTPStep :execrows
+-- Запоминает использованный временной шаг TOTP, чтобы один код нельзя было применить повторно.
+UPDATE user_totp
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: DeleteUserTOTP :exec
+-- Отключает двухфакторную аутентификацию.
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+-- Сохраняет хеш кода восстановления.
+INSERT INTO user_recovery_codes (user_id, code_hash)
+VALUES ($1, $2);
+
+-- name: UseRecoveryCode :execrows
+-- Помечает код восстановления использованным.
+UPDATE user_recovery_codes
+SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: DeleteUserRecoveryCodes :exec
+-- Удаляет все коды восстановления пользователя.
+DELETE FROM user_recovery_codes
+WHERE user_id = $1;
+
+-- name: CreateLoginChallenge :exec
+-- Сохраняет хеш токена промежуточного шага входа (после проверки пароля, до проверки второго фактора).
+INSERT INTO login_challenges (user_id, token_hash, expires_at)
+VALUES ($1, $2, $3);
+
+-- name: IncrLoginChallengeAttempts :one
+-- Засчитывает попытку ввода второго фактора и возвращает шаг входа.
+UPDATE login_challenges
+SET attempts = attempts + 1
+WHERE token_hash = $1 AND expires_at > NOW()
+RETURNING *;
+
+-- name: DeleteLoginChallenge :exec
+-- Удаляет промежуточный шаг входа.
+DELETE FROM login_challenges
+WHERE id = $1;
+
+-- name: DeleteExpiredLoginChallenges :exec
+-- Удаляет истекшие промежуточные шаги входа пользователя.
+DELETE FROM login_challenges
+WHERE user_id = $1 AND expires_at <= NOW();
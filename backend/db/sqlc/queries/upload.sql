@@ -0,0 +1,82 @@
+-- name: CreateUpload :one
+-- Создает возобновляемую загрузку (tus).
+INSERT INTO uploads (id, user_id, workspace_id, filename, content_type, upload_length, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetUserUpload :one
+-- Возвращает незавершенную или завершенную загрузку пользователя, если она не истекла.
+SELECT *
+FROM uploads
+WHERE id = $1 AND user_id = $2 AND expires_at > now()
+LIMIT 1;
+
+-- name: LockUserUpload :one
+-- Берет аренду на запись в загрузку пользователя, если ее сейчас не держит другой запрос.
+-- Не возвращает строку, если загрузки нет, она истекла или занята.
+UPDATE uploads
+SET lock_token = sqlc.arg(lock_token)::text, locked_until = sqlc.arg(locked_until)
+WHERE id = sqlc.arg(id)
+  AND user_id = sqlc.arg(user_id)
+  AND expires_at > now()
+  AND (locked_until IS NULL OR locked_until < now())
+RETURNING *;
+
+-- name: ExtendUploadLock :execrows
+-- Продлевает аренду, если она все еще принадлежит запросу.
+UPDATE uploads
+SET locked_until = sqlc.arg(locked_until)
+WHERE id = sqlc.arg(id) AND lock_token = sqlc.arg(lock_token)::text;
+
+-- name: UnlockUpload :exec
+-- Снимает аренду, если она все еще принадлежит запросу.
+UPDATE uploads
+SET lock_token = NULL, locked_until = NULL
+WHERE id = sqlc.arg(id) AND lock_token = sqlc.arg(lock_token)::text;
+
+-- name: AdvanceUploadOffset :execrows
+-- Сдвигает смещение загрузки, только если оно не изменилось с момента чтения и аренда принадлежит запросу.
+UPDATE uploads
+SET upload_offset = sqlc.arg(new_offset)
+WHERE id = sqlc.arg(id) AND upload_offset = sqlc.arg(old_offset) AND lock_token = sqlc.arg(lock_token)::text;
+
+-- name: CreateUploadPart :exec
+-- Сохраняет часть данных загрузки, принятую одним PATCH-запросом.
+INSERT INTO upload_parts (upload_id, part_offset, size_bytes, storage_key)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetUploadParts :many
+-- Возвращает части загрузки в порядке их смещения.
+SELECT *
+FROM upload_parts
+WHERE upload_id = $1
+ORDER BY part_offset;
+
+-- name: DeleteUploadParts :many
+-- Удаляет части загрузки и возвращает их ключи, чтобы удалить объекты из хранилища.
+DELETE FROM upload_parts
+WHERE upload_id = $1
+RETURNING storage_key;
+
+-- name: DeleteOrphanUploadParts :many
+-- Удаляет части загрузок, которых больше нет (истекли или удалены вместе с пользователем или рабочим пространством),
+-- и возвращает их ключи.
+DELETE FROM upload_parts p
+WHERE NOT EXISTS (SELECT 1 FROM uploads u WHERE u.id = p.upload_id)
+RETURNING p.storage_key;
+
+-- name: CompleteUpload :exec
+-- Связывает завершенную загрузку с созданным из нее документом.
+UPDATE uploads
+SET document_id = $2
+WHERE id = $1;
+
+-- name: DeleteUserUpload :execrows
+-- Удаляет загрузку пользователя (расширение termination).
+DELETE FROM uploads
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteExpiredUploads :execrows
+-- Удаляет истекшие загрузки; их части затем удаляет DeleteOrphanUploadParts.
+DELETE FROM uploads
+WHERE expires_at <= now();
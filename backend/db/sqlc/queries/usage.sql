@@ -0,0 +1,39 @@
+-- name: GetUserUsage :one
+-- Возвращает текущее потребление квот пользователем: загруженные им документы, объем и чанки загруженных им версий
+-- и поиски за день. Документы в корзине не учитываются. Чанки берутся из счетчиков версий, а не подсчетом таблицы chunks.
+SELECT
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.user_id = sqlc.arg(user_id)::bigint AND d.deleted_at IS NULL
+  ) AS documents_count,
+  (
+    SELECT coalesce(sum(v.size_bytes), 0)::bigint
+    FROM document_versions v
+    JOIN documents d ON d.id = v.document_id
+    WHERE v.created_by = sqlc.arg(user_id) AND d.deleted_at IS NULL
+  ) AS size_bytes,
+  (
+    SELECT coalesce(sum(v.chunks_count), 0)::bigint
+    FROM document_versions v
+    JOIN documents d ON d.id = v.document_id
+    WHERE v.created_by = sqlc.arg(user_id) AND d.deleted_at IS NULL
+  ) AS chunks_count,
+  (
+    SELECT coalesce(max(s.count), 0)::bigint FROM search_usage s WHERE s.user_id = sqlc.arg(user_id) AND s.day = sqlc.arg(day)
+  ) AS searches_count;
+
+-- name: IncrSearchUsage :one
+-- Увеличивает счетчик поисков пользователя за день и возвращает новое значение.
+INSERT INTO search_usage (user_id, day, count)
+VALUES ($1, $2, 1)
+ON CONFLICT (user_id, day) DO UPDATE
+SET count = search_usage.count + 1
+RETURNING count;
+
+
+-- name: LockUserQuota :exec
+-- Блокирует квоты пользователя до конца транзакции, чтобы параллельные загрузки проверяли и занимали их по очереди.
+-- Блокируется строка пользователя: NO KEY не мешает вставкам, которые ссылаются на пользователя внешним ключом.
+SELECT id
+FROM users
+WHERE id = $1
+FOR NO KEY UPDATE;
@@ -17,3 +17,33 @@ SELECT *
 FROM users
 WHERE id = $1
 LIMIT 1;
+
+-- name: GetUserByIdentity :one
+-- Находит пользователя по внешней учетной записи OIDC-провайдера.
+SELECT u.*
+FROM users u
+JOIN user_identities i ON i.user_id = u.id
+WHERE i.issuer = $1 AND i.subject = $2
+LIMIT 1;
+
+-- name: CreateUserIdentity :exec
+-- Привязывает внешнюю учетную запись OIDC-провайдера к пользователю.
+INSERT INTO user_identities (user_id, issuer, subject, email)
+VALUES ($1, $2, $3, $4);
+
+-- name: SetUserEmailVerified :exec
+-- Отмечает email пользователя как подтвержденный.
+UPDATE users
+SET email_verified = TRUE
+WHERE id = $1;
+
+-- name: UpdateUserPassword :exec
+-- Обновляет хеш пароля пользователя.
+UPDATE users
+SET password_hash = $2
+WHERE id = $1;
+
+-- name: DeleteUser :exec
+-- Удаляет пользователя. Документы, чанки и сессии удаляются каскадно.
+DELETE FROM users
+WHERE id = $1;
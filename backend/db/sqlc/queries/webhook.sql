@@ -0,0 +1,149 @@
+-- name: CreateWebhook :one
+-- Регистрирует вебхук рабочего пространства. Пустой список events означает подписку на все события.
+INSERT INTO webhooks (workspace_id, url, secret_encrypted, events, created_by)
+VALUES (sqlc.arg(workspace_id), sqlc.arg(url), sqlc.arg(secret_encrypted)::text, sqlc.arg(events)::text[], sqlc.arg(created_by))
+RETURNING *;
+
+-- name: GetWorkspaceWebhooks :many
+-- Возвращает вебхуки рабочего пространства в порядке создания.
+SELECT *
+FROM webhooks
+WHERE workspace_id = $1
+ORDER BY id;
+
+-- name: GetWorkspaceWebhook :one
+-- Возвращает вебхук, только если он принадлежит указанному рабочему пространству.
+SELECT *
+FROM webhooks
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: CountWorkspaceWebhooks :one
+SELECT count(*)
+FROM webhooks
+WHERE workspace_id = $1;
+
+-- name: UpdateWebhook :one
+-- Меняет переданные поля вебхука; поля со значением NULL остаются прежними.
+UPDATE webhooks
+SET url = coalesce(sqlc.narg(url), url),
+    secret_encrypted = coalesce(sqlc.narg(secret_encrypted), secret_encrypted),
+    events = coalesce(sqlc.narg(events)::text[], events),
+    enabled = coalesce(sqlc.narg(enabled), enabled),
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND workspace_id = sqlc.arg(workspace_id)
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+-- Удаляет вебхук вместе с журналом его доставок.
+DELETE FROM webhooks
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: GetWebhookDeliveries :many
+-- Возвращает страницу журнала доставок вебхука, начиная с последних.
+SELECT
+  d.id,
+  d.webhook_id,
+  d.event_id,
+  e.type AS event_type,
+  d.status,
+  d.attempts,
+  d.next_attempt_at,
+  d.last_attempt_at,
+  d.response_status,
+  d.response_body,
+  d.error,
+  d.created_at
+FROM webhook_deliveries d
+JOIN webhook_events e ON e.id = d.event_id
+WHERE d.webhook_id = sqlc.arg(webhook_id)
+ORDER BY d.id DESC
+OFFSET sqlc.arg(offset_count)
+LIMIT sqlc.arg(limit_count);
+
+-- name: RedeliverWebhookEvent :one
+-- Ставит событие одной из доставок вебхука в очередь повторно, новой доставкой со своим журналом попыток.
+WITH source AS (
+  SELECT webhook_id, event_id
+  FROM webhook_deliveries
+  WHERE webhook_deliveries.id = sqlc.arg(delivery_id) AND webhook_deliveries.webhook_id = sqlc.arg(webhook_id)
+), created AS (
+  INSERT INTO webhook_deliveries (webhook_id, event_id)
+  SELECT webhook_id, event_id FROM source
+  RETURNING *
+)
+SELECT
+  d.id,
+  d.webhook_id,
+  d.event_id,
+  e.type AS event_type,
+  d.status,
+  d.attempts,
+  d.next_attempt_at,
+  d.last_attempt_at,
+  d.response_status,
+  d.response_body,
+  d.error,
+  d.created_at
+FROM created d
+JOIN webhook_events e ON e.id = d.event_id;
+
+-- name: ClaimWebhookDeliveries :many
+-- Забирает доставки, время попытки которых наступило, и откладывает их следующую попытку до lease_until.
+-- Если процесс упадет во время отправки, доставка снова станет доступна после lease_until.
+-- Доставки выключенных вебхуков ждут, пока вебхук снова включат.
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM webhooks w, webhook_events e
+WHERE d.id IN (
+    SELECT p.id
+    FROM webhook_deliveries p
+    JOIN webhooks pw ON pw.id = p.webhook_id
+    WHERE p.status = 'pending' AND p.next_attempt_at <= now() AND pw.enabled
+    ORDER BY p.next_attempt_at
+    LIMIT sqlc.arg(limit_count)
+    FOR UPDATE OF p SKIP LOCKED
+  )
+  AND w.id = d.webhook_id
+  AND e.id = d.event_id
+RETURNING
+  d.id,
+  d.webhook_id,
+  d.event_id,
+  d.attempts,
+  w.url,
+  w.secret_encrypted,
+  e.workspace_id,
+  e.type AS event_type,
+  e.data AS event_data,
+  e.created_at AS event_created_at;
+
+-- name: RecordWebhookDeliveryAttempt :exec
+-- Сохраняет результат попытки доставки. Для повторной попытки статус остается 'pending' с новым next_attempt_at.
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    attempts = attempts + 1,
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_attempt_at = now(),
+    response_status = sqlc.narg(response_status),
+    response_body = sqlc.narg(response_body),
+    error = sqlc.narg(error)
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteOldWebhookEvents :execrows
+-- Удаляет события старше срока хранения вместе с их доставками.
+DELETE FROM webhook_events
+WHERE created_at < sqlc.arg(created_before);
+
+-- name: FailStalledDocumentVersions :many
+-- Отмечает текущие версии документов, эмбеддинги которых не посчитаны до created_before, как необработанные.
+-- Отметка ставится один раз; триггер записывает событие document.failed для вебхуков.
+UPDATE document_versions v
+SET processing_failed_at = now()
+FROM documents d
+WHERE d.id = v.document_id
+  AND d.current_version = v.version
+  AND d.deleted_at IS NULL
+  AND v.null_embeddings_count > 0
+  AND v.processing_failed_at IS NULL
+  AND v.created_at < sqlc.arg(created_before)
+RETURNING v.document_id;
@@ -0,0 +1,109 @@
+-- name: CreateWorkspace :one
+-- Создает рабочее пространство. Для личного пространства передается personal_user_id.
+INSERT INTO workspaces (name, personal_user_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetPersonalWorkspace :one
+-- Возвращает личное рабочее пространство пользователя.
+SELECT *
+FROM workspaces
+WHERE personal_user_id = $1
+LIMIT 1;
+
+-- name: GetUserWorkspaces :many
+-- Возвращает рабочие пространства, в которых состоит пользователь, вместе с его ролью.
+SELECT
+  w.id,
+  w.name,
+  w.personal_user_id,
+  w.created_at,
+  m.role
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.user_id = $1
+ORDER BY w.personal_user_id IS NULL, w.id;
+
+-- name: GetUserWorkspaceByID :one
+-- Возвращает рабочее пространство вместе с ролью пользователя в нем.
+-- ВАЖНО: пространство находится, только если пользователь в нем состоит.
+SELECT
+  w.id,
+  w.name,
+  w.personal_user_id,
+  w.created_at,
+  m.role
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE w.id = $1 AND m.user_id = $2
+LIMIT 1;
+
+-- name: RenameWorkspace :exec
+-- Переименовывает рабочее пространство.
+UPDATE workspaces
+SET name = $2
+WHERE id = $1;
+
+-- name: DeleteWorkspace :exec
+-- Удаляет рабочее пространство вместе с документами и чанками.
+DELETE FROM workspaces
+WHERE id = $1;
+
+-- name: UpsertWorkspaceMember :exec
+-- Добавляет участника в рабочее пространство или меняет его роль.
+INSERT INTO workspace_members (workspace_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id, user_id) DO UPDATE
+SET role = excluded.role;
+
+-- name: GetWorkspaceMembers :many
+-- Возвращает участников рабочего пространства.
+SELECT
+  m.user_id,
+  u.email,
+  m.role,
+  m.created_at
+FROM workspace_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.workspace_id = $1
+ORDER BY m.created_at, m.user_id;
+
+-- name: DeleteWorkspaceMember :execrows
+-- Удаляет участника из рабочего пространства.
+DELETE FROM workspace_members
+WHERE workspace_id = $1 AND user_id = $2;
+
+-- name: CountWorkspaceOwners :one
+-- Возвращает количество владельцев рабочего пространства.
+SELECT COUNT(*)
+FROM workspace_members
+WHERE workspace_id = $1 AND role = 'owner';
+
+-- name: LockWorkspace :exec
+-- Блокирует рабочее пространство до конца транзакции, чтобы изменения состава владельцев шли по очереди.
+SELECT id
+FROM workspaces
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockOwnedSharedWorkspaces :many
+-- Блокирует до конца транзакции общие рабочие пространства, владельцем которых является пользователь.
+-- Нужна, чтобы два владельца не удалили аккаунты одновременно, оставив пространство без владельца.
+SELECT w.id
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.user_id = $1 AND m.role = 'owner' AND w.personal_user_id IS NULL
+ORDER BY w.id
+FOR UPDATE OF w;
+
+-- name: CountSoleOwnedWorkspaces :one
+-- Возвращает количество общих рабочих пространств, в которых пользователь — единственный владелец.
+SELECT COUNT(*)
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.user_id = $1 AND m.role = 'owner' AND w.personal_user_id IS NULL
+  AND NOT EXISTS (
+    SELECT 1
+    FROM workspace_members o
+    WHERE o.workspace_id = w.id AND o.role = 'owner' AND o.user_id <> $1
+  );
@@ -0,0 +1,28 @@
+package blobstore
+
+import (
+	"backend/internal/config"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func New(ctx context.Context, cfg *config.BlobConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case "local", "":
+		return NewLocal(cfg.Dir)
+	case "s3":
+		return NewS3(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown blob driver: '%s'", cfg.Driver)
+	}
+}
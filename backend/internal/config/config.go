@@ -11,12 +11,23 @@ import (
 
 type (
 	Config struct {
-		LogLevel  zerolog.Level
-		Server    *ServerConfig
-		Db        *DbConfig
-		Handler   *HandlerConfig
-		JWT       *JWTConfig
-		Embedding *EmbeddingConfig
+		LogLevel          zerolog.Level
+		Server            *ServerConfig
+		Db                *DbConfig
+		Handler           *HandlerConfig
+		JWT               *JWTConfig
+		Embedding         *EmbeddingConfig
+		OIDC              *OIDCConfig
+		Mail              *MailConfig
+		RateLimit         *RateLimitConfig
+		TwoFactor         *TwoFactorConfig
+		Admin             *AdminConfig
+		Quota             *QuotaConfig
+		Blob              *BlobConfig
+		Upload            *UploadConfig
+		Trash             *TrashConfig
+		EmbeddingCounters *EmbeddingCountersConfig
+		Webhook           *WebhookConfig
 	}
 
 	DbConfig struct {
@@ -36,7 +47,18 @@ type (
 	}
 
 	HandlerConfig struct {
-		RequestTimeout time.Duration
+		RequestTimeout         time.Duration
+		FrontendURL            string
+		TrustProxy             bool
+		MaxUploadSize          int64
+		MaxUploadFiles         int
+		MaxArchiveEntries      int
+		MaxArchiveExpandedSize int64
+		// MaxResumableUploadSize — предел размера файла для tus-загрузки, отдельный от MaxUploadSize
+		// обычной загрузки одним запросом.
+		MaxResumableUploadSize int64
+		// ResumableUploadTimeout — сколько может длиться один PATCH tus-загрузки вместо общих таймаутов сервера.
+		ResumableUploadTimeout time.Duration
 	}
 
 	JWTConfig struct {
@@ -47,6 +69,116 @@ type (
 		Host string
 		Port int
 	}
+
+	OIDCConfig struct {
+		Enabled      bool
+		Issuer       string
+		ClientID     string
+		ClientSecret string
+		RedirectURL  string
+		Scopes       []string
+	}
+
+	MailConfig struct {
+		Driver  string
+		From    string
+		BaseURL string
+		Dir     string
+		SMTP    *SMTPConfig
+	}
+
+	RateLimitConfig struct {
+		Store           string
+		CleanupInterval time.Duration
+		IP              RateLimitRule
+		Account         RateLimitRule
+		ShareLink       RateLimitRule
+		Mail            RateLimitRule
+		Lockout         LockoutConfig
+	}
+
+	RateLimitRule struct {
+		Limit  int
+		Window time.Duration
+	}
+
+	LockoutConfig struct {
+		Threshold    int
+		Window       time.Duration
+		BaseDuration time.Duration
+		MaxDuration  time.Duration
+	}
+
+	TwoFactorConfig struct {
+		Issuer        string
+		EncryptionKey string
+		ChallengeTTL  time.Duration
+		MaxAttempts   int
+	}
+
+	BlobConfig struct {
+		Driver string
+		Dir    string
+		S3     *S3Config
+	}
+
+	UploadConfig struct {
+		Dir             string
+		ExpiresIn       time.Duration
+		CleanupInterval time.Duration
+		LockTimeout     time.Duration
+	}
+
+	TrashConfig struct {
+		Retention     time.Duration
+		PurgeInterval time.Duration
+	}
+
+	EmbeddingCountersConfig struct {
+		ReconcileInterval time.Duration
+	}
+
+	WebhookConfig struct {
+		PollInterval         time.Duration
+		BatchSize            int
+		Timeout              time.Duration
+		MaxAttempts          int
+		InitialBackoff       time.Duration
+		MaxBackoff           time.Duration
+		ProcessingTimeout    time.Duration
+		MaintenanceInterval  time.Duration
+		Retention            time.Duration
+		AllowPrivateNetworks bool
+		EncryptionKey        string
+	}
+
+	S3Config struct {
+		Endpoint  string
+		Region    string
+		Bucket    string
+		AccessKey string
+		SecretKey string
+		UseSSL    bool
+		PathStyle bool
+	}
+
+	QuotaConfig struct {
+		MaxBytes          int64
+		MaxDocuments      int64
+		MaxChunks         int64
+		MaxSearchesPerDay int64
+	}
+
+	AdminConfig struct {
+		Emails []string
+	}
+
+	SMTPConfig struct {
+		Host     string
+		Port     int
+		Username string
+		Password string
+	}
 )
 
 func Init(cfgPath, dbEnvPath, backendEnvPath string) (*Config, error) {
@@ -95,15 +227,118 @@ func Init(cfgPath, dbEnvPath, backendEnvPath string) (*Config, error) {
 			MaxHeaderBytes: v.GetInt("server.maxHeaderBytes"),
 		},
 		Handler: &HandlerConfig{
-			RequestTimeout: v.GetDuration("handler.requestTimeout"),
+			RequestTimeout:         v.GetDuration("handler.requestTimeout"),
+			FrontendURL:            v.GetString("handler.frontendURL"),
+			TrustProxy:             v.GetBool("handler.trustProxy"),
+			MaxUploadSize:          v.GetInt64("handler.maxUploadSize"),
+			MaxUploadFiles:         v.GetInt("handler.maxUploadFiles"),
+			MaxArchiveEntries:      v.GetInt("handler.maxArchiveEntries"),
+			MaxArchiveExpandedSize: v.GetInt64("handler.maxArchiveExpandedSize"),
+			MaxResumableUploadSize: v.GetInt64("handler.maxResumableUploadSize"),
+			ResumableUploadTimeout: v.GetDuration("handler.resumableUploadTimeout"),
 		},
 		Embedding: &EmbeddingConfig{
 			Host: v.GetString("embedding-service.host"),
 			Port: v.GetInt("embedding-service.port"),
 		},
+		OIDC: &OIDCConfig{
+			Enabled:      v.GetBool("oidc.enabled"),
+			Issuer:       v.GetString("oidc.issuer"),
+			ClientID:     v.GetString("oidc.clientID"),
+			ClientSecret: v.GetString("OIDC_CLIENT_SECRET"),
+			RedirectURL:  v.GetString("oidc.redirectURL"),
+			Scopes:       v.GetStringSlice("oidc.scopes"),
+		},
+		Mail: &MailConfig{
+			Driver:  v.GetString("mail.driver"),
+			From:    v.GetString("mail.from"),
+			BaseURL: v.GetString("mail.baseURL"),
+			Dir:     v.GetString("mail.dir"),
+			SMTP: &SMTPConfig{
+				Host:     v.GetString("mail.smtp.host"),
+				Port:     v.GetInt("mail.smtp.port"),
+				Username: v.GetString("mail.smtp.username"),
+				Password: v.GetString("SMTP_PASSWORD"),
+			},
+		},
+		RateLimit: &RateLimitConfig{
+			Store:           v.GetString("rateLimit.store"),
+			CleanupInterval: v.GetDuration("rateLimit.cleanupInterval"),
+			IP:              getRateLimitRule(v, "rateLimit.ip"),
+			Account:         getRateLimitRule(v, "rateLimit.account"),
+			ShareLink:       getRateLimitRule(v, "rateLimit.shareLink"),
+			Mail:            getRateLimitRule(v, "rateLimit.mail"),
+			Lockout: LockoutConfig{
+				Threshold:    v.GetInt("rateLimit.lockout.threshold"),
+				Window:       v.GetDuration("rateLimit.lockout.window"),
+				BaseDuration: v.GetDuration("rateLimit.lockout.baseDuration"),
+				MaxDuration:  v.GetDuration("rateLimit.lockout.maxDuration"),
+			},
+		},
+		TwoFactor: &TwoFactorConfig{
+			Issuer:        v.GetString("twoFactor.issuer"),
+			EncryptionKey: v.GetString("TOTP_ENCRYPTION_KEY"),
+			ChallengeTTL:  v.GetDuration("twoFactor.challengeTTL"),
+			MaxAttempts:   v.GetInt("twoFactor.maxAttempts"),
+		},
+		Admin: &AdminConfig{
+			Emails: v.GetStringSlice("admin.emails"),
+		},
+		Blob: &BlobConfig{
+			Driver: v.GetString("blob.driver"),
+			Dir:    v.GetString("blob.dir"),
+			S3: &S3Config{
+				Endpoint:  v.GetString("blob.s3.endpoint"),
+				Region:    v.GetString("blob.s3.region"),
+				Bucket:    v.GetString("blob.s3.bucket"),
+				AccessKey: v.GetString("S3_ACCESS_KEY"),
+				SecretKey: v.GetString("S3_SECRET_KEY"),
+				UseSSL:    v.GetBool("blob.s3.useSSL"),
+				PathStyle: v.GetBool("blob.s3.pathStyle"),
+			},
+		},
+		Quota: &QuotaConfig{
+			MaxBytes:          v.GetInt64("quota.maxBytes"),
+			MaxDocuments:      v.GetInt64("quota.maxDocuments"),
+			MaxChunks:         v.GetInt64("quota.maxChunks"),
+			MaxSearchesPerDay: v.GetInt64("quota.maxSearchesPerDay"),
+		},
+		Upload: &UploadConfig{
+			Dir:             v.GetString("upload.dir"),
+			ExpiresIn:       v.GetDuration("upload.expiresIn"),
+			CleanupInterval: v.GetDuration("upload.cleanupInterval"),
+			LockTimeout:     v.GetDuration("upload.lockTimeout"),
+		},
+		Trash: &TrashConfig{
+			Retention:     v.GetDuration("trash.retention"),
+			PurgeInterval: v.GetDuration("trash.purgeInterval"),
+		},
+		EmbeddingCounters: &EmbeddingCountersConfig{
+			ReconcileInterval: v.GetDuration("embeddingCounters.reconcileInterval"),
+		},
+		Webhook: &WebhookConfig{
+			PollInterval:         v.GetDuration("webhook.pollInterval"),
+			BatchSize:            v.GetInt("webhook.batchSize"),
+			Timeout:              v.GetDuration("webhook.timeout"),
+			MaxAttempts:          v.GetInt("webhook.maxAttempts"),
+			InitialBackoff:       v.GetDuration("webhook.initialBackoff"),
+			MaxBackoff:           v.GetDuration("webhook.maxBackoff"),
+			ProcessingTimeout:    v.GetDuration("webhook.processingTimeout"),
+			MaintenanceInterval:  v.GetDuration("webhook.maintenanceInterval"),
+			Retention:            v.GetDuration("webhook.retention"),
+			EncryptionKey:        v.GetString("WEBHOOK_ENCRYPTION_KEY"),
+			AllowPrivateNetworks: v.GetBool("webhook.allowPrivateNetworks"),
+		},
 	}, nil
 }
 
+func getRateLimitRule(v *viper.Viper, key string) RateLimitRule {
+	return RateLimitRule{
+		Limit:  v.GetInt(key + ".limit"),
+		Window: v.GetDuration(key + ".window"),
+	}
+}
+
 func (p *DbConfig) GetUrl() string {
 	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		p.Host, p.Port, p.User, p.Password, p.DB, p.SSLMode)
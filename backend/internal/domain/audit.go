@@ -0,0 +1,14 @@
+package domain
+
+const (
+	AuditPasswordChanged = "user.password_changed"
+	AuditAccountDeleted  = "user.deleted"
+	AuditUserDisabled    = "user.disabled"
+	AuditUserEnabled     = "user.enabled"
+)
+
+type AuditEvent struct {
+	UserID  int64
+	Action  string
+	Details map[string]any
+}
@@ -8,3 +8,35 @@ type RefreshToken struct {
 	TokenHash string
 	ExpiresAt time.Time
 }
+
+type OIDCLoginRequest struct {
+	AuthURL  string
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// Reauthentication — подтверждение личности перед изменением пароля, удалением аккаунта
+// или отключением двухфакторной аутентификации.
+type Reauthentication struct {
+	Password string
+	// Code — TOTP-код или код восстановления.
+	Code string
+	// OIDCAuthTime — время входа у OIDC-провайдера, если сессия получена через него.
+	OIDCAuthTime time.Time
+}
+
+type UserTokenPurpose string
+
+const (
+	UserTokenEmailVerification UserTokenPurpose = "email_verification"
+	UserTokenPasswordReset     UserTokenPurpose = "password_reset"
+)
+
+type UserToken struct {
+	ID        int64
+	UserID    int64
+	Purpose   UserTokenPurpose
+	TokenHash string
+	ExpiresAt time.Time
+}
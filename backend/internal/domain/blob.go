@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Blob — оригинальный загруженный файл, лежащий в хранилище блобов.
+type Blob struct {
+	ID          int64
+	StorageKey  string
+	SHA256      string
+	SizeBytes   int64
+	ContentType string
+	CreatedAt   time.Time
+}
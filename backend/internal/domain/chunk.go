@@ -1,10 +1,22 @@
 package domain
 
 type Chunk struct {
-	ID         int64
-	UserID     int64
-	DocumentID int64
-	Text       string
+	ID          int64
+	UserID      *int64
+	WorkspaceID int64
+	DocumentID  int64
+	Title       string
+	Text        string
+	// OverlapBytes — длина в байтах начала текста, повторяющего конец предыдущего чанка.
+	// nil у чанков, созданных до того, как перекрытие стало сохраняться.
+	OverlapBytes *int32
+	Embedded     bool
+}
+
+// ChunkText — чанк, полученный при разбиении текста документа, но еще не сохраненный.
+type ChunkText struct {
+	Text         string
+	OverlapBytes int32
 }
 
 type SearchResult struct {
@@ -1,9 +1,175 @@
 package domain
 
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	SharePermissionRead  = "read"
+	SharePermissionWrite = "write"
+)
+
 type Document struct {
 	ID              int64
-	UserID          int64
+	UserID          *int64
+	WorkspaceID     int64
+	WorkspaceRole   string
+	SharePermission string
+	SharedWithMe    bool
 	Filename        string
+	SizeBytes       int64
+	BlobID          *int64
+	ContentSHA256   string
+	CurrentVersion  int32
+	FolderID        *int64
+	Description     string
+	Tags            []string
+	Metadata        map[string]string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 	NullEmbeddings  int64
 	TotalEmbeddings int64
 }
+
+// DocumentMetadataUpdate — изменение описания, тегов и пользовательских полей документа.
+// Поле со значением nil не меняется; пустой срез или пустая карта очищают теги или поля.
+type DocumentMetadataUpdate struct {
+	Description *string
+	Tags        []string
+	Metadata    map[string]string
+}
+
+// SearchFilter ограничивает поиск документами, у которых есть все перечисленные теги и пользовательские поля.
+// Если задан FolderID, поиск идет только по документам из этой папки и вложенных в нее.
+type SearchFilter struct {
+	Tags     []string
+	Metadata map[string]string
+	FolderID *int64
+}
+
+const (
+	DocumentSortName      = "name"
+	DocumentSortCreatedAt = "createdAt"
+	DocumentSortSize      = "size"
+)
+
+const (
+	DocumentStatusProcessing = "processing"
+	DocumentStatusReady      = "ready"
+)
+
+// DocumentFilter ограничивает список документов. Пустые поля не применяются.
+// Filename ищется как подстрока без учета регистра, теги должны быть у документа все.
+type DocumentFilter struct {
+	WorkspaceID *int64
+	FolderIDs   []int64
+	Filename    string
+	Status      string
+	Tags        []string
+}
+
+// DocumentPosition — документ, после которого начинается следующая страница списка.
+// Заполнено только поле, соответствующее сортировке, и ID.
+type DocumentPosition struct {
+	Name      string
+	CreatedAt time.Time
+	SizeBytes int64
+	ID        int64
+}
+
+// DocumentListQuery — запрос списка документов. Без Limit и Cursor запрашиваются все документы,
+// иначе одна страница. Cursor — непрозрачная строка из NextCursor предыдущей страницы, полученной с той же сортировкой.
+type DocumentListQuery struct {
+	WorkspaceID *int64
+	FolderID    *int64
+	Filename    string
+	Status      string
+	Tags        []string
+	Sort        string
+	Descending  *bool
+	Cursor      string
+	Limit       int32
+}
+
+type DocumentPage struct {
+	Items      []Document
+	Total      int64
+	NextCursor string
+}
+
+// TrashedDocument — документ в корзине. После PurgeAt он будет удален окончательно.
+type TrashedDocument struct {
+	Document
+	DeletedAt time.Time
+	DeletedBy *int64
+	PurgeAt   time.Time
+}
+
+// DocumentVersion — одна из сохраненных версий документа. Текущей является последняя загруженная или восстановленная.
+type DocumentVersion struct {
+	ID            int64
+	DocumentID    int64
+	Version       int32
+	SizeBytes     int64
+	BlobID        *int64
+	ContentSHA256 string
+	CreatedBy     *int64
+	CreatedAt     time.Time
+	Chunks        int64
+}
+
+const (
+	DiffEqual   = "equal"
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+)
+
+// ChunkDiff — один шаг сравнения чанков двух версий документа.
+// Индексы указывают на позицию чанка в исходной (From) и новой (To) версии; для добавленных нет FromIndex, для удаленных — ToIndex.
+type ChunkDiff struct {
+	Op        string
+	FromIndex *int
+	ToIndex   *int
+	Text      string
+}
+
+type VersionDiff struct {
+	From    int32
+	To      int32
+	Added   int
+	Removed int
+	Equal   int
+	Chunks  []ChunkDiff
+}
+
+// CanEdit сообщает, может ли пользователь менять содержимое документа:
+// он владелец или редактор рабочего пространства либо ему выдан доступ на запись.
+func (d *Document) CanEdit() bool {
+	return CanWriteWorkspace(d.WorkspaceRole) || d.SharePermission == SharePermissionWrite
+}
+
+type DocumentShare struct {
+	UserID     int64
+	Email      string
+	Permission string
+	CreatedAt  time.Time
+}
+
+// DocumentContent — содержимое документа для скачивания: оригинальный файл или текст, собранный из чанков.
+type DocumentContent struct {
+	Filename    string
+	ContentType string
+	SizeBytes   int64
+	Body        io.ReadCloser
+}
+
+// DuplicateDocumentError возвращается при загрузке файла, содержимое которого уже есть в рабочем пространстве.
+type DuplicateDocumentError struct {
+	Document *Document
+}
+
+func (e *DuplicateDocumentError) Error() string {
+	return fmt.Sprintf("document with the same content already exists: %d", e.Document.ID)
+}
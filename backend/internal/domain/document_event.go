@@ -0,0 +1,25 @@
+package domain
+
+const (
+	DocumentEventProgress = "document.progress"
+	DocumentEventDeleted  = "document.deleted"
+)
+
+// DocumentEvent — изменение документа, которое отправляется в поток событий пользователям с доступом к нему.
+// Событие несет состояние документа целиком, поэтому пропущенные промежуточные события ничего не ломают.
+type DocumentEvent struct {
+	Type            string
+	DocumentID      int64
+	WorkspaceID     int64
+	CurrentVersion  int32
+	NullEmbeddings  int64
+	TotalEmbeddings int64
+}
+
+// Status возвращает статус обработки документа: пока у текущей версии есть чанки без эмбеддинга, он обрабатывается.
+func (e *DocumentEvent) Status() string {
+	if e.NullEmbeddings > 0 {
+		return DocumentStatusProcessing
+	}
+	return DocumentStatusReady
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Folder — папка для документов внутри рабочего пространства. Папка без ParentID находится в корне пространства.
+type Folder struct {
+	ID            int64
+	WorkspaceID   int64
+	ParentID      *int64
+	Name          string
+	CreatedBy     *int64
+	WorkspaceRole string
+	Documents     int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
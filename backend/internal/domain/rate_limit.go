@@ -0,0 +1,8 @@
+package domain
+
+import "time"
+
+type RateLimitCounter struct {
+	Count     int64
+	ExpiresAt time.Time
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+type ShareLink struct {
+	ID         int64
+	DocumentID int64
+	CreatedBy  int64
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (l *ShareLink) Active(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	return l.ExpiresAt == nil || l.ExpiresAt.After(now)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShareLinkActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name string
+		link ShareLink
+		want bool
+	}{
+		{name: "without expiration", link: ShareLink{}, want: true},
+		{name: "not expired", link: ShareLink{ExpiresAt: &future}, want: true},
+		{name: "expired", link: ShareLink{ExpiresAt: &past}, want: false},
+		{name: "expires right now", link: ShareLink{ExpiresAt: &now}, want: false},
+		{name: "revoked", link: ShareLink{RevokedAt: &past}, want: false},
+		{name: "revoked before expiration", link: ShareLink{ExpiresAt: &future, RevokedAt: &past}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.link.Active(now); got != tt.want {
+			t.Errorf("%s: Active = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type UserTOTP struct {
+	UserID          int64
+	SecretEncrypted string
+	Enabled         bool
+	LastUsedStep    int64
+}
+
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type LoginChallenge struct {
+	ID        int64
+	UserID    int64
+	Attempts  int32
+	ExpiresAt time.Time
+}
+
+type LoginResult struct {
+	AccessToken        string
+	RefreshToken       string
+	ChallengeToken     string
+	ChallengeExpiresAt time.Time
+}
+
+func (r *LoginResult) TwoFactorRequired() bool {
+	return r.ChallengeToken != ""
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// Upload — возобновляемая загрузка файла по протоколу tus.
+// После получения всех байт файл проходит обычную загрузку документа, и DocumentID указывает на результат.
+type Upload struct {
+	ID          string
+	UserID      int64
+	WorkspaceID int64
+	Filename    string
+	ContentType string
+	Length      int64
+	Offset      int64
+	DocumentID  *int64
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func (u *Upload) Complete() bool {
+	return u.Offset == u.Length
+}
+
+// UploadPart — часть данных загрузки, принятая одним PATCH-запросом и сохраненная в хранилище блобов.
+type UploadPart struct {
+	UploadID   string
+	Offset     int64
+	SizeBytes  int64
+	StorageKey string
+}
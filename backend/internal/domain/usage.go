@@ -0,0 +1,33 @@
+package domain
+
+import "fmt"
+
+const (
+	QuotaStorage   = "storage_bytes"
+	QuotaDocuments = "documents"
+	QuotaChunks    = "chunks"
+	QuotaSearches  = "searches_per_day"
+)
+
+// Usage — текущее потребление квот пользователем. Нулевой лимит означает отсутствие ограничения.
+type Usage struct {
+	SizeBytes         int64
+	MaxBytes          int64
+	Documents         int64
+	MaxDocuments      int64
+	Chunks            int64
+	MaxChunks         int64
+	SearchesToday     int64
+	MaxSearchesPerDay int64
+}
+
+// QuotaExceededError возвращается, когда действие превысило бы одну из квот пользователя.
+type QuotaExceededError struct {
+	Resource string
+	Limit    int64
+	Used     int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for %s: %d of %d used", e.Resource, e.Used, e.Limit)
+}
@@ -1,6 +1,21 @@
 package domain
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type User struct {
-	ID    int64
-	Email string
+	ID               int64
+	Email            string
+	EmailVerified    bool
+	Role             string
+	Disabled         bool
+	TwoFactorEnabled bool
+}
+
+type UserWithStats struct {
+	User
+	DocumentsCount int64
+	ChunksCount    int64
 }
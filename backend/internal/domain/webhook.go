@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	WebhookEventDocumentCreated = "document.created"
+	WebhookEventDocumentReady   = "document.ready"
+	WebhookEventDocumentFailed  = "document.failed"
+	WebhookEventDocumentDeleted = "document.deleted"
+)
+
+// WebhookEventTypes — события, на которые можно подписать вебхук.
+var WebhookEventTypes = []string{
+	WebhookEventDocumentCreated,
+	WebhookEventDocumentReady,
+	WebhookEventDocumentFailed,
+	WebhookEventDocumentDeleted,
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook — адрес, на который отправляются события документов рабочего пространства.
+// Пустой Events означает подписку на все события. Секрет используется для подписи тела запроса
+// и хранится зашифрованным; открытый Secret заполняется только при создании вебхука.
+type Webhook struct {
+	ID              int64
+	WorkspaceID     int64
+	URL             string
+	Secret          string
+	SecretEncrypted string
+	Events          []string
+	Enabled         bool
+	CreatedBy       *int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// WebhookUpdate — изменение вебхука. Поле со значением nil не меняется; пустой Events подписывает на все события.
+// Secret — новый секрет открытым текстом, в хранилище записывается SecretEncrypted.
+type WebhookUpdate struct {
+	URL             *string
+	Secret          *string
+	SecretEncrypted *string
+	Events          []string
+	Enabled         *bool
+}
+
+// WebhookDelivery — запись журнала доставки одного события одному вебхуку.
+// Повторная отправка вручную создает новую доставку того же события.
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        int64
+	EventType      string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  *time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int32
+	ResponseBody   string
+	Error          string
+	CreatedAt      time.Time
+}
+
+// PendingWebhookDelivery — доставка, взятая в отправку, вместе с адресом, зашифрованным секретом и событием.
+type PendingWebhookDelivery struct {
+	ID              int64
+	WebhookID       int64
+	Attempts        int32
+	URL             string
+	SecretEncrypted string
+	WorkspaceID     int64
+	Event           WebhookEvent
+}
+
+// WebhookEvent — событие из исходящего журнала. Data — JSON с описанием документа.
+type WebhookEvent struct {
+	ID        int64
+	Type      string
+	Data      json.RawMessage
+	CreatedAt time.Time
+}
+
+// WebhookAttempt — результат одной попытки доставки.
+type WebhookAttempt struct {
+	Status         string
+	NextAttemptAt  time.Time
+	ResponseStatus *int32
+	ResponseBody   string
+	Error          string
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleEditor = "editor"
+	WorkspaceRoleViewer = "viewer"
+)
+
+type Workspace struct {
+	ID        int64
+	Name      string
+	Personal  bool
+	Role      string
+	CreatedAt time.Time
+}
+
+type WorkspaceMember struct {
+	UserID    int64
+	Email     string
+	Role      string
+	CreatedAt time.Time
+}
+
+func CanWriteWorkspace(role string) bool {
+	return role == WorkspaceRoleOwner || role == WorkspaceRoleEditor
+}
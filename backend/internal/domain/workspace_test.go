@@ -0,0 +1,22 @@
+package domain
+
+import "testing"
+
+func TestCanWriteWorkspace(t *testing.T) {
+	tests := []struct {
+		role string
+		want bool
+	}{
+		{WorkspaceRoleOwner, true},
+		{WorkspaceRoleEditor, true},
+		{WorkspaceRoleViewer, false},
+		{"", false},
+		{"admin", false},
+	}
+
+	for _, tt := range tests {
+		if got := CanWriteWorkspace(tt.role); got != tt.want {
+			t.Errorf("CanWriteWorkspace(%q) = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func pageParams(page, size *int64) (int64, int64) {
+	p, s := int64(1), int64(defaultPageSize)
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	if size != nil && *size > 0 {
+		s = min(*size, maxPageSize)
+	}
+	return p, s
+}
+
+func adminUserToResponse(u *domain.UserWithStats) AdminUser {
+	return AdminUser{
+		Id:             u.ID,
+		Email:          openapi_types.Email(u.Email),
+		EmailVerified:  u.EmailVerified,
+		Role:           AdminUserRole(u.Role),
+		Disabled:       u.Disabled,
+		DocumentsCount: u.DocumentsCount,
+		ChunksCount:    u.ChunksCount,
+	}
+}
+
+func (h *handler) AdminListUsers(ctx context.Context, request AdminListUsersRequestObject) (AdminListUsersResponseObject, error) {
+	page, size := pageParams(request.Params.Page, request.Params.Size)
+
+	users, total, err := h.service.ListUsers(ctx, page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]AdminUser, len(users))
+	for i := range users {
+		items[i] = adminUserToResponse(&users[i])
+	}
+
+	return AdminListUsers200JSONResponse{
+		Items: items,
+		Total: total,
+	}, nil
+}
+
+func (h *handler) AdminGetUser(ctx context.Context, request AdminGetUserRequestObject) (AdminGetUserResponseObject, error) {
+	user, err := h.service.GetUserStats(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return AdminGetUser404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return AdminGetUser200JSONResponse(adminUserToResponse(user)), nil
+}
+
+func (h *handler) AdminDisableUser(ctx context.Context, request AdminDisableUserRequestObject) (AdminDisableUserResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	adminID := int64(claims["user_id"].(float64))
+
+	if err := h.service.SetUserDisabled(ctx, adminID, request.UserID, true); err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			return AdminDisableUser404Response{}, nil
+		case errors.Is(err, service.ErrCannotDisableSelf):
+			errorMessage := err.Error()
+			return AdminDisableUser400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return AdminDisableUser204Response{}, nil
+}
+
+func (h *handler) AdminEnableUser(ctx context.Context, request AdminEnableUserRequestObject) (AdminEnableUserResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	adminID := int64(claims["user_id"].(float64))
+
+	if err := h.service.SetUserDisabled(ctx, adminID, request.UserID, false); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return AdminEnableUser404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return AdminEnableUser204Response{}, nil
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -20,1134 +22,9906 @@ const (
 	CookieAuthScopes = "CookieAuth.Scopes"
 )
 
-// Document defines model for Document.
-type Document struct {
-	Filename        string `json:"filename"`
-	Id              int64  `json:"id"`
-	NullEmbeddings  int64  `json:"nullEmbeddings"`
-	TotalEmbeddings int64  `json:"totalEmbeddings"`
-	UserID          int64  `json:"userID"`
-}
+// Defines values for AdminUserRole.
+const (
+	AdminUserRoleAdmin AdminUserRole = "admin"
+	AdminUserRoleUser  AdminUserRole = "user"
+)
 
-// Error defines model for Error.
-type Error struct {
-	Error *string `json:"error,omitempty"`
-}
+// Defines values for ChunkDiffOp.
+const (
+	Added   ChunkDiffOp = "added"
+	Equal   ChunkDiffOp = "equal"
+	Removed ChunkDiffOp = "removed"
+)
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
-}
+// Defines values for DocumentEventType.
+const (
+	DocumentEventTypeDocumentDeleted  DocumentEventType = "document.deleted"
+	DocumentEventTypeDocumentProgress DocumentEventType = "document.progress"
+)
 
-// RegisterRequest defines model for RegisterRequest.
-type RegisterRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
-}
+// Defines values for DocumentStatus.
+const (
+	Processing DocumentStatus = "processing"
+	Ready      DocumentStatus = "ready"
+)
 
-// SearchRequest defines model for SearchRequest.
-type SearchRequest struct {
-	Query string `json:"query"`
-}
+// Defines values for QuotaErrorResource.
+const (
+	Chunks         QuotaErrorResource = "chunks"
+	Documents      QuotaErrorResource = "documents"
+	SearchesPerDay QuotaErrorResource = "searches_per_day"
+	StorageBytes   QuotaErrorResource = "storage_bytes"
+)
 
-// SearchResult defines model for SearchResult.
-type SearchResult struct {
-	Distance   *float64 `json:"distance,omitempty"`
-	DocumentID *int64   `json:"documentID,omitempty"`
-	Id         *int64   `json:"id,omitempty"`
-	Text       *string  `json:"text,omitempty"`
-	Title      *string  `json:"title,omitempty"`
-}
+// Defines values for SharePermission.
+const (
+	Read  SharePermission = "read"
+	Write SharePermission = "write"
+)
 
-// User defines model for User.
-type User struct {
-	Email *openapi_types.Email `json:"email,omitempty"`
-	Id    *int64               `json:"id,omitempty"`
-}
+// Defines values for UserRole.
+const (
+	UserRoleAdmin UserRole = "admin"
+	UserRoleUser  UserRole = "user"
+)
 
-// UploadDocumentMultipartBody defines parameters for UploadDocument.
-type UploadDocumentMultipartBody struct {
-	File *openapi_types.File `json:"file,omitempty"`
-}
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequest
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeDocumentCreated WebhookEventType = "document.created"
+	WebhookEventTypeDocumentDeleted WebhookEventType = "document.deleted"
+	WebhookEventTypeDocumentFailed  WebhookEventType = "document.failed"
+	WebhookEventTypeDocumentReady   WebhookEventType = "document.ready"
+)
 
-// RegisterJSONRequestBody defines body for Register for application/json ContentType.
-type RegisterJSONRequestBody = RegisterRequest
+// Defines values for WorkspaceRole.
+const (
+	Editor WorkspaceRole = "editor"
+	Owner  WorkspaceRole = "owner"
+	Viewer WorkspaceRole = "viewer"
+)
 
-// UploadDocumentMultipartRequestBody defines body for UploadDocument for multipart/form-data ContentType.
-type UploadDocumentMultipartRequestBody UploadDocumentMultipartBody
+// Defines values for ListUserDocumentsParamsSort.
+const (
+	CreatedAt ListUserDocumentsParamsSort = "createdAt"
+	Name      ListUserDocumentsParamsSort = "name"
+	Size      ListUserDocumentsParamsSort = "size"
+)
 
-// SearchJSONRequestBody defines body for Search for application/json ContentType.
-type SearchJSONRequestBody = SearchRequest
+// Defines values for ListUserDocumentsParamsOrder.
+const (
+	Asc  ListUserDocumentsParamsOrder = "asc"
+	Desc ListUserDocumentsParamsOrder = "desc"
+)
 
-// SearchInDocumentJSONRequestBody defines body for SearchInDocument for application/json ContentType.
-type SearchInDocumentJSONRequestBody = SearchRequest
+// Defines values for UploadDocumentParamsOnDuplicate.
+const (
+	Existing UploadDocumentParamsOnDuplicate = "existing"
+	Reject   UploadDocumentParamsOnDuplicate = "reject"
+)
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Выход со всех устройств
-	// (POST /auth/full_logout)
-	FullLogout(w http.ResponseWriter, r *http.Request)
-	// Вход в систему
-	// (POST /auth/login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// Выход из текущей сессии
-	// (POST /auth/logout)
-	Logout(w http.ResponseWriter, r *http.Request)
-	// Обновление пары токенов
-	// (POST /auth/refresh)
-	Refresh(w http.ResponseWriter, r *http.Request)
-	// Регистрация нового пользователя
-	// (POST /auth/register)
-	Register(w http.ResponseWriter, r *http.Request)
-	// Получить список всех документов пользователя
-	// (GET /documents)
-	ListUserDocuments(w http.ResponseWriter, r *http.Request)
-	// Загрузить новый документ
-	// (POST /documents)
-	UploadDocument(w http.ResponseWriter, r *http.Request)
-	// Семантический поиск по всем документам
-	// (POST /documents/search)
-	Search(w http.ResponseWriter, r *http.Request)
-	// Удалить документ
-	// (DELETE /documents/{documentID})
-	DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64)
-	// Получить информацию о конкретном документе
-	// (GET /documents/{documentID})
-	GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64)
-	// Семантический поиск по конкретному документу
-	// (POST /documents/{documentID}/search)
-	SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64)
-	// Проверка работоспособности сервера
-	// (GET /ping)
-	Ping(w http.ResponseWriter, r *http.Request)
-	// Получить профиль текущего пользователя
-	// (GET /users/me)
-	GetUserProfile(w http.ResponseWriter, r *http.Request)
+// AddWorkspaceMemberRequest defines model for AddWorkspaceMemberRequest.
+type AddWorkspaceMemberRequest struct {
+	Email openapi_types.Email `json:"email"`
+	Role  WorkspaceRole       `json:"role"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	ChunksCount    int64               `json:"chunksCount"`
+	Disabled       bool                `json:"disabled"`
+	DocumentsCount int64               `json:"documentsCount"`
+	Email          openapi_types.Email `json:"email"`
+	EmailVerified  bool                `json:"emailVerified"`
+	Id             int64               `json:"id"`
+	Role           AdminUserRole       `json:"role"`
+}
 
-type Unimplemented struct{}
+// AdminUserRole defines model for AdminUser.Role.
+type AdminUserRole string
 
-// Выход со всех устройств
-// (POST /auth/full_logout)
-func (_ Unimplemented) FullLogout(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// AdminUserList defines model for AdminUserList.
+type AdminUserList struct {
+	Items []AdminUser `json:"items"`
+	Total int64       `json:"total"`
 }
 
-// Вход в систему
-// (POST /auth/login)
-func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// BatchUploadResult defines model for BatchUploadResult.
+type BatchUploadResult struct {
+	Accepted int            `json:"accepted"`
+	Rejected int            `json:"rejected"`
+	Results  []UploadResult `json:"results"`
 }
 
-// Выход из текущей сессии
-// (POST /auth/logout)
-func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	// Code TOTP-код или код восстановления; подтверждает аккаунт без пароля
+	Code            *string `json:"code,omitempty"`
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+	NewPassword     string  `json:"newPassword"`
 }
 
-// Обновление пары токенов
-// (POST /auth/refresh)
-func (_ Unimplemented) Refresh(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Chunk defines model for Chunk.
+type Chunk struct {
+	DocumentID int64 `json:"documentID"`
 
-// Регистрация нового пользователя
-// (POST /auth/register)
-func (_ Unimplemented) Register(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Embedded Рассчитан ли эмбеддинг для чанка
+	Embedded *bool  `json:"embedded,omitempty"`
+	Id       int64  `json:"id"`
+	Text     string `json:"text"`
 }
 
-// Получить список всех документов пользователя
-// (GET /documents)
-func (_ Unimplemented) ListUserDocuments(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ChunkDiff defines model for ChunkDiff.
+type ChunkDiff struct {
+	// FromIndex Позиция чанка в версии from; нет у добавленных чанков
+	FromIndex *int        `json:"fromIndex,omitempty"`
+	Op        ChunkDiffOp `json:"op"`
+	Text      string      `json:"text"`
 
-// Загрузить новый документ
-// (POST /documents)
-func (_ Unimplemented) UploadDocument(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// ToIndex Позиция чанка в версии to; нет у удалённых чанков
+	ToIndex *int `json:"toIndex,omitempty"`
 }
 
-// Семантический поиск по всем документам
-// (POST /documents/search)
-func (_ Unimplemented) Search(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ChunkDiffOp defines model for ChunkDiff.Op.
+type ChunkDiffOp string
+
+// ChunkList defines model for ChunkList.
+type ChunkList struct {
+	Items []Chunk `json:"items"`
+	Total int64   `json:"total"`
 }
 
-// Удалить документ
-// (DELETE /documents/{documentID})
-func (_ Unimplemented) DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CreateFolderRequest defines model for CreateFolderRequest.
+type CreateFolderRequest struct {
+	Name     string `json:"name"`
+	ParentID *int64 `json:"parentID,omitempty"`
+
+	// WorkspaceID Рабочее пространство для папки в корне; по умолчанию личное
+	WorkspaceID *int64 `json:"workspaceID,omitempty"`
 }
 
-// Получить информацию о конкретном документе
-// (GET /documents/{documentID})
-func (_ Unimplemented) GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CreateShareLinkRequest defines model for CreateShareLinkRequest.
+type CreateShareLinkRequest struct {
+	// ExpiresAt Момент истечения ссылки, без него ссылка бессрочная
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 }
 
-// Семантический поиск по конкретному документу
-// (POST /documents/{documentID}/search)
-func (_ Unimplemented) SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Events События для подписки; если не указаны, вебхук получает все события
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Secret Секрет для подписи; если не указан, будет сгенерирован
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
 }
 
-// Проверка работоспособности сервера
-// (GET /ping)
-func (_ Unimplemented) Ping(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CreatedShareLink defines model for CreatedShareLink.
+type CreatedShareLink struct {
+	Active     bool       `json:"active"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	DocumentID int64      `json:"documentID"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         int64      `json:"id"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+
+	// Token Токен ссылки, возвращается только при создании
+	Token string `json:"token"`
 }
 
-// Получить профиль текущего пользователя
-// (GET /users/me)
-func (_ Unimplemented) GetUserProfile(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// DeleteAccountRequest defines model for DeleteAccountRequest.
+type DeleteAccountRequest struct {
+	// Code TOTP-код или код восстановления; подтверждает аккаунт без пароля
+	Code     *string `json:"code,omitempty"`
+	Password *string `json:"password,omitempty"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// DeleteFolderResult defines model for DeleteFolderResult.
+type DeleteFolderResult struct {
+	// TrashedDocuments Сколько документов перемещено в корзину
+	TrashedDocuments int `json:"trashedDocuments"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// DisableTwoFactorRequest defines model for DisableTwoFactorRequest.
+type DisableTwoFactorRequest struct {
+	Code     string  `json:"code"`
+	Password *string `json:"password,omitempty"`
+}
 
-// FullLogout operation middleware
-func (siw *ServerInterfaceWrapper) FullLogout(w http.ResponseWriter, r *http.Request) {
+// Document defines model for Document.
+type Document struct {
+	// ContentSHA256 SHA-256 нормализованного содержимого документа
+	ContentSHA256 *string   `json:"contentSHA256,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
 
-	ctx := r.Context()
+	// CurrentVersion Номер текущей версии документа
+	CurrentVersion int32  `json:"currentVersion"`
+	Description    string `json:"description"`
+	Filename       string `json:"filename"`
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// FolderID Папка документа; отсутствует, если документ в корне рабочего пространства
+	FolderID *int64 `json:"folderID,omitempty"`
+	Id       int64  `json:"id"`
 
-	r = r.WithContext(ctx)
+	// Metadata Пользовательские поля документа — пары ключ/значение
+	Metadata        DocumentMetadata `json:"metadata"`
+	NullEmbeddings  int64            `json:"nullEmbeddings"`
+	SharePermission *SharePermission `json:"sharePermission,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FullLogout(w, r)
-	}))
+	// SharedWithMe Документ доступен пользователю только потому, что им поделились
+	SharedWithMe    bool     `json:"sharedWithMe"`
+	Tags            []string `json:"tags"`
+	TotalEmbeddings int64    `json:"totalEmbeddings"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// UpdatedAt Время последнего изменения содержимого или метаданных
+	UpdatedAt time.Time `json:"updatedAt"`
 
-	handler.ServeHTTP(w, r)
+	// UserID ID пользователя, загрузившего документ. Отсутствует, если его аккаунт удален.
+	UserID      *int64 `json:"userID,omitempty"`
+	WorkspaceID int64  `json:"workspaceID"`
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+// DocumentEvent defines model for DocumentEvent.
+type DocumentEvent struct {
+	CurrentVersion int32 `json:"currentVersion"`
+	DocumentID     int64 `json:"documentID"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// NullEmbeddings Сколько чанков текущей версии еще ждут эмбеддинга
+	NullEmbeddings int64          `json:"nullEmbeddings"`
+	Status         DocumentStatus `json:"status"`
 
-	handler.ServeHTTP(w, r)
+	// TotalEmbeddings Сколько всего чанков в текущей версии
+	TotalEmbeddings int64             `json:"totalEmbeddings"`
+	Type            DocumentEventType `json:"type"`
+	WorkspaceID     int64             `json:"workspaceID"`
 }
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+// DocumentEventType defines model for DocumentEventType.
+type DocumentEventType string
 
-	ctx := r.Context()
+// DocumentMetadata Пользовательские поля документа — пары ключ/значение
+type DocumentMetadata map[string]string
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// DocumentShare defines model for DocumentShare.
+type DocumentShare struct {
+	CreatedAt  time.Time           `json:"createdAt"`
+	Email      openapi_types.Email `json:"email"`
+	Permission SharePermission     `json:"permission"`
+	UserID     int64               `json:"userID"`
+}
 
-	r = r.WithContext(ctx)
+// DocumentStatus defines model for DocumentStatus.
+type DocumentStatus string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(w, r)
-	}))
+// DocumentVersion defines model for DocumentVersion.
+type DocumentVersion struct {
+	// Chunks Количество чанков версии
+	Chunks int64 `json:"chunks"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ContentSHA256 SHA-256 нормализованного содержимого версии
+	ContentSHA256 *string   `json:"contentSHA256,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
 
-	handler.ServeHTTP(w, r)
+	// CreatedBy Пользователь, загрузивший или восстановивший версию
+	CreatedBy *int64 `json:"createdBy,omitempty"`
+	SizeBytes int64  `json:"sizeBytes"`
+	Version   int32  `json:"version"`
 }
 
-// Refresh operation middleware
-func (siw *ServerInterfaceWrapper) Refresh(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Refresh(w, r)
-	}))
+// DuplicateDocumentError defines model for DuplicateDocumentError.
+type DuplicateDocumentError struct {
+	Document Document `json:"document"`
+	Error    string   `json:"error"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// EmptyTrashResult defines model for EmptyTrashResult.
+type EmptyTrashResult struct {
+	// Deleted Количество окончательно удалённых документов
+	Deleted int `json:"deleted"`
+}
 
-	handler.ServeHTTP(w, r)
+// Error defines model for Error.
+type Error struct {
+	Error *string `json:"error,omitempty"`
 }
 
-// Register operation middleware
-func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+// Folder defines model for Folder.
+type Folder struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Register(w, r)
-	}))
+	// Documents Количество документов непосредственно в папке
+	Documents int64  `json:"documents"`
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ParentID Родительская папка; отсутствует у папок в корне пространства
+	ParentID    *int64        `json:"parentID,omitempty"`
+	Role        WorkspaceRole `json:"role"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	WorkspaceID int64         `json:"workspaceID"`
+}
 
-	handler.ServeHTTP(w, r)
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
 }
 
-// ListUserDocuments operation middleware
-func (siw *ServerInterfaceWrapper) ListUserDocuments(w http.ResponseWriter, r *http.Request) {
+// LoginResponse defines model for LoginResponse.
+type LoginResponse = map[string]interface{}
 
-	ctx := r.Context()
+// MoveFolderRequest defines model for MoveFolderRequest.
+type MoveFolderRequest struct {
+	// ParentID Новая родительская папка; без нее папка переносится в корень пространства
+	ParentID *int64 `json:"parentID,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
 
-	r = r.WithContext(ctx)
+// QuotaError defines model for QuotaError.
+type QuotaError struct {
+	Error string `json:"error"`
+	Limit *int64 `json:"limit,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserDocuments(w, r)
-	}))
+	// Resource Превышенная квота
+	Resource *QuotaErrorResource `json:"resource,omitempty"`
+	Used     *int64              `json:"used,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// QuotaErrorResource Превышенная квота
+type QuotaErrorResource string
 
-	handler.ServeHTTP(w, r)
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	// Limit Лимит квоты, отсутствует, если ограничения нет
+	Limit *int64 `json:"limit,omitempty"`
+	Used  int64  `json:"used"`
 }
 
-// UploadDocument operation middleware
-func (siw *ServerInterfaceWrapper) UploadDocument(w http.ResponseWriter, r *http.Request) {
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
 
-	ctx := r.Context()
+// RegisterRequest defines model for RegisterRequest.
+type RegisterRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// RenameFolderRequest defines model for RenameFolderRequest.
+type RenameFolderRequest struct {
+	Name string `json:"name"`
+}
 
-	r = r.WithContext(ctx)
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadDocument(w, r)
-	}))
+// SearchRequest defines model for SearchRequest.
+type SearchRequest struct {
+	// FolderID Искать только в документах из этой папки и вложенных в нее
+	FolderID *int64 `json:"folderID,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Metadata Пользовательские поля документа — пары ключ/значение
+	Metadata *DocumentMetadata `json:"metadata,omitempty"`
+	Query    string            `json:"query"`
 
-	handler.ServeHTTP(w, r)
+	// Tags Искать только в документах, у которых есть все эти теги
+	Tags *[]string `json:"tags,omitempty"`
+
+	// WorkspaceID Искать только в этом рабочем пространстве
+	WorkspaceID *int64 `json:"workspaceID,omitempty"`
 }
 
-// Search operation middleware
-func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Distance   *float64 `json:"distance,omitempty"`
+	DocumentID *int64   `json:"documentID,omitempty"`
+	Id         *int64   `json:"id,omitempty"`
+	Text       *string  `json:"text,omitempty"`
+	Title      *string  `json:"title,omitempty"`
+}
 
-	ctx := r.Context()
+// SetDocumentFolderRequest defines model for SetDocumentFolderRequest.
+type SetDocumentFolderRequest struct {
+	// FolderID Папка для документа; без нее документ переносится в корень пространства
+	FolderID *int64 `json:"folderID,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// ShareDocumentRequest defines model for ShareDocumentRequest.
+type ShareDocumentRequest struct {
+	Email      openapi_types.Email `json:"email"`
+	Permission SharePermission     `json:"permission"`
+}
 
-	r = r.WithContext(ctx)
+// ShareLink defines model for ShareLink.
+type ShareLink struct {
+	Active     bool       `json:"active"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	DocumentID int64      `json:"documentID"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	Id         int64      `json:"id"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Search(w, r)
-	}))
+// SharePermission defines model for SharePermission.
+type SharePermission string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SharedDocument defines model for SharedDocument.
+type SharedDocument struct {
+	Chunks   []Chunk `json:"chunks"`
+	Filename string  `json:"filename"`
+	Id       int64   `json:"id"`
+}
 
-	handler.ServeHTTP(w, r)
+// TokenRequest defines model for TokenRequest.
+type TokenRequest struct {
+	Token string `json:"token"`
 }
 
-// DeleteDocument operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDocument(w http.ResponseWriter, r *http.Request) {
+// TrashedDocument defines model for TrashedDocument.
+type TrashedDocument struct {
+	DeletedAt time.Time `json:"deletedAt"`
 
-	var err error
+	// DeletedBy Пользователь, удаливший документ
+	DeletedBy *int64   `json:"deletedBy,omitempty"`
+	Document  Document `json:"document"`
 
-	// ------------- Path parameter "documentID" -------------
-	var documentID int64
+	// PurgeAt Время, после которого документ будет удалён окончательно
+	PurgeAt time.Time `json:"purgeAt"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
-		return
-	}
+// TwoFactorChallenge defines model for TwoFactorChallenge.
+type TwoFactorChallenge struct {
+	ChallengeToken string    `json:"challengeToken"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
 
-	ctx := r.Context()
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	ProvisioningURI string `json:"provisioningURI"`
+	Secret          string `json:"secret"`
+}
 
-	r = r.WithContext(ctx)
+// TwoFactorVerifyRequest defines model for TwoFactorVerifyRequest.
+type TwoFactorVerifyRequest struct {
+	ChallengeToken string `json:"challengeToken"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDocument(w, r, documentID)
-	}))
+	// Code 6-значный TOTP-код или код восстановления
+	Code string `json:"code"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// UpdateDocumentRequest Переданные поля заменяются целиком, отсутствующие не меняются
+type UpdateDocumentRequest struct {
+	Description *string `json:"description,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Metadata Пользовательские поля документа — пары ключ/значение
+	Metadata *DocumentMetadata `json:"metadata,omitempty"`
+	Tags     *[]string         `json:"tags,omitempty"`
 }
 
-// GetDocumentByID operation middleware
-func (siw *ServerInterfaceWrapper) GetDocumentByID(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	// Enabled Доставки выключенного вебхука копятся и отправляются после включения
+	Enabled *bool               `json:"enabled,omitempty"`
+	Events  *[]WebhookEventType `json:"events,omitempty"`
+	Secret  *string             `json:"secret,omitempty"`
+	Url     *string             `json:"url,omitempty"`
+}
 
-	var err error
+// UpdateWorkspaceMemberRequest defines model for UpdateWorkspaceMemberRequest.
+type UpdateWorkspaceMemberRequest struct {
+	Role WorkspaceRole `json:"role"`
+}
 
-	// ------------- Path parameter "documentID" -------------
-	var documentID int64
+// UploadResult defines model for UploadResult.
+type UploadResult struct {
+	Accepted bool `json:"accepted"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
-		return
-	}
+	// Archive Архив, из которого извлечён файл
+	Archive  *string   `json:"archive,omitempty"`
+	Document *Document `json:"document,omitempty"`
 
-	ctx := r.Context()
+	// Duplicate Файл совпал по содержимому с уже загруженным документом, который указан в document
+	Duplicate *bool `json:"duplicate,omitempty"`
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// Error Причина отклонения файла
+	Error *string `json:"error,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Filename Имя файла или путь внутри архива
+	Filename string `json:"filename"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDocumentByID(w, r, documentID)
-	}))
+// Usage Потребление квот загруженными пользователем документами и версиями; документы в корзине не учитываются.
+type Usage struct {
+	Chunks        QuotaUsage `json:"chunks"`
+	Documents     QuotaUsage `json:"documents"`
+	SearchesToday QuotaUsage `json:"searchesToday"`
+	StorageBytes  QuotaUsage `json:"storageBytes"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// User defines model for User.
+type User struct {
+	Email            *openapi_types.Email `json:"email,omitempty"`
+	EmailVerified    *bool                `json:"emailVerified,omitempty"`
+	Id               *int64               `json:"id,omitempty"`
+	Role             *UserRole            `json:"role,omitempty"`
+	TwoFactorEnabled *bool                `json:"twoFactorEnabled,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Usage Потребление квот загруженными пользователем документами и версиями; документы в корзине не учитываются.
+	Usage *Usage `json:"usage,omitempty"`
 }
 
-// SearchInDocument operation middleware
-func (siw *ServerInterfaceWrapper) SearchInDocument(w http.ResponseWriter, r *http.Request) {
+// UserRole defines model for User.Role.
+type UserRole string
 
-	var err error
+// VersionDiff defines model for VersionDiff.
+type VersionDiff struct {
+	Added   int         `json:"added"`
+	Chunks  []ChunkDiff `json:"chunks"`
+	Equal   int         `json:"equal"`
+	From    int32       `json:"from"`
+	Removed int         `json:"removed"`
+	To      int32       `json:"to"`
+}
 
-	// ------------- Path parameter "documentID" -------------
-	var documentID int64
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Enabled   bool      `json:"enabled"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
-		return
-	}
+	// Events События, на которые подписан вебхук; пустой список означает все события
+	Events []WebhookEventType `json:"events"`
+	Id     int64              `json:"id"`
 
-	ctx := r.Context()
+	// Secret Секрет для проверки подписи; возвращается только при создании вебхука
+	Secret      *string   `json:"secret,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Url         string    `json:"url"`
+	WorkspaceID int64     `json:"workspaceID"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int32     `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
 
-	r = r.WithContext(ctx)
+	// Error Причина неудачи последней попытки
+	Error   *string `json:"error,omitempty"`
+	EventID int64   `json:"eventID"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SearchInDocument(w, r, documentID)
-	}))
+	// EventType document.created — документ загружен; document.ready — у текущей версии посчитаны все эмбеддинги и она доступна для поиска;
+	// document.failed — эмбеддинги текущей версии не посчитаны за отведенное время; document.deleted — документ перенесен в корзину.
+	EventType     WebhookEventType `json:"eventType"`
+	Id            int64            `json:"id"`
+	LastAttemptAt *time.Time       `json:"lastAttemptAt,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// NextAttemptAt Время следующей попытки, пока доставка не завершена
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// ResponseBody Начало тела ответа получателя на последнюю попытку
+	ResponseBody *string `json:"responseBody,omitempty"`
+
+	// ResponseStatus HTTP-статус ответа получателя на последнюю попытку
+	ResponseStatus *int32                `json:"responseStatus,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookID      int64                 `json:"webhookID"`
 }
 
-// Ping operation middleware
-func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Ping(w, r)
-	}))
+// WebhookEventType document.created — документ загружен; document.ready — у текущей версии посчитаны все эмбеддинги и она доступна для поиска;
+// document.failed — эмбеддинги текущей версии не посчитаны за отведенное время; document.deleted — документ перенесен в корзину.
+type WebhookEventType string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Workspace defines model for Workspace.
+type Workspace struct {
+	CreatedAt time.Time     `json:"createdAt"`
+	Id        int64         `json:"id"`
+	Name      string        `json:"name"`
+	Personal  bool          `json:"personal"`
+	Role      WorkspaceRole `json:"role"`
+}
 
-	handler.ServeHTTP(w, r)
+// WorkspaceMember defines model for WorkspaceMember.
+type WorkspaceMember struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Email     openapi_types.Email `json:"email"`
+	Role      WorkspaceRole       `json:"role"`
+	UserID    int64               `json:"userID"`
 }
 
-// GetUserProfile operation middleware
-func (siw *ServerInterfaceWrapper) GetUserProfile(w http.ResponseWriter, r *http.Request) {
+// WorkspaceRequest defines model for WorkspaceRequest.
+type WorkspaceRequest struct {
+	Name string `json:"name"`
+}
 
-	ctx := r.Context()
+// WorkspaceRole defines model for WorkspaceRole.
+type WorkspaceRole string
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// FolderIDPath defines model for FolderIDPath.
+type FolderIDPath = int64
 
-	r = r.WithContext(ctx)
+// FolderIDQuery defines model for FolderIDQuery.
+type FolderIDQuery = int64
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserProfile(w, r)
-	}))
+// ShareLinkToken defines model for ShareLinkToken.
+type ShareLinkToken = string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TusResumable defines model for TusResumable.
+type TusResumable = string
 
-	handler.ServeHTTP(w, r)
-}
+// UploadID defines model for UploadID.
+type UploadID = string
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// WebhookIDPath defines model for WebhookIDPath.
+type WebhookIDPath = int64
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// WorkspaceIDPath defines model for WorkspaceIDPath.
+type WorkspaceIDPath = int64
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// WorkspaceIDQuery defines model for WorkspaceIDQuery.
+type WorkspaceIDQuery = int64
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// SearchQuotaExceeded defines model for SearchQuotaExceeded.
+type SearchQuotaExceeded = QuotaError
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
+// AdminListUsersParams defines parameters for AdminListUsers.
+type AdminListUsersParams struct {
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Size *int64 `form:"size,omitempty" json:"size,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// OidcCallbackParams defines parameters for OidcCallback.
+type OidcCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// OidcLoginParams defines parameters for OidcLogin.
+type OidcLoginParams struct {
+	// Reauth Потребовать у провайдера повторного ввода учетных данных, даже если сессия у него уже есть
+	Reauth *bool `form:"reauth,omitempty" json:"reauth,omitempty"`
 }
 
-type RequiredParamError struct {
-	ParamName string
+// ListUserDocumentsParams defines parameters for ListUserDocuments.
+type ListUserDocumentsParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
+
+	// FolderID ID папки; учитываются документы из нее и всех вложенных папок
+	FolderID *FolderIDQuery `form:"folderID,omitempty" json:"folderID,omitempty"`
+
+	// Filename Подстрока имени файла, без учета регистра
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty"`
+
+	// Status processing — есть необработанные эмбеддинги, ready — все эмбеддинги посчитаны
+	Status *DocumentStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tag Теги, которые должны быть у документа; можно передать несколько раз
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListUserDocumentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки; по умолчанию asc для name и desc для остальных полей
+	Order *ListUserDocumentsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор следующей страницы из заголовка X-Next-Cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы; если передан только cursor, страница содержит 20 документов
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// ListUserDocumentsParamsSort defines parameters for ListUserDocuments.
+type ListUserDocumentsParamsSort string
+
+// ListUserDocumentsParamsOrder defines parameters for ListUserDocuments.
+type ListUserDocumentsParamsOrder string
+
+// UploadDocumentMultipartBody defines parameters for UploadDocument.
+type UploadDocumentMultipartBody struct {
+	File *[]openapi_types.File `json:"file,omitempty"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// UploadDocumentParams defines parameters for UploadDocument.
+type UploadDocumentParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
+
+	// OnDuplicate Что делать с файлом, содержимое которого уже загружено в рабочее пространство
+	OnDuplicate *UploadDocumentParamsOnDuplicate `form:"onDuplicate,omitempty" json:"onDuplicate,omitempty"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// UploadDocumentParamsOnDuplicate defines parameters for UploadDocument.
+type UploadDocumentParamsOnDuplicate string
+
+// EmptyTrashParams defines parameters for EmptyTrash.
+type EmptyTrashParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// ListTrashParams defines parameters for ListTrash.
+type ListTrashParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// UploadDocumentVersionMultipartBody defines parameters for UploadDocumentVersion.
+type UploadDocumentVersionMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// ListDocumentChunksParams defines parameters for ListDocumentChunks.
+type ListDocumentChunksParams struct {
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Size *int64 `form:"size,omitempty" json:"size,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// DiffDocumentVersionsParams defines parameters for DiffDocumentVersions.
+type DiffDocumentVersionsParams struct {
+	From int32 `form:"from" json:"from"`
+	To   int32 `form:"to" json:"to"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// ListFoldersParams defines parameters for ListFolders.
+type ListFoldersParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// CreateUploadParams defines parameters for CreateUpload.
+type CreateUploadParams struct {
+	// WorkspaceID ID рабочего пространства
+	WorkspaceID *WorkspaceIDQuery `form:"workspaceID,omitempty" json:"workspaceID,omitempty"`
+
+	// TusResumable Версия протокола tus, которую использует клиент
+	TusResumable   TusResumable `json:"Tus-Resumable"`
+	UploadLength   int64        `json:"Upload-Length"`
+	UploadMetadata *string      `json:"Upload-Metadata,omitempty"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// DeleteUploadParams defines parameters for DeleteUpload.
+type DeleteUploadParams struct {
+	// TusResumable Версия протокола tus, которую использует клиент
+	TusResumable TusResumable `json:"Tus-Resumable"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// GetUploadOffsetParams defines parameters for GetUploadOffset.
+type GetUploadOffsetParams struct {
+	// TusResumable Версия протокола tus, которую использует клиент
+	TusResumable TusResumable `json:"Tus-Resumable"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// AppendUploadParams defines parameters for AppendUpload.
+type AppendUploadParams struct {
+	// TusResumable Версия протокола tus, которую использует клиент
+	TusResumable   TusResumable `json:"Tus-Resumable"`
+	UploadOffset   int64        `json:"Upload-Offset"`
+	UploadChecksum *string      `json:"Upload-Checksum,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Size *int64 `form:"size,omitempty" json:"size,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// DisableTwoFactorJSONRequestBody defines body for DisableTwoFactor for application/json ContentType.
+type DisableTwoFactorJSONRequestBody = DisableTwoFactorRequest
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// ConfirmTwoFactorEnrollmentJSONRequestBody defines body for ConfirmTwoFactorEnrollment for application/json ContentType.
+type ConfirmTwoFactorEnrollmentJSONRequestBody = TwoFactorCodeRequest
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/full_logout", wrapper.FullLogout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/login", wrapper.Login)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/logout", wrapper.Logout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/refresh", wrapper.Refresh)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/register", wrapper.Register)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/documents", wrapper.ListUserDocuments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/documents", wrapper.UploadDocument)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/documents/search", wrapper.Search)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/documents/{documentID}", wrapper.DeleteDocument)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/documents/{documentID}", wrapper.GetDocumentByID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/documents/{documentID}/search", wrapper.SearchInDocument)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/ping", wrapper.Ping)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/me", wrapper.GetUserProfile)
-	})
+// VerifyTwoFactorLoginJSONRequestBody defines body for VerifyTwoFactorLogin for application/json ContentType.
+type VerifyTwoFactorLoginJSONRequestBody = TwoFactorVerifyRequest
 
-	return r
-}
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
 
-type FullLogoutRequestObject struct {
-}
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
 
-type FullLogoutResponseObject interface {
-	VisitFullLogoutResponse(w http.ResponseWriter) error
+// RequestPasswordResetJSONRequestBody defines body for RequestPasswordReset for application/json ContentType.
+type RequestPasswordResetJSONRequestBody = PasswordResetRequest
+
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = RegisterRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = TokenRequest
+
+// UploadDocumentMultipartRequestBody defines body for UploadDocument for multipart/form-data ContentType.
+type UploadDocumentMultipartRequestBody UploadDocumentMultipartBody
+
+// SearchJSONRequestBody defines body for Search for application/json ContentType.
+type SearchJSONRequestBody = SearchRequest
+
+// UpdateDocumentJSONRequestBody defines body for UpdateDocument for application/json ContentType.
+type UpdateDocumentJSONRequestBody = UpdateDocumentRequest
+
+// UploadDocumentVersionMultipartRequestBody defines body for UploadDocumentVersion for multipart/form-data ContentType.
+type UploadDocumentVersionMultipartRequestBody UploadDocumentVersionMultipartBody
+
+// SetDocumentFolderJSONRequestBody defines body for SetDocumentFolder for application/json ContentType.
+type SetDocumentFolderJSONRequestBody = SetDocumentFolderRequest
+
+// CreateShareLinkJSONRequestBody defines body for CreateShareLink for application/json ContentType.
+type CreateShareLinkJSONRequestBody = CreateShareLinkRequest
+
+// SearchInDocumentJSONRequestBody defines body for SearchInDocument for application/json ContentType.
+type SearchInDocumentJSONRequestBody = SearchRequest
+
+// ShareDocumentJSONRequestBody defines body for ShareDocument for application/json ContentType.
+type ShareDocumentJSONRequestBody = ShareDocumentRequest
+
+// CreateFolderJSONRequestBody defines body for CreateFolder for application/json ContentType.
+type CreateFolderJSONRequestBody = CreateFolderRequest
+
+// RenameFolderJSONRequestBody defines body for RenameFolder for application/json ContentType.
+type RenameFolderJSONRequestBody = RenameFolderRequest
+
+// MoveFolderJSONRequestBody defines body for MoveFolder for application/json ContentType.
+type MoveFolderJSONRequestBody = MoveFolderRequest
+
+// SearchSharedDocumentJSONRequestBody defines body for SearchSharedDocument for application/json ContentType.
+type SearchSharedDocumentJSONRequestBody = SearchRequest
+
+// DeleteAccountJSONRequestBody defines body for DeleteAccount for application/json ContentType.
+type DeleteAccountJSONRequestBody = DeleteAccountRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = WorkspaceRequest
+
+// RenameWorkspaceJSONRequestBody defines body for RenameWorkspace for application/json ContentType.
+type RenameWorkspaceJSONRequestBody = WorkspaceRequest
+
+// AddWorkspaceMemberJSONRequestBody defines body for AddWorkspaceMember for application/json ContentType.
+type AddWorkspaceMemberJSONRequestBody = AddWorkspaceMemberRequest
+
+// UpdateWorkspaceMemberJSONRequestBody defines body for UpdateWorkspaceMember for application/json ContentType.
+type UpdateWorkspaceMemberJSONRequestBody = UpdateWorkspaceMemberRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Список пользователей с количеством документов и чанков
+	// (GET /admin/users)
+	AdminListUsers(w http.ResponseWriter, r *http.Request, params AdminListUsersParams)
+	// Пользователь с количеством документов и чанков
+	// (GET /admin/users/{userID})
+	AdminGetUser(w http.ResponseWriter, r *http.Request, userID int64)
+	// Заблокировать пользователя
+	// (POST /admin/users/{userID}/disable)
+	AdminDisableUser(w http.ResponseWriter, r *http.Request, userID int64)
+	// Разблокировать пользователя
+	// (POST /admin/users/{userID}/enable)
+	AdminEnableUser(w http.ResponseWriter, r *http.Request, userID int64)
+	// Отключить двухфакторную аутентификацию
+	// (POST /auth/2fa/disable)
+	DisableTwoFactor(w http.ResponseWriter, r *http.Request)
+	// Начать подключение TOTP
+	// (POST /auth/2fa/enroll)
+	BeginTwoFactorEnrollment(w http.ResponseWriter, r *http.Request)
+	// Подтвердить подключение TOTP первым кодом
+	// (POST /auth/2fa/enroll/confirm)
+	ConfirmTwoFactorEnrollment(w http.ResponseWriter, r *http.Request)
+	// Второй шаг входа - проверка TOTP-кода или кода восстановления
+	// (POST /auth/2fa/verify)
+	VerifyTwoFactorLogin(w http.ResponseWriter, r *http.Request)
+	// Выход со всех устройств
+	// (POST /auth/full_logout)
+	FullLogout(w http.ResponseWriter, r *http.Request)
+	// Вход в систему
+	// (POST /auth/login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Выход из текущей сессии
+	// (POST /auth/logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Обработка ответа OIDC-провайдера
+	// (GET /auth/oidc/callback)
+	OidcCallback(w http.ResponseWriter, r *http.Request, params OidcCallbackParams)
+	// Вход через OIDC-провайдера
+	// (GET /auth/oidc/login)
+	OidcLogin(w http.ResponseWriter, r *http.Request, params OidcLoginParams)
+	// Установить новый пароль по одноразовому токену из письма
+	// (POST /auth/password-reset)
+	ResetPassword(w http.ResponseWriter, r *http.Request)
+	// Запросить письмо для сброса пароля
+	// (POST /auth/password-reset/request)
+	RequestPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Обновление пары токенов
+	// (POST /auth/refresh)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	// Регистрация нового пользователя
+	// (POST /auth/register)
+	Register(w http.ResponseWriter, r *http.Request)
+	// Подтверждение email по одноразовому токену из письма
+	// (POST /auth/verify-email)
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
+	// Повторно отправить письмо для подтверждения email
+	// (POST /auth/verify-email/request)
+	RequestEmailVerification(w http.ResponseWriter, r *http.Request)
+	// Получить список всех документов пользователя
+	// (GET /documents)
+	ListUserDocuments(w http.ResponseWriter, r *http.Request, params ListUserDocumentsParams)
+	// Загрузить новый документ
+	// (POST /documents)
+	UploadDocument(w http.ResponseWriter, r *http.Request, params UploadDocumentParams)
+	// Семантический поиск по всем документам
+	// (POST /documents/search)
+	Search(w http.ResponseWriter, r *http.Request)
+	// Очистить корзину
+	// (DELETE /documents/trash)
+	EmptyTrash(w http.ResponseWriter, r *http.Request, params EmptyTrashParams)
+	// Список документов в корзине
+	// (GET /documents/trash)
+	ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams)
+	// Удалить документ
+	// (DELETE /documents/{documentID})
+	DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Получить информацию о конкретном документе
+	// (GET /documents/{documentID})
+	GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Изменить описание, теги и пользовательские поля документа
+	// (PATCH /documents/{documentID})
+	UpdateDocument(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Загрузить новую версию документа
+	// (PUT /documents/{documentID})
+	UploadDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Список чанков документа
+	// (GET /documents/{documentID}/chunks)
+	ListDocumentChunks(w http.ResponseWriter, r *http.Request, documentID int64, params ListDocumentChunksParams)
+	// Скачать содержимое документа
+	// (GET /documents/{documentID}/content)
+	GetDocumentContent(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Сравнить две версии документа
+	// (GET /documents/{documentID}/diff)
+	DiffDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64, params DiffDocumentVersionsParams)
+	// Переместить документ в папку
+	// (PUT /documents/{documentID}/folder)
+	SetDocumentFolder(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Список публичных ссылок на документ
+	// (GET /documents/{documentID}/links)
+	ListShareLinks(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Создать публичную ссылку на документ только для чтения
+	// (POST /documents/{documentID}/links)
+	CreateShareLink(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Отозвать публичную ссылку
+	// (DELETE /documents/{documentID}/links/{linkID})
+	RevokeShareLink(w http.ResponseWriter, r *http.Request, documentID int64, linkID int64)
+	// Восстановить документ из корзины
+	// (POST /documents/{documentID}/restore)
+	RestoreDocument(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Семантический поиск по конкретному документу
+	// (POST /documents/{documentID}/search)
+	SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Список пользователей, которым выдан доступ к документу
+	// (GET /documents/{documentID}/shares)
+	ListDocumentShares(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Поделиться документом с пользователем по email
+	// (POST /documents/{documentID}/shares)
+	ShareDocument(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Отозвать доступ к документу
+	// (DELETE /documents/{documentID}/shares/{userID})
+	RevokeDocumentShare(w http.ResponseWriter, r *http.Request, documentID int64, userID int64)
+	// Список версий документа
+	// (GET /documents/{documentID}/versions)
+	ListDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64)
+	// Восстановить версию документа
+	// (POST /documents/{documentID}/versions/{version}/restore)
+	RestoreDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64, version int32)
+	// Поток событий документов
+	// (GET /events)
+	StreamEvents(w http.ResponseWriter, r *http.Request)
+	// Список папок
+	// (GET /folders)
+	ListFolders(w http.ResponseWriter, r *http.Request, params ListFoldersParams)
+	// Создать папку
+	// (POST /folders)
+	CreateFolder(w http.ResponseWriter, r *http.Request)
+	// Удалить папку
+	// (DELETE /folders/{folderID})
+	DeleteFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath)
+	// Получить папку
+	// (GET /folders/{folderID})
+	GetFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath)
+	// Переименовать папку
+	// (PUT /folders/{folderID})
+	RenameFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath)
+	// Переместить папку
+	// (POST /folders/{folderID}/move)
+	MoveFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath)
+	// Проверка работоспособности сервера
+	// (GET /ping)
+	Ping(w http.ResponseWriter, r *http.Request)
+	// Просмотр документа по публичной ссылке
+	// (GET /public/links/{token})
+	GetSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken)
+	// Семантический поиск по документу из публичной ссылки
+	// (POST /public/links/{token}/search)
+	SearchSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken)
+	// Возможности сервера загрузок tus
+	// (OPTIONS /uploads)
+	TusOptions(w http.ResponseWriter, r *http.Request)
+	// Создать возобновляемую загрузку (tus creation)
+	// (POST /uploads)
+	CreateUpload(w http.ResponseWriter, r *http.Request, params CreateUploadParams)
+	// Отменить загрузку (tus termination)
+	// (DELETE /uploads/{uploadID})
+	DeleteUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteUploadParams)
+	// Текущее смещение загрузки
+	// (HEAD /uploads/{uploadID})
+	GetUploadOffset(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetUploadOffsetParams)
+	// Дописать данные в загрузку
+	// (PATCH /uploads/{uploadID})
+	AppendUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params AppendUploadParams)
+	// Удалить аккаунт
+	// (DELETE /users/me)
+	DeleteAccount(w http.ResponseWriter, r *http.Request)
+	// Получить профиль текущего пользователя
+	// (GET /users/me)
+	GetUserProfile(w http.ResponseWriter, r *http.Request)
+	// Сменить пароль
+	// (PUT /users/me/password)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	// Список рабочих пространств пользователя
+	// (GET /workspaces)
+	ListWorkspaces(w http.ResponseWriter, r *http.Request)
+	// Создать рабочее пространство
+	// (POST /workspaces)
+	CreateWorkspace(w http.ResponseWriter, r *http.Request)
+	// Удалить рабочее пространство
+	// (DELETE /workspaces/{workspaceID})
+	DeleteWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Получить рабочее пространство
+	// (GET /workspaces/{workspaceID})
+	GetWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Переименовать рабочее пространство
+	// (PUT /workspaces/{workspaceID})
+	RenameWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Список участников рабочего пространства
+	// (GET /workspaces/{workspaceID}/members)
+	ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Добавить участника по email
+	// (POST /workspaces/{workspaceID}/members)
+	AddWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Удалить участника
+	// (DELETE /workspaces/{workspaceID}/members/{userID})
+	RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64)
+	// Изменить роль участника
+	// (PUT /workspaces/{workspaceID}/members/{userID})
+	UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64)
+	// Список вебхуков рабочего пространства
+	// (GET /workspaces/{workspaceID}/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Зарегистрировать вебхук
+	// (POST /workspaces/{workspaceID}/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath)
+	// Удалить вебхук
+	// (DELETE /workspaces/{workspaceID}/webhooks/{webhookID})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath)
+	// Получить вебхук
+	// (GET /workspaces/{workspaceID}/webhooks/{webhookID})
+	GetWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath)
+	// Изменить вебхук
+	// (PUT /workspaces/{workspaceID}/webhooks/{webhookID})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath)
+	// Журнал доставок вебхука
+	// (GET /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, params ListWebhookDeliveriesParams)
+	// Повторить доставку
+	// (POST /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+	RedeliverWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, deliveryID int64)
 }
 
-type FullLogout204Response struct {
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Список пользователей с количеством документов и чанков
+// (GET /admin/users)
+func (_ Unimplemented) AdminListUsers(w http.ResponseWriter, r *http.Request, params AdminListUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response FullLogout204Response) VisitFullLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// Пользователь с количеством документов и чанков
+// (GET /admin/users/{userID})
+func (_ Unimplemented) AdminGetUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type FullLogout401Response struct {
+// Заблокировать пользователя
+// (POST /admin/users/{userID}/disable)
+func (_ Unimplemented) AdminDisableUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response FullLogout401Response) VisitFullLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Разблокировать пользователя
+// (POST /admin/users/{userID}/enable)
+func (_ Unimplemented) AdminEnableUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
+// Отключить двухфакторную аутентификацию
+// (POST /auth/2fa/disable)
+func (_ Unimplemented) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
+// Начать подключение TOTP
+// (POST /auth/2fa/enroll)
+func (_ Unimplemented) BeginTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Login200ResponseHeaders struct {
-	SetCookie string
+// Подтвердить подключение TOTP первым кодом
+// (POST /auth/2fa/enroll/confirm)
+func (_ Unimplemented) ConfirmTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Login200Response struct {
-	Headers Login200ResponseHeaders
+// Второй шаг входа - проверка TOTP-кода или кода восстановления
+// (POST /auth/2fa/verify)
+func (_ Unimplemented) VerifyTwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Login200Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
-	return nil
+// Выход со всех устройств
+// (POST /auth/full_logout)
+func (_ Unimplemented) FullLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Login400Response struct {
+// Вход в систему
+// (POST /auth/login)
+func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Login400Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+// Выход из текущей сессии
+// (POST /auth/logout)
+func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Login401Response struct {
+// Обработка ответа OIDC-провайдера
+// (GET /auth/oidc/callback)
+func (_ Unimplemented) OidcCallback(w http.ResponseWriter, r *http.Request, params OidcCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Login401Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Вход через OIDC-провайдера
+// (GET /auth/oidc/login)
+func (_ Unimplemented) OidcLogin(w http.ResponseWriter, r *http.Request, params OidcLoginParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type LogoutRequestObject struct {
+// Установить новый пароль по одноразовому токену из письма
+// (POST /auth/password-reset)
+func (_ Unimplemented) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
+// Запросить письмо для сброса пароля
+// (POST /auth/password-reset/request)
+func (_ Unimplemented) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Logout204ResponseHeaders struct {
-	SetCookie string
+// Обновление пары токенов
+// (POST /auth/refresh)
+func (_ Unimplemented) Refresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Logout204Response struct {
-	Headers Logout204ResponseHeaders
+// Регистрация нового пользователя
+// (POST /auth/register)
+func (_ Unimplemented) Register(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(204)
-	return nil
+// Подтверждение email по одноразовому токену из письма
+// (POST /auth/verify-email)
+func (_ Unimplemented) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Logout401Response struct {
+// Повторно отправить письмо для подтверждения email
+// (POST /auth/verify-email/request)
+func (_ Unimplemented) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Logout401Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Получить список всех документов пользователя
+// (GET /documents)
+func (_ Unimplemented) ListUserDocuments(w http.ResponseWriter, r *http.Request, params ListUserDocumentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RefreshRequestObject struct {
+// Загрузить новый документ
+// (POST /documents)
+func (_ Unimplemented) UploadDocument(w http.ResponseWriter, r *http.Request, params UploadDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RefreshResponseObject interface {
-	VisitRefreshResponse(w http.ResponseWriter) error
+// Семантический поиск по всем документам
+// (POST /documents/search)
+func (_ Unimplemented) Search(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Refresh200ResponseHeaders struct {
-	SetCookie string
+// Очистить корзину
+// (DELETE /documents/trash)
+func (_ Unimplemented) EmptyTrash(w http.ResponseWriter, r *http.Request, params EmptyTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Refresh200Response struct {
-	Headers Refresh200ResponseHeaders
+// Список документов в корзине
+// (GET /documents/trash)
+func (_ Unimplemented) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Refresh200Response) VisitRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
-	return nil
+// Удалить документ
+// (DELETE /documents/{documentID})
+func (_ Unimplemented) DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Refresh401Response struct {
+// Получить информацию о конкретном документе
+// (GET /documents/{documentID})
+func (_ Unimplemented) GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Refresh401Response) VisitRefreshResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Изменить описание, теги и пользовательские поля документа
+// (PATCH /documents/{documentID})
+func (_ Unimplemented) UpdateDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RegisterRequestObject struct {
-	Body *RegisterJSONRequestBody
+// Загрузить новую версию документа
+// (PUT /documents/{documentID})
+func (_ Unimplemented) UploadDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RegisterResponseObject interface {
-	VisitRegisterResponse(w http.ResponseWriter) error
+// Список чанков документа
+// (GET /documents/{documentID}/chunks)
+func (_ Unimplemented) ListDocumentChunks(w http.ResponseWriter, r *http.Request, documentID int64, params ListDocumentChunksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Register201ResponseHeaders struct {
-	SetCookie string
+// Скачать содержимое документа
+// (GET /documents/{documentID}/content)
+func (_ Unimplemented) GetDocumentContent(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Register201Response struct {
-	Headers Register201ResponseHeaders
+// Сравнить две версии документа
+// (GET /documents/{documentID}/diff)
+func (_ Unimplemented) DiffDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64, params DiffDocumentVersionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Register201Response) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(201)
-	return nil
+// Переместить документ в папку
+// (PUT /documents/{documentID}/folder)
+func (_ Unimplemented) SetDocumentFolder(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Register400JSONResponse Error
+// Список публичных ссылок на документ
+// (GET /documents/{documentID}/links)
+func (_ Unimplemented) ListShareLinks(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// Создать публичную ссылку на документ только для чтения
+// (POST /documents/{documentID}/links)
+func (_ Unimplemented) CreateShareLink(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Отозвать публичную ссылку
+// (DELETE /documents/{documentID}/links/{linkID})
+func (_ Unimplemented) RevokeShareLink(w http.ResponseWriter, r *http.Request, documentID int64, linkID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Register409JSONResponse Error
+// Восстановить документ из корзины
+// (POST /documents/{documentID}/restore)
+func (_ Unimplemented) RestoreDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response Register409JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// Семантический поиск по конкретному документу
+// (POST /documents/{documentID}/search)
+func (_ Unimplemented) SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Список пользователей, которым выдан доступ к документу
+// (GET /documents/{documentID}/shares)
+func (_ Unimplemented) ListDocumentShares(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Register500JSONResponse Error
-
-func (response Register500JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// Поделиться документом с пользователем по email
+// (POST /documents/{documentID}/shares)
+func (_ Unimplemented) ShareDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Отозвать доступ к документу
+// (DELETE /documents/{documentID}/shares/{userID})
+func (_ Unimplemented) RevokeDocumentShare(w http.ResponseWriter, r *http.Request, documentID int64, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListUserDocumentsRequestObject struct {
+// Список версий документа
+// (GET /documents/{documentID}/versions)
+func (_ Unimplemented) ListDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListUserDocumentsResponseObject interface {
-	VisitListUserDocumentsResponse(w http.ResponseWriter) error
+// Восстановить версию документа
+// (POST /documents/{documentID}/versions/{version}/restore)
+func (_ Unimplemented) RestoreDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64, version int32) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListUserDocuments200JSONResponse []Document
+// Поток событий документов
+// (GET /events)
+func (_ Unimplemented) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response ListUserDocuments200JSONResponse) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Список папок
+// (GET /folders)
+func (_ Unimplemented) ListFolders(w http.ResponseWriter, r *http.Request, params ListFoldersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Создать папку
+// (POST /folders)
+func (_ Unimplemented) CreateFolder(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListUserDocuments401Response struct {
+// Удалить папку
+// (DELETE /folders/{folderID})
+func (_ Unimplemented) DeleteFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response ListUserDocuments401Response) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Получить папку
+// (GET /folders/{folderID})
+func (_ Unimplemented) GetFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UploadDocumentRequestObject struct {
-	Body *multipart.Reader
+// Переименовать папку
+// (PUT /folders/{folderID})
+func (_ Unimplemented) RenameFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UploadDocumentResponseObject interface {
-	VisitUploadDocumentResponse(w http.ResponseWriter) error
+// Переместить папку
+// (POST /folders/{folderID}/move)
+func (_ Unimplemented) MoveFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UploadDocument201JSONResponse Document
+// Проверка работоспособности сервера
+// (GET /ping)
+func (_ Unimplemented) Ping(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response UploadDocument201JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// Просмотр документа по публичной ссылке
+// (GET /public/links/{token})
+func (_ Unimplemented) GetSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Семантический поиск по документу из публичной ссылки
+// (POST /public/links/{token}/search)
+func (_ Unimplemented) SearchSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UploadDocument400Response struct {
+// Возможности сервера загрузок tus
+// (OPTIONS /uploads)
+func (_ Unimplemented) TusOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response UploadDocument400Response) VisitUploadDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+// Создать возобновляемую загрузку (tus creation)
+// (POST /uploads)
+func (_ Unimplemented) CreateUpload(w http.ResponseWriter, r *http.Request, params CreateUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UploadDocument401Response struct {
+// Отменить загрузку (tus termination)
+// (DELETE /uploads/{uploadID})
+func (_ Unimplemented) DeleteUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response UploadDocument401Response) VisitUploadDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Текущее смещение загрузки
+// (HEAD /uploads/{uploadID})
+func (_ Unimplemented) GetUploadOffset(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetUploadOffsetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchRequestObject struct {
-	Body *SearchJSONRequestBody
+// Дописать данные в загрузку
+// (PATCH /uploads/{uploadID})
+func (_ Unimplemented) AppendUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params AppendUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchResponseObject interface {
-	VisitSearchResponse(w http.ResponseWriter) error
+// Удалить аккаунт
+// (DELETE /users/me)
+func (_ Unimplemented) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Search200JSONResponse []SearchResult
+// Получить профиль текущего пользователя
+// (GET /users/me)
+func (_ Unimplemented) GetUserProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Сменить пароль
+// (PUT /users/me/password)
+func (_ Unimplemented) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Список рабочих пространств пользователя
+// (GET /workspaces)
+func (_ Unimplemented) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Search400Response struct {
+// Создать рабочее пространство
+// (POST /workspaces)
+func (_ Unimplemented) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Search400Response) VisitSearchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+// Удалить рабочее пространство
+// (DELETE /workspaces/{workspaceID})
+func (_ Unimplemented) DeleteWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Search401Response struct {
+// Получить рабочее пространство
+// (GET /workspaces/{workspaceID})
+func (_ Unimplemented) GetWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Search401Response) VisitSearchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Переименовать рабочее пространство
+// (PUT /workspaces/{workspaceID})
+func (_ Unimplemented) RenameWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type Search404Response struct {
+// Список участников рабочего пространства
+// (GET /workspaces/{workspaceID}/members)
+func (_ Unimplemented) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response Search404Response) VisitSearchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// Добавить участника по email
+// (POST /workspaces/{workspaceID}/members)
+func (_ Unimplemented) AddWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteDocumentRequestObject struct {
-	DocumentID int64 `json:"documentID"`
+// Удалить участника
+// (DELETE /workspaces/{workspaceID}/members/{userID})
+func (_ Unimplemented) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteDocumentResponseObject interface {
-	VisitDeleteDocumentResponse(w http.ResponseWriter) error
+// Изменить роль участника
+// (PUT /workspaces/{workspaceID}/members/{userID})
+func (_ Unimplemented) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteDocument204Response struct {
+// Список вебхуков рабочего пространства
+// (GET /workspaces/{workspaceID}/webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteDocument204Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// Зарегистрировать вебхук
+// (POST /workspaces/{workspaceID}/webhooks)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteDocument401Response struct {
+// Удалить вебхук
+// (DELETE /workspaces/{workspaceID}/webhooks/{webhookID})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteDocument401Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Получить вебхук
+// (GET /workspaces/{workspaceID}/webhooks/{webhookID})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteDocument404Response struct {
+// Изменить вебхук
+// (PUT /workspaces/{workspaceID}/webhooks/{webhookID})
+func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteDocument404Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// Журнал доставок вебхука
+// (GET /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetDocumentByIDRequestObject struct {
-	DocumentID int64 `json:"documentID"`
+// Повторить доставку
+// (POST /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+func (_ Unimplemented) RedeliverWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, deliveryID int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetDocumentByIDResponseObject interface {
-	VisitGetDocumentByIDResponse(w http.ResponseWriter) error
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetDocumentByID200JSONResponse Document
+type MiddlewareFunc func(http.Handler) http.Handler
 
-func (response GetDocumentByID200JSONResponse) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// AdminListUsers operation middleware
+func (siw *ServerInterfaceWrapper) AdminListUsers(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetDocumentByID401Response struct {
-}
+	ctx := r.Context()
 
-func (response GetDocumentByID401Response) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-type GetDocumentByID404Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response GetDocumentByID404Response) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListUsersParams
 
-type SearchInDocumentRequestObject struct {
-	DocumentID int64 `json:"documentID"`
-	Body       *SearchInDocumentJSONRequestBody
-}
+	// ------------- Optional query parameter "page" -------------
 
-type SearchInDocumentResponseObject interface {
-	VisitSearchInDocumentResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type SearchInDocument200JSONResponse []SearchResult
+	// ------------- Optional query parameter "size" -------------
 
-func (response SearchInDocument200JSONResponse) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListUsers(w, r, params)
+	}))
 
-type SearchInDocument400Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response SearchInDocument400Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type SearchInDocument401Response struct {
-}
+// AdminGetUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetUser(w http.ResponseWriter, r *http.Request) {
 
-func (response SearchInDocument401Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type SearchInDocument404Response struct {
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetUser(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response SearchInDocument404Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// AdminDisableUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminDisableUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDisableUser(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PingRequestObject struct {
+// AdminEnableUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminEnableUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminEnableUser(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisableTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableTwoFactor(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BeginTwoFactorEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) BeginTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BeginTwoFactorEnrollment(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmTwoFactorEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmTwoFactorEnrollment(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyTwoFactorLogin operation middleware
+func (siw *ServerInterfaceWrapper) VerifyTwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyTwoFactorLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FullLogout operation middleware
+func (siw *ServerInterfaceWrapper) FullLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FullLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OidcCallback operation middleware
+func (siw *ServerInterfaceWrapper) OidcCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OidcCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OidcCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OidcLogin operation middleware
+func (siw *ServerInterfaceWrapper) OidcLogin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OidcLoginParams
+
+	// ------------- Optional query parameter "reauth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reauth", r.URL.Query(), &params.Reauth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reauth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OidcLogin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) ResetPassword(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Refresh operation middleware
+func (siw *ServerInterfaceWrapper) Refresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Refresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Register operation middleware
+func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestEmailVerification(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserDocuments operation middleware
+func (siw *ServerInterfaceWrapper) ListUserDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserDocumentsParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "folderID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "folderID", r.URL.Query(), &params.FolderID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filename" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filename", r.URL.Query(), &params.Filename)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserDocuments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadDocument operation middleware
+func (siw *ServerInterfaceWrapper) UploadDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadDocumentParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "onDuplicate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "onDuplicate", r.URL.Query(), &params.OnDuplicate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "onDuplicate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadDocument(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EmptyTrash operation middleware
+func (siw *ServerInterfaceWrapper) EmptyTrash(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EmptyTrashParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EmptyTrash(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListTrash(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrash(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDocument operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDocument(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDocumentByID operation middleware
+func (siw *ServerInterfaceWrapper) GetDocumentByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocumentByID(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDocument operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDocument(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadDocumentVersion operation middleware
+func (siw *ServerInterfaceWrapper) UploadDocumentVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadDocumentVersion(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDocumentChunks operation middleware
+func (siw *ServerInterfaceWrapper) ListDocumentChunks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDocumentChunksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDocumentChunks(w, r, documentID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDocumentContent operation middleware
+func (siw *ServerInterfaceWrapper) GetDocumentContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocumentContent(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiffDocumentVersions operation middleware
+func (siw *ServerInterfaceWrapper) DiffDocumentVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffDocumentVersionsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffDocumentVersions(w, r, documentID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetDocumentFolder operation middleware
+func (siw *ServerInterfaceWrapper) SetDocumentFolder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDocumentFolder(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListShareLinks operation middleware
+func (siw *ServerInterfaceWrapper) ListShareLinks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListShareLinks(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateShareLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateShareLink(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeShareLink operation middleware
+func (siw *ServerInterfaceWrapper) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "linkID" -------------
+	var linkID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "linkID", chi.URLParam(r, "linkID"), &linkID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeShareLink(w, r, documentID, linkID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreDocument operation middleware
+func (siw *ServerInterfaceWrapper) RestoreDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreDocument(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchInDocument operation middleware
+func (siw *ServerInterfaceWrapper) SearchInDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchInDocument(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDocumentShares operation middleware
+func (siw *ServerInterfaceWrapper) ListDocumentShares(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDocumentShares(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ShareDocument operation middleware
+func (siw *ServerInterfaceWrapper) ShareDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShareDocument(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeDocumentShare operation middleware
+func (siw *ServerInterfaceWrapper) RevokeDocumentShare(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeDocumentShare(w, r, documentID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDocumentVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListDocumentVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDocumentVersions(w, r, documentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreDocumentVersion operation middleware
+func (siw *ServerInterfaceWrapper) RestoreDocumentVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentID" -------------
+	var documentID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentID", chi.URLParam(r, "documentID"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreDocumentVersion(w, r, documentID, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFolders operation middleware
+func (siw *ServerInterfaceWrapper) ListFolders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFoldersParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFolders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateFolder operation middleware
+func (siw *ServerInterfaceWrapper) CreateFolder(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFolder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFolder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFolder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "folderID" -------------
+	var folderID FolderIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folderID", chi.URLParam(r, "folderID"), &folderID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFolder(w, r, folderID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFolder operation middleware
+func (siw *ServerInterfaceWrapper) GetFolder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "folderID" -------------
+	var folderID FolderIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folderID", chi.URLParam(r, "folderID"), &folderID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFolder(w, r, folderID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameFolder operation middleware
+func (siw *ServerInterfaceWrapper) RenameFolder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "folderID" -------------
+	var folderID FolderIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folderID", chi.URLParam(r, "folderID"), &folderID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameFolder(w, r, folderID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveFolder operation middleware
+func (siw *ServerInterfaceWrapper) MoveFolder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "folderID" -------------
+	var folderID FolderIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folderID", chi.URLParam(r, "folderID"), &folderID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveFolder(w, r, folderID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Ping operation middleware
+func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Ping(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSharedDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token ShareLinkToken
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedDocument(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchSharedDocument operation middleware
+func (siw *ServerInterfaceWrapper) SearchSharedDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token ShareLinkToken
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchSharedDocument(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TusOptions operation middleware
+func (siw *ServerInterfaceWrapper) TusOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TusOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUpload operation middleware
+func (siw *ServerInterfaceWrapper) CreateUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateUploadParams
+
+	// ------------- Optional query parameter "workspaceID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceID", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Tus-Resumable", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tus-Resumable", valueList[0], &TusResumable, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Tus-Resumable", Err: err})
+			return
+		}
+
+		params.TusResumable = TusResumable
+
+	} else {
+		err := fmt.Errorf("Header parameter Tus-Resumable is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Tus-Resumable", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "Upload-Length" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Length")]; found {
+		var UploadLength int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Upload-Length", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Length", valueList[0], &UploadLength, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Upload-Length", Err: err})
+			return
+		}
+
+		params.UploadLength = UploadLength
+
+	} else {
+		err := fmt.Errorf("Header parameter Upload-Length is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Upload-Length", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Upload-Metadata" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Metadata")]; found {
+		var UploadMetadata string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Upload-Metadata", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Metadata", valueList[0], &UploadMetadata, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Upload-Metadata", Err: err})
+			return
+		}
+
+		params.UploadMetadata = &UploadMetadata
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUpload(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUpload operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUploadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Tus-Resumable", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tus-Resumable", valueList[0], &TusResumable, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Tus-Resumable", Err: err})
+			return
+		}
+
+		params.TusResumable = TusResumable
+
+	} else {
+		err := fmt.Errorf("Header parameter Tus-Resumable is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Tus-Resumable", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUpload(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUploadOffset operation middleware
+func (siw *ServerInterfaceWrapper) GetUploadOffset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUploadOffsetParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Tus-Resumable", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tus-Resumable", valueList[0], &TusResumable, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Tus-Resumable", Err: err})
+			return
+		}
+
+		params.TusResumable = TusResumable
+
+	} else {
+		err := fmt.Errorf("Header parameter Tus-Resumable is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Tus-Resumable", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUploadOffset(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AppendUpload operation middleware
+func (siw *ServerInterfaceWrapper) AppendUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AppendUploadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Tus-Resumable", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tus-Resumable", valueList[0], &TusResumable, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Tus-Resumable", Err: err})
+			return
+		}
+
+		params.TusResumable = TusResumable
+
+	} else {
+		err := fmt.Errorf("Header parameter Tus-Resumable is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Tus-Resumable", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Offset")]; found {
+		var UploadOffset int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Upload-Offset", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Offset", valueList[0], &UploadOffset, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Upload-Offset", Err: err})
+			return
+		}
+
+		params.UploadOffset = UploadOffset
+
+	} else {
+		err := fmt.Errorf("Header parameter Upload-Offset is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Upload-Offset", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Upload-Checksum" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Checksum")]; found {
+		var UploadChecksum string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Upload-Checksum", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Upload-Checksum", valueList[0], &UploadChecksum, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Upload-Checksum", Err: err})
+			return
+		}
+
+		params.UploadChecksum = &UploadChecksum
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AppendUpload(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetUserProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangePassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkspace(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkspace(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkspace(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) RenameWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameWorkspace(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkspaceMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaceMembers(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddWorkspaceMember(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveWorkspaceMember(w, r, workspaceID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userID" -------------
+	var userID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWorkspaceMember(w, r, workspaceID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, workspaceID, webhookID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, workspaceID, webhookID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, workspaceID, webhookID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, workspaceID, webhookID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RedeliverWebhook operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceID" -------------
+	var workspaceID WorkspaceIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceID", chi.URLParam(r, "workspaceID"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryID" -------------
+	var deliveryID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryID", chi.URLParam(r, "deliveryID"), &deliveryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeliverWebhook(w, r, workspaceID, webhookID, deliveryID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users", wrapper.AdminListUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{userID}", wrapper.AdminGetUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userID}/disable", wrapper.AdminDisableUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userID}/enable", wrapper.AdminEnableUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/2fa/disable", wrapper.DisableTwoFactor)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/2fa/enroll", wrapper.BeginTwoFactorEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/2fa/enroll/confirm", wrapper.ConfirmTwoFactorEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/2fa/verify", wrapper.VerifyTwoFactorLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/full_logout", wrapper.FullLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/callback", wrapper.OidcCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/login", wrapper.OidcLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset", wrapper.ResetPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset/request", wrapper.RequestPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.Refresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/register", wrapper.Register)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/verify-email", wrapper.VerifyEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/verify-email/request", wrapper.RequestEmailVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents", wrapper.ListUserDocuments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents", wrapper.UploadDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/search", wrapper.Search)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/documents/trash", wrapper.EmptyTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/trash", wrapper.ListTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/documents/{documentID}", wrapper.DeleteDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}", wrapper.GetDocumentByID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/documents/{documentID}", wrapper.UpdateDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/documents/{documentID}", wrapper.UploadDocumentVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/chunks", wrapper.ListDocumentChunks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/content", wrapper.GetDocumentContent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/diff", wrapper.DiffDocumentVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/documents/{documentID}/folder", wrapper.SetDocumentFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/links", wrapper.ListShareLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/{documentID}/links", wrapper.CreateShareLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/documents/{documentID}/links/{linkID}", wrapper.RevokeShareLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/{documentID}/restore", wrapper.RestoreDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/{documentID}/search", wrapper.SearchInDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/shares", wrapper.ListDocumentShares)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/{documentID}/shares", wrapper.ShareDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/documents/{documentID}/shares/{userID}", wrapper.RevokeDocumentShare)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{documentID}/versions", wrapper.ListDocumentVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/documents/{documentID}/versions/{version}/restore", wrapper.RestoreDocumentVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events", wrapper.StreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/folders", wrapper.ListFolders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/folders", wrapper.CreateFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/folders/{folderID}", wrapper.DeleteFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/folders/{folderID}", wrapper.GetFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/folders/{folderID}", wrapper.RenameFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/folders/{folderID}/move", wrapper.MoveFolder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ping", wrapper.Ping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/public/links/{token}", wrapper.GetSharedDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/public/links/{token}/search", wrapper.SearchSharedDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/uploads", wrapper.TusOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/uploads", wrapper.CreateUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/uploads/{uploadID}", wrapper.DeleteUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Head(options.BaseURL+"/uploads/{uploadID}", wrapper.GetUploadOffset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/uploads/{uploadID}", wrapper.AppendUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me", wrapper.DeleteAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me", wrapper.GetUserProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/me/password", wrapper.ChangePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces", wrapper.ListWorkspaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workspaces", wrapper.CreateWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/workspaces/{workspaceID}", wrapper.DeleteWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{workspaceID}", wrapper.GetWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/workspaces/{workspaceID}", wrapper.RenameWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{workspaceID}/members", wrapper.ListWorkspaceMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workspaces/{workspaceID}/members", wrapper.AddWorkspaceMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/workspaces/{workspaceID}/members/{userID}", wrapper.RemoveWorkspaceMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/workspaces/{workspaceID}/members/{userID}", wrapper.UpdateWorkspaceMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{workspaceID}/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workspaces/{workspaceID}/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/workspaces/{workspaceID}/webhooks/{webhookID}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{workspaceID}/webhooks/{webhookID}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/workspaces/{workspaceID}/webhooks/{webhookID}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver", wrapper.RedeliverWebhook)
+	})
+
+	return r
+}
+
+type SearchQuotaExceededResponseHeaders struct {
+	RetryAfter int
+}
+type SearchQuotaExceededJSONResponse struct {
+	Body QuotaError
+
+	Headers SearchQuotaExceededResponseHeaders
+}
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter int
+}
+type TooManyRequestsJSONResponse struct {
+	Body Error
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type TusVersionMismatchResponseHeaders struct {
+	TusVersion string
+}
+type TusVersionMismatchResponse struct {
+	Headers TusVersionMismatchResponseHeaders
+}
+
+type AdminListUsersRequestObject struct {
+	Params AdminListUsersParams
+}
+
+type AdminListUsersResponseObject interface {
+	VisitAdminListUsersResponse(w http.ResponseWriter) error
+}
+
+type AdminListUsers200JSONResponse AdminUserList
+
+func (response AdminListUsers200JSONResponse) VisitAdminListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListUsers401Response struct {
+}
+
+func (response AdminListUsers401Response) VisitAdminListUsersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListUsers403Response struct {
+}
+
+func (response AdminListUsers403Response) VisitAdminListUsersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AdminGetUserRequestObject struct {
+	UserID int64 `json:"userID"`
+}
+
+type AdminGetUserResponseObject interface {
+	VisitAdminGetUserResponse(w http.ResponseWriter) error
+}
+
+type AdminGetUser200JSONResponse AdminUser
+
+func (response AdminGetUser200JSONResponse) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetUser401Response struct {
+}
+
+func (response AdminGetUser401Response) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminGetUser403Response struct {
+}
+
+func (response AdminGetUser403Response) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AdminGetUser404Response struct {
+}
+
+func (response AdminGetUser404Response) VisitAdminGetUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminDisableUserRequestObject struct {
+	UserID int64 `json:"userID"`
+}
+
+type AdminDisableUserResponseObject interface {
+	VisitAdminDisableUserResponse(w http.ResponseWriter) error
+}
+
+type AdminDisableUser204Response struct {
+}
+
+func (response AdminDisableUser204Response) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AdminDisableUser400JSONResponse Error
+
+func (response AdminDisableUser400JSONResponse) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDisableUser401Response struct {
+}
+
+func (response AdminDisableUser401Response) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminDisableUser403Response struct {
+}
+
+func (response AdminDisableUser403Response) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AdminDisableUser404Response struct {
+}
+
+func (response AdminDisableUser404Response) VisitAdminDisableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminEnableUserRequestObject struct {
+	UserID int64 `json:"userID"`
+}
+
+type AdminEnableUserResponseObject interface {
+	VisitAdminEnableUserResponse(w http.ResponseWriter) error
+}
+
+type AdminEnableUser204Response struct {
+}
+
+func (response AdminEnableUser204Response) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AdminEnableUser401Response struct {
+}
+
+func (response AdminEnableUser401Response) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminEnableUser403Response struct {
+}
+
+func (response AdminEnableUser403Response) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AdminEnableUser404Response struct {
+}
+
+func (response AdminEnableUser404Response) VisitAdminEnableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DisableTwoFactorRequestObject struct {
+	Body *DisableTwoFactorJSONRequestBody
+}
+
+type DisableTwoFactorResponseObject interface {
+	VisitDisableTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type DisableTwoFactor204Response struct {
+}
+
+func (response DisableTwoFactor204Response) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisableTwoFactor400JSONResponse Error
+
+func (response DisableTwoFactor400JSONResponse) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTwoFactor401Response struct {
+}
+
+func (response DisableTwoFactor401Response) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DisableTwoFactor403JSONResponse Error
+
+func (response DisableTwoFactor403JSONResponse) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginTwoFactorEnrollmentRequestObject struct {
+}
+
+type BeginTwoFactorEnrollmentResponseObject interface {
+	VisitBeginTwoFactorEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type BeginTwoFactorEnrollment200JSONResponse TwoFactorEnrollment
+
+func (response BeginTwoFactorEnrollment200JSONResponse) VisitBeginTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginTwoFactorEnrollment401Response struct {
+}
+
+func (response BeginTwoFactorEnrollment401Response) VisitBeginTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type BeginTwoFactorEnrollment404Response struct {
+}
+
+func (response BeginTwoFactorEnrollment404Response) VisitBeginTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type BeginTwoFactorEnrollment409JSONResponse Error
+
+func (response BeginTwoFactorEnrollment409JSONResponse) VisitBeginTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTwoFactorEnrollmentRequestObject struct {
+	Body *ConfirmTwoFactorEnrollmentJSONRequestBody
+}
+
+type ConfirmTwoFactorEnrollmentResponseObject interface {
+	VisitConfirmTwoFactorEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type ConfirmTwoFactorEnrollment200JSONResponse RecoveryCodes
+
+func (response ConfirmTwoFactorEnrollment200JSONResponse) VisitConfirmTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTwoFactorEnrollment400JSONResponse Error
+
+func (response ConfirmTwoFactorEnrollment400JSONResponse) VisitConfirmTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTwoFactorEnrollment401Response struct {
+}
+
+func (response ConfirmTwoFactorEnrollment401Response) VisitConfirmTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ConfirmTwoFactorEnrollment409JSONResponse Error
+
+func (response ConfirmTwoFactorEnrollment409JSONResponse) VisitConfirmTwoFactorEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTwoFactorLoginRequestObject struct {
+	Body *VerifyTwoFactorLoginJSONRequestBody
+}
+
+type VerifyTwoFactorLoginResponseObject interface {
+	VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error
+}
+
+type VerifyTwoFactorLogin200Response struct {
+}
+
+func (response VerifyTwoFactorLogin200Response) VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type VerifyTwoFactorLogin400Response struct {
+}
+
+func (response VerifyTwoFactorLogin400Response) VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type VerifyTwoFactorLogin401JSONResponse Error
+
+func (response VerifyTwoFactorLogin401JSONResponse) VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTwoFactorLogin403JSONResponse Error
+
+func (response VerifyTwoFactorLogin403JSONResponse) VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTwoFactorLogin429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response VerifyTwoFactorLogin429JSONResponse) VisitVerifyTwoFactorLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FullLogoutRequestObject struct {
+}
+
+type FullLogoutResponseObject interface {
+	VisitFullLogoutResponse(w http.ResponseWriter) error
+}
+
+type FullLogout204Response struct {
+}
+
+func (response FullLogout204Response) VisitFullLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type FullLogout401Response struct {
+}
+
+func (response FullLogout401Response) VisitFullLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200ResponseHeaders struct {
+	SetCookie string
+}
+
+type Login200Response struct {
+	Headers Login200ResponseHeaders
+}
+
+func (response Login200Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+	return nil
+}
+
+type Login202JSONResponse TwoFactorChallenge
+
+func (response Login202JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login400Response struct {
+}
+
+func (response Login400Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type Login401Response struct {
+}
+
+func (response Login401Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Login403JSONResponse Error
+
+func (response Login403JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response Login429JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout204ResponseHeaders struct {
+	SetCookie string
+}
+
+type Logout204Response struct {
+	Headers Logout204ResponseHeaders
+}
+
+func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(204)
+	return nil
+}
+
+type Logout401Response struct {
+}
+
+func (response Logout401Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type OidcCallbackRequestObject struct {
+	Params OidcCallbackParams
+}
+
+type OidcCallbackResponseObject interface {
+	VisitOidcCallbackResponse(w http.ResponseWriter) error
+}
+
+type OidcCallback302ResponseHeaders struct {
+	Location string
+}
+
+type OidcCallback302Response struct {
+	Headers OidcCallback302ResponseHeaders
+}
+
+func (response OidcCallback302Response) VisitOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type OidcCallback400JSONResponse Error
+
+func (response OidcCallback400JSONResponse) VisitOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OidcCallback403JSONResponse Error
+
+func (response OidcCallback403JSONResponse) VisitOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OidcCallback404Response struct {
+}
+
+func (response OidcCallback404Response) VisitOidcCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type OidcCallback409JSONResponse Error
+
+func (response OidcCallback409JSONResponse) VisitOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OidcLoginRequestObject struct {
+	Params OidcLoginParams
+}
+
+type OidcLoginResponseObject interface {
+	VisitOidcLoginResponse(w http.ResponseWriter) error
+}
+
+type OidcLogin302ResponseHeaders struct {
+	Location string
+}
+
+type OidcLogin302Response struct {
+	Headers OidcLogin302ResponseHeaders
+}
+
+func (response OidcLogin302Response) VisitOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type OidcLogin404Response struct {
+}
+
+func (response OidcLogin404Response) VisitOidcLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ResetPasswordRequestObject struct {
+	Body *ResetPasswordJSONRequestBody
+}
+
+type ResetPasswordResponseObject interface {
+	VisitResetPasswordResponse(w http.ResponseWriter) error
+}
+
+type ResetPassword204Response struct {
+}
+
+func (response ResetPassword204Response) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ResetPassword400JSONResponse Error
+
+func (response ResetPassword400JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPassword429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ResetPassword429JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RequestPasswordResetRequestObject struct {
+	Body *RequestPasswordResetJSONRequestBody
+}
+
+type RequestPasswordResetResponseObject interface {
+	VisitRequestPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type RequestPasswordReset202Response struct {
+}
+
+func (response RequestPasswordReset202Response) VisitRequestPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequestPasswordReset400Response struct {
+}
+
+func (response RequestPasswordReset400Response) VisitRequestPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RequestPasswordReset429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RequestPasswordReset429JSONResponse) VisitRequestPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RefreshRequestObject struct {
+}
+
+type RefreshResponseObject interface {
+	VisitRefreshResponse(w http.ResponseWriter) error
+}
+
+type Refresh200ResponseHeaders struct {
+	SetCookie string
+}
+
+type Refresh200Response struct {
+	Headers Refresh200ResponseHeaders
+}
+
+func (response Refresh200Response) VisitRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+	return nil
+}
+
+type Refresh401Response struct {
+}
+
+func (response Refresh401Response) VisitRefreshResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RegisterRequestObject struct {
+	Body *RegisterJSONRequestBody
+}
+
+type RegisterResponseObject interface {
+	VisitRegisterResponse(w http.ResponseWriter) error
+}
+
+type Register201ResponseHeaders struct {
+	SetCookie string
+}
+
+type Register201Response struct {
+	Headers Register201ResponseHeaders
+}
+
+func (response Register201Response) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(201)
+	return nil
+}
+
+type Register400JSONResponse Error
+
+func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register409JSONResponse Error
+
+func (response Register409JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response Register429JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Register500JSONResponse Error
+
+func (response Register500JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEmailRequestObject struct {
+	Body *VerifyEmailJSONRequestBody
+}
+
+type VerifyEmailResponseObject interface {
+	VisitVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type VerifyEmail204Response struct {
+}
+
+func (response VerifyEmail204Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type VerifyEmail400JSONResponse Error
+
+func (response VerifyEmail400JSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEmail429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response VerifyEmail429JSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RequestEmailVerificationRequestObject struct {
+}
+
+type RequestEmailVerificationResponseObject interface {
+	VisitRequestEmailVerificationResponse(w http.ResponseWriter) error
+}
+
+type RequestEmailVerification204Response struct {
+}
+
+func (response RequestEmailVerification204Response) VisitRequestEmailVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RequestEmailVerification401Response struct {
+}
+
+func (response RequestEmailVerification401Response) VisitRequestEmailVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RequestEmailVerification409JSONResponse Error
+
+func (response RequestEmailVerification409JSONResponse) VisitRequestEmailVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmailVerification429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RequestEmailVerification429JSONResponse) VisitRequestEmailVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListUserDocumentsRequestObject struct {
+	Params ListUserDocumentsParams
+}
+
+type ListUserDocumentsResponseObject interface {
+	VisitListUserDocumentsResponse(w http.ResponseWriter) error
+}
+
+type ListUserDocuments200ResponseHeaders struct {
+	XNextCursor string
+	XTotalCount int64
+}
+
+type ListUserDocuments200JSONResponse struct {
+	Body    []Document
+	Headers ListUserDocuments200ResponseHeaders
+}
+
+func (response ListUserDocuments200JSONResponse) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListUserDocuments400JSONResponse Error
+
+func (response ListUserDocuments400JSONResponse) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserDocuments401Response struct {
+}
+
+func (response ListUserDocuments401Response) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListUserDocuments404Response struct {
+}
+
+func (response ListUserDocuments404Response) VisitListUserDocumentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UploadDocumentRequestObject struct {
+	Params UploadDocumentParams
+	Body   *multipart.Reader
+}
+
+type UploadDocumentResponseObject interface {
+	VisitUploadDocumentResponse(w http.ResponseWriter) error
+}
+
+type UploadDocument200JSONResponse Document
+
+func (response UploadDocument200JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocument201JSONResponse Document
+
+func (response UploadDocument201JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocument207JSONResponse BatchUploadResult
+
+func (response UploadDocument207JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(207)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocument400Response struct {
+}
+
+func (response UploadDocument400Response) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UploadDocument401Response struct {
+}
+
+func (response UploadDocument401Response) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UploadDocument403JSONResponse QuotaError
+
+func (response UploadDocument403JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocument404Response struct {
+}
+
+func (response UploadDocument404Response) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UploadDocument409JSONResponse DuplicateDocumentError
+
+func (response UploadDocument409JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocument413JSONResponse Error
+
+func (response UploadDocument413JSONResponse) VisitUploadDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchRequestObject struct {
+	Body *SearchJSONRequestBody
+}
+
+type SearchResponseObject interface {
+	VisitSearchResponse(w http.ResponseWriter) error
+}
+
+type Search200JSONResponse []SearchResult
+
+func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Search400JSONResponse Error
+
+func (response Search400JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Search401Response struct {
+}
+
+func (response Search401Response) VisitSearchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Search404Response struct {
+}
+
+func (response Search404Response) VisitSearchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type Search429JSONResponse struct {
+	SearchQuotaExceededJSONResponse
+}
+
+func (response Search429JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EmptyTrashRequestObject struct {
+	Params EmptyTrashParams
+}
+
+type EmptyTrashResponseObject interface {
+	VisitEmptyTrashResponse(w http.ResponseWriter) error
+}
+
+type EmptyTrash200JSONResponse EmptyTrashResult
+
+func (response EmptyTrash200JSONResponse) VisitEmptyTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EmptyTrash401Response struct {
+}
+
+func (response EmptyTrash401Response) VisitEmptyTrashResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type EmptyTrash404Response struct {
+}
+
+func (response EmptyTrash404Response) VisitEmptyTrashResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListTrashRequestObject struct {
+	Params ListTrashParams
+}
+
+type ListTrashResponseObject interface {
+	VisitListTrashResponse(w http.ResponseWriter) error
+}
+
+type ListTrash200JSONResponse []TrashedDocument
+
+func (response ListTrash200JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrash401Response struct {
+}
+
+func (response ListTrash401Response) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTrash404Response struct {
+}
+
+func (response ListTrash404Response) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteDocumentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type DeleteDocumentResponseObject interface {
+	VisitDeleteDocumentResponse(w http.ResponseWriter) error
+}
+
+type DeleteDocument204Response struct {
+}
+
+func (response DeleteDocument204Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDocument401Response struct {
+}
+
+func (response DeleteDocument401Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteDocument403JSONResponse Error
+
+func (response DeleteDocument403JSONResponse) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDocument404Response struct {
+}
+
+func (response DeleteDocument404Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetDocumentByIDRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type GetDocumentByIDResponseObject interface {
+	VisitGetDocumentByIDResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentByID200JSONResponse Document
+
+func (response GetDocumentByID200JSONResponse) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDocumentByID401Response struct {
+}
+
+func (response GetDocumentByID401Response) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDocumentByID404Response struct {
+}
+
+func (response GetDocumentByID404Response) VisitGetDocumentByIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateDocumentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *UpdateDocumentJSONRequestBody
+}
+
+type UpdateDocumentResponseObject interface {
+	VisitUpdateDocumentResponse(w http.ResponseWriter) error
+}
+
+type UpdateDocument200JSONResponse Document
+
+func (response UpdateDocument200JSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDocument400JSONResponse Error
+
+func (response UpdateDocument400JSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDocument401Response struct {
+}
+
+func (response UpdateDocument401Response) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateDocument403JSONResponse Error
+
+func (response UpdateDocument403JSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDocument404Response struct {
+}
+
+func (response UpdateDocument404Response) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UploadDocumentVersionRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *multipart.Reader
+}
+
+type UploadDocumentVersionResponseObject interface {
+	VisitUploadDocumentVersionResponse(w http.ResponseWriter) error
+}
+
+type UploadDocumentVersion200JSONResponse Document
+
+func (response UploadDocumentVersion200JSONResponse) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocumentVersion400JSONResponse Error
+
+func (response UploadDocumentVersion400JSONResponse) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocumentVersion401Response struct {
+}
+
+func (response UploadDocumentVersion401Response) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UploadDocumentVersion403JSONResponse QuotaError
+
+func (response UploadDocumentVersion403JSONResponse) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocumentVersion404Response struct {
+}
+
+func (response UploadDocumentVersion404Response) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UploadDocumentVersion413JSONResponse Error
+
+func (response UploadDocumentVersion413JSONResponse) VisitUploadDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentChunksRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Params     ListDocumentChunksParams
+}
+
+type ListDocumentChunksResponseObject interface {
+	VisitListDocumentChunksResponse(w http.ResponseWriter) error
+}
+
+type ListDocumentChunks200JSONResponse ChunkList
+
+func (response ListDocumentChunks200JSONResponse) VisitListDocumentChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentChunks401Response struct {
+}
+
+func (response ListDocumentChunks401Response) VisitListDocumentChunksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListDocumentChunks404Response struct {
+}
+
+func (response ListDocumentChunks404Response) VisitListDocumentChunksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetDocumentContentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type GetDocumentContentResponseObject interface {
+	VisitGetDocumentContentResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentContent200ResponseHeaders struct {
+	ContentDisposition  string
+	XContentTypeOptions string
+}
+
+type GetDocumentContent200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetDocumentContent200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetDocumentContent200ApplicationoctetStreamResponse) VisitGetDocumentContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetDocumentContent401Response struct {
+}
+
+func (response GetDocumentContent401Response) VisitGetDocumentContentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDocumentContent404Response struct {
+}
+
+func (response GetDocumentContent404Response) VisitGetDocumentContentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DiffDocumentVersionsRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Params     DiffDocumentVersionsParams
+}
+
+type DiffDocumentVersionsResponseObject interface {
+	VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error
+}
+
+type DiffDocumentVersions200JSONResponse VersionDiff
+
+func (response DiffDocumentVersions200JSONResponse) VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDocumentVersions401Response struct {
+}
+
+func (response DiffDocumentVersions401Response) VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DiffDocumentVersions404Response struct {
+}
+
+func (response DiffDocumentVersions404Response) VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SetDocumentFolderRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *SetDocumentFolderJSONRequestBody
+}
+
+type SetDocumentFolderResponseObject interface {
+	VisitSetDocumentFolderResponse(w http.ResponseWriter) error
+}
+
+type SetDocumentFolder200JSONResponse Document
+
+func (response SetDocumentFolder200JSONResponse) VisitSetDocumentFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDocumentFolder400JSONResponse Error
+
+func (response SetDocumentFolder400JSONResponse) VisitSetDocumentFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDocumentFolder401Response struct {
+}
+
+func (response SetDocumentFolder401Response) VisitSetDocumentFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetDocumentFolder403JSONResponse Error
+
+func (response SetDocumentFolder403JSONResponse) VisitSetDocumentFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDocumentFolder404JSONResponse Error
+
+func (response SetDocumentFolder404JSONResponse) VisitSetDocumentFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareLinksRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type ListShareLinksResponseObject interface {
+	VisitListShareLinksResponse(w http.ResponseWriter) error
+}
+
+type ListShareLinks200JSONResponse []ShareLink
+
+func (response ListShareLinks200JSONResponse) VisitListShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareLinks401Response struct {
+}
+
+func (response ListShareLinks401Response) VisitListShareLinksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListShareLinks403JSONResponse Error
+
+func (response ListShareLinks403JSONResponse) VisitListShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareLinks404Response struct {
+}
+
+func (response ListShareLinks404Response) VisitListShareLinksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CreateShareLinkRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *CreateShareLinkJSONRequestBody
+}
+
+type CreateShareLinkResponseObject interface {
+	VisitCreateShareLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateShareLink201JSONResponse CreatedShareLink
+
+func (response CreateShareLink201JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink400JSONResponse Error
+
+func (response CreateShareLink400JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink401Response struct {
+}
+
+func (response CreateShareLink401Response) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateShareLink403JSONResponse Error
+
+func (response CreateShareLink403JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink404Response struct {
+}
+
+func (response CreateShareLink404Response) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RevokeShareLinkRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	LinkID     int64 `json:"linkID"`
+}
+
+type RevokeShareLinkResponseObject interface {
+	VisitRevokeShareLinkResponse(w http.ResponseWriter) error
+}
+
+type RevokeShareLink204Response struct {
+}
+
+func (response RevokeShareLink204Response) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeShareLink401Response struct {
+}
+
+func (response RevokeShareLink401Response) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeShareLink403JSONResponse Error
+
+func (response RevokeShareLink403JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink404Response struct {
+}
+
+func (response RevokeShareLink404Response) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RestoreDocumentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type RestoreDocumentResponseObject interface {
+	VisitRestoreDocumentResponse(w http.ResponseWriter) error
+}
+
+type RestoreDocument200JSONResponse Document
+
+func (response RestoreDocument200JSONResponse) VisitRestoreDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreDocument401Response struct {
+}
+
+func (response RestoreDocument401Response) VisitRestoreDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RestoreDocument403JSONResponse QuotaError
+
+func (response RestoreDocument403JSONResponse) VisitRestoreDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreDocument404Response struct {
+}
+
+func (response RestoreDocument404Response) VisitRestoreDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SearchInDocumentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *SearchInDocumentJSONRequestBody
+}
+
+type SearchInDocumentResponseObject interface {
+	VisitSearchInDocumentResponse(w http.ResponseWriter) error
+}
+
+type SearchInDocument200JSONResponse []SearchResult
+
+func (response SearchInDocument200JSONResponse) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchInDocument400Response struct {
+}
+
+func (response SearchInDocument400Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SearchInDocument401Response struct {
+}
+
+func (response SearchInDocument401Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SearchInDocument404Response struct {
+}
+
+func (response SearchInDocument404Response) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SearchInDocument429JSONResponse struct {
+	SearchQuotaExceededJSONResponse
+}
+
+func (response SearchInDocument429JSONResponse) VisitSearchInDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListDocumentSharesRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type ListDocumentSharesResponseObject interface {
+	VisitListDocumentSharesResponse(w http.ResponseWriter) error
+}
+
+type ListDocumentShares200JSONResponse []DocumentShare
+
+func (response ListDocumentShares200JSONResponse) VisitListDocumentSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentShares401Response struct {
+}
+
+func (response ListDocumentShares401Response) VisitListDocumentSharesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListDocumentShares403JSONResponse Error
+
+func (response ListDocumentShares403JSONResponse) VisitListDocumentSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentShares404Response struct {
+}
+
+func (response ListDocumentShares404Response) VisitListDocumentSharesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ShareDocumentRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Body       *ShareDocumentJSONRequestBody
+}
+
+type ShareDocumentResponseObject interface {
+	VisitShareDocumentResponse(w http.ResponseWriter) error
+}
+
+type ShareDocument201JSONResponse DocumentShare
+
+func (response ShareDocument201JSONResponse) VisitShareDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareDocument400JSONResponse Error
+
+func (response ShareDocument400JSONResponse) VisitShareDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareDocument401Response struct {
+}
+
+func (response ShareDocument401Response) VisitShareDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ShareDocument403JSONResponse Error
+
+func (response ShareDocument403JSONResponse) VisitShareDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareDocument404JSONResponse Error
+
+func (response ShareDocument404JSONResponse) VisitShareDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeDocumentShareRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	UserID     int64 `json:"userID"`
+}
+
+type RevokeDocumentShareResponseObject interface {
+	VisitRevokeDocumentShareResponse(w http.ResponseWriter) error
+}
+
+type RevokeDocumentShare204Response struct {
+}
+
+func (response RevokeDocumentShare204Response) VisitRevokeDocumentShareResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeDocumentShare401Response struct {
+}
+
+func (response RevokeDocumentShare401Response) VisitRevokeDocumentShareResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeDocumentShare403JSONResponse Error
+
+func (response RevokeDocumentShare403JSONResponse) VisitRevokeDocumentShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeDocumentShare404JSONResponse Error
+
+func (response RevokeDocumentShare404JSONResponse) VisitRevokeDocumentShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentVersionsRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+}
+
+type ListDocumentVersionsResponseObject interface {
+	VisitListDocumentVersionsResponse(w http.ResponseWriter) error
+}
+
+type ListDocumentVersions200JSONResponse []DocumentVersion
+
+func (response ListDocumentVersions200JSONResponse) VisitListDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDocumentVersions401Response struct {
+}
+
+func (response ListDocumentVersions401Response) VisitListDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListDocumentVersions404Response struct {
+}
+
+func (response ListDocumentVersions404Response) VisitListDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RestoreDocumentVersionRequestObject struct {
+	DocumentID int64 `json:"documentID"`
+	Version    int32 `json:"version"`
+}
+
+type RestoreDocumentVersionResponseObject interface {
+	VisitRestoreDocumentVersionResponse(w http.ResponseWriter) error
+}
+
+type RestoreDocumentVersion200JSONResponse Document
+
+func (response RestoreDocumentVersion200JSONResponse) VisitRestoreDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreDocumentVersion401Response struct {
+}
+
+func (response RestoreDocumentVersion401Response) VisitRestoreDocumentVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RestoreDocumentVersion403JSONResponse QuotaError
+
+func (response RestoreDocumentVersion403JSONResponse) VisitRestoreDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreDocumentVersion404Response struct {
+}
+
+func (response RestoreDocumentVersion404Response) VisitRestoreDocumentVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type StreamEventsRequestObject struct {
+}
+
+type StreamEventsResponseObject interface {
+	VisitStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamEvents200TexteventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamEvents401Response struct {
+}
+
+func (response StreamEvents401Response) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type StreamEvents403JSONResponse Error
+
+func (response StreamEvents403JSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamEvents429JSONResponse Error
+
+func (response StreamEvents429JSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFoldersRequestObject struct {
+	Params ListFoldersParams
+}
+
+type ListFoldersResponseObject interface {
+	VisitListFoldersResponse(w http.ResponseWriter) error
+}
+
+type ListFolders200JSONResponse []Folder
+
+func (response ListFolders200JSONResponse) VisitListFoldersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFolders401Response struct {
+}
+
+func (response ListFolders401Response) VisitListFoldersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListFolders404Response struct {
+}
+
+func (response ListFolders404Response) VisitListFoldersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CreateFolderRequestObject struct {
+	Body *CreateFolderJSONRequestBody
+}
+
+type CreateFolderResponseObject interface {
+	VisitCreateFolderResponse(w http.ResponseWriter) error
+}
+
+type CreateFolder201JSONResponse Folder
+
+func (response CreateFolder201JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFolder400JSONResponse Error
+
+func (response CreateFolder400JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFolder401Response struct {
+}
+
+func (response CreateFolder401Response) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateFolder403JSONResponse Error
+
+func (response CreateFolder403JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFolder404JSONResponse Error
+
+func (response CreateFolder404JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFolder409JSONResponse Error
+
+func (response CreateFolder409JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFolderRequestObject struct {
+	FolderID FolderIDPath `json:"folderID"`
+}
+
+type DeleteFolderResponseObject interface {
+	VisitDeleteFolderResponse(w http.ResponseWriter) error
+}
+
+type DeleteFolder200JSONResponse DeleteFolderResult
+
+func (response DeleteFolder200JSONResponse) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFolder401Response struct {
+}
+
+func (response DeleteFolder401Response) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteFolder403JSONResponse Error
+
+func (response DeleteFolder403JSONResponse) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFolder404Response struct {
+}
+
+func (response DeleteFolder404Response) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetFolderRequestObject struct {
+	FolderID FolderIDPath `json:"folderID"`
+}
+
+type GetFolderResponseObject interface {
+	VisitGetFolderResponse(w http.ResponseWriter) error
+}
+
+type GetFolder200JSONResponse Folder
+
+func (response GetFolder200JSONResponse) VisitGetFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFolder401Response struct {
+}
+
+func (response GetFolder401Response) VisitGetFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFolder404Response struct {
+}
+
+func (response GetFolder404Response) VisitGetFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RenameFolderRequestObject struct {
+	FolderID FolderIDPath `json:"folderID"`
+	Body     *RenameFolderJSONRequestBody
+}
+
+type RenameFolderResponseObject interface {
+	VisitRenameFolderResponse(w http.ResponseWriter) error
+}
+
+type RenameFolder200JSONResponse Folder
+
+func (response RenameFolder200JSONResponse) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFolder400JSONResponse Error
+
+func (response RenameFolder400JSONResponse) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFolder401Response struct {
+}
+
+func (response RenameFolder401Response) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RenameFolder403JSONResponse Error
+
+func (response RenameFolder403JSONResponse) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFolder404Response struct {
+}
+
+func (response RenameFolder404Response) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RenameFolder409JSONResponse Error
+
+func (response RenameFolder409JSONResponse) VisitRenameFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveFolderRequestObject struct {
+	FolderID FolderIDPath `json:"folderID"`
+	Body     *MoveFolderJSONRequestBody
+}
+
+type MoveFolderResponseObject interface {
+	VisitMoveFolderResponse(w http.ResponseWriter) error
+}
+
+type MoveFolder200JSONResponse Folder
+
+func (response MoveFolder200JSONResponse) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveFolder400JSONResponse Error
+
+func (response MoveFolder400JSONResponse) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveFolder401Response struct {
+}
+
+func (response MoveFolder401Response) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MoveFolder403JSONResponse Error
+
+func (response MoveFolder403JSONResponse) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveFolder404JSONResponse Error
+
+func (response MoveFolder404JSONResponse) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveFolder409JSONResponse Error
+
+func (response MoveFolder409JSONResponse) VisitMoveFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PingRequestObject struct {
 }
 
 type PingResponseObject interface {
 	VisitPingResponse(w http.ResponseWriter) error
 }
 
-type Ping200TextResponse string
-
-func (response Ping200TextResponse) VisitPingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(200)
+type Ping200TextResponse string
+
+func (response Ping200TextResponse) VisitPingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetSharedDocumentRequestObject struct {
+	Token ShareLinkToken `json:"token"`
+}
+
+type GetSharedDocumentResponseObject interface {
+	VisitGetSharedDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetSharedDocument200JSONResponse SharedDocument
+
+func (response GetSharedDocument200JSONResponse) VisitGetSharedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedDocument404Response struct {
+}
+
+func (response GetSharedDocument404Response) VisitGetSharedDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetSharedDocument429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetSharedDocument429JSONResponse) VisitGetSharedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SearchSharedDocumentRequestObject struct {
+	Token ShareLinkToken `json:"token"`
+	Body  *SearchSharedDocumentJSONRequestBody
+}
+
+type SearchSharedDocumentResponseObject interface {
+	VisitSearchSharedDocumentResponse(w http.ResponseWriter) error
+}
+
+type SearchSharedDocument200JSONResponse []SearchResult
+
+func (response SearchSharedDocument200JSONResponse) VisitSearchSharedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSharedDocument400Response struct {
+}
+
+func (response SearchSharedDocument400Response) VisitSearchSharedDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SearchSharedDocument404Response struct {
+}
+
+func (response SearchSharedDocument404Response) VisitSearchSharedDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SearchSharedDocument429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SearchSharedDocument429JSONResponse) VisitSearchSharedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TusOptionsRequestObject struct {
+}
+
+type TusOptionsResponseObject interface {
+	VisitTusOptionsResponse(w http.ResponseWriter) error
+}
+
+type TusOptions204ResponseHeaders struct {
+	TusChecksumAlgorithm string
+	TusExtension         string
+	TusMaxSize           int64
+	TusVersion           string
+}
+
+type TusOptions204Response struct {
+	Headers TusOptions204ResponseHeaders
+}
+
+func (response TusOptions204Response) VisitTusOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Tus-Checksum-Algorithm", fmt.Sprint(response.Headers.TusChecksumAlgorithm))
+	w.Header().Set("Tus-Extension", fmt.Sprint(response.Headers.TusExtension))
+	w.Header().Set("Tus-Max-Size", fmt.Sprint(response.Headers.TusMaxSize))
+	w.Header().Set("Tus-Version", fmt.Sprint(response.Headers.TusVersion))
+	w.WriteHeader(204)
+	return nil
+}
+
+type CreateUploadRequestObject struct {
+	Params CreateUploadParams
+}
+
+type CreateUploadResponseObject interface {
+	VisitCreateUploadResponse(w http.ResponseWriter) error
+}
+
+type CreateUpload201ResponseHeaders struct {
+	Location string
+}
+
+type CreateUpload201Response struct {
+	Headers CreateUpload201ResponseHeaders
+}
+
+func (response CreateUpload201Response) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateUpload400JSONResponse Error
+
+func (response CreateUpload400JSONResponse) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUpload401Response struct {
+}
+
+func (response CreateUpload401Response) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateUpload403JSONResponse QuotaError
+
+func (response CreateUpload403JSONResponse) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUpload404Response struct {
+}
+
+func (response CreateUpload404Response) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CreateUpload412Response = TusVersionMismatchResponse
+
+func (response CreateUpload412Response) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Tus-Version", fmt.Sprint(response.Headers.TusVersion))
+	w.WriteHeader(412)
+	return nil
+}
+
+type CreateUpload413JSONResponse Error
+
+func (response CreateUpload413JSONResponse) VisitCreateUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUploadRequestObject struct {
+	UploadID UploadID `json:"uploadID"`
+	Params   DeleteUploadParams
+}
+
+type DeleteUploadResponseObject interface {
+	VisitDeleteUploadResponse(w http.ResponseWriter) error
+}
+
+type DeleteUpload204Response struct {
+}
+
+func (response DeleteUpload204Response) VisitDeleteUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUpload401Response struct {
+}
+
+func (response DeleteUpload401Response) VisitDeleteUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteUpload404Response struct {
+}
+
+func (response DeleteUpload404Response) VisitDeleteUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteUpload412Response = TusVersionMismatchResponse
+
+func (response DeleteUpload412Response) VisitDeleteUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Tus-Version", fmt.Sprint(response.Headers.TusVersion))
+	w.WriteHeader(412)
+	return nil
+}
+
+type GetUploadOffsetRequestObject struct {
+	UploadID UploadID `json:"uploadID"`
+	Params   GetUploadOffsetParams
+}
+
+type GetUploadOffsetResponseObject interface {
+	VisitGetUploadOffsetResponse(w http.ResponseWriter) error
+}
+
+type GetUploadOffset200ResponseHeaders struct {
+	CacheControl     string
+	UploadDocumentID string
+	UploadLength     int64
+	UploadOffset     int64
+}
+
+type GetUploadOffset200Response struct {
+	Headers GetUploadOffset200ResponseHeaders
+}
+
+func (response GetUploadOffset200Response) VisitGetUploadOffsetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Upload-Document-ID", fmt.Sprint(response.Headers.UploadDocumentID))
+	w.Header().Set("Upload-Length", fmt.Sprint(response.Headers.UploadLength))
+	w.Header().Set("Upload-Offset", fmt.Sprint(response.Headers.UploadOffset))
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetUploadOffset401Response struct {
+}
+
+func (response GetUploadOffset401Response) VisitGetUploadOffsetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetUploadOffset404Response struct {
+}
+
+func (response GetUploadOffset404Response) VisitGetUploadOffsetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetUploadOffset412Response = TusVersionMismatchResponse
+
+func (response GetUploadOffset412Response) VisitGetUploadOffsetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Tus-Version", fmt.Sprint(response.Headers.TusVersion))
+	w.WriteHeader(412)
+	return nil
+}
+
+type AppendUploadRequestObject struct {
+	UploadID UploadID `json:"uploadID"`
+	Params   AppendUploadParams
+	Body     io.Reader
+}
+
+type AppendUploadResponseObject interface {
+	VisitAppendUploadResponse(w http.ResponseWriter) error
+}
+
+type AppendUpload204ResponseHeaders struct {
+	UploadDocumentID string
+	UploadOffset     int64
+}
+
+type AppendUpload204Response struct {
+	Headers AppendUpload204ResponseHeaders
+}
+
+func (response AppendUpload204Response) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Upload-Document-ID", fmt.Sprint(response.Headers.UploadDocumentID))
+	w.Header().Set("Upload-Offset", fmt.Sprint(response.Headers.UploadOffset))
+	w.WriteHeader(204)
+	return nil
+}
+
+type AppendUpload400JSONResponse Error
+
+func (response AppendUpload400JSONResponse) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendUpload401Response struct {
+}
+
+func (response AppendUpload401Response) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AppendUpload403JSONResponse QuotaError
+
+func (response AppendUpload403JSONResponse) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendUpload404Response struct {
+}
+
+func (response AppendUpload404Response) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AppendUpload409JSONResponse Error
+
+func (response AppendUpload409JSONResponse) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendUpload412Response = TusVersionMismatchResponse
+
+func (response AppendUpload412Response) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Tus-Version", fmt.Sprint(response.Headers.TusVersion))
+	w.WriteHeader(412)
+	return nil
+}
+
+type AppendUpload415Response struct {
+}
+
+func (response AppendUpload415Response) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(415)
+	return nil
+}
+
+type AppendUpload423JSONResponse Error
+
+func (response AppendUpload423JSONResponse) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(423)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendUpload460JSONResponse Error
+
+func (response AppendUpload460JSONResponse) VisitAppendUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(460)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccountRequestObject struct {
+	Body *DeleteAccountJSONRequestBody
+}
+
+type DeleteAccountResponseObject interface {
+	VisitDeleteAccountResponse(w http.ResponseWriter) error
+}
+
+type DeleteAccount204Response struct {
+}
+
+func (response DeleteAccount204Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAccount401Response struct {
+}
+
+func (response DeleteAccount401Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteAccount403JSONResponse Error
+
+func (response DeleteAccount403JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccount409JSONResponse Error
+
+func (response DeleteAccount409JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserProfileRequestObject struct {
+}
+
+type GetUserProfileResponseObject interface {
+	VisitGetUserProfileResponse(w http.ResponseWriter) error
+}
+
+type GetUserProfile200JSONResponse User
+
+func (response GetUserProfile200JSONResponse) VisitGetUserProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserProfile401Response struct {
+}
+
+func (response GetUserProfile401Response) VisitGetUserProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangePassword204Response struct {
+}
+
+func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangePassword400Response struct {
+}
+
+func (response ChangePassword400Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ChangePassword401Response struct {
+}
+
+func (response ChangePassword401Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangePassword403JSONResponse Error
+
+func (response ChangePassword403JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspacesRequestObject struct {
+}
+
+type ListWorkspacesResponseObject interface {
+	VisitListWorkspacesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaces200JSONResponse []Workspace
+
+func (response ListWorkspaces200JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaces401Response struct {
+}
+
+func (response ListWorkspaces401Response) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWorkspaceRequestObject struct {
+	Body *CreateWorkspaceJSONRequestBody
+}
+
+type CreateWorkspaceResponseObject interface {
+	VisitCreateWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkspace201JSONResponse Workspace
+
+func (response CreateWorkspace201JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace400JSONResponse Error
+
+func (response CreateWorkspace400JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace401Response struct {
+}
+
+func (response CreateWorkspace401Response) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWorkspaceRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+}
+
+type DeleteWorkspaceResponseObject interface {
+	VisitDeleteWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkspace204Response struct {
+}
+
+func (response DeleteWorkspace204Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkspace401Response struct {
+}
+
+func (response DeleteWorkspace401Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWorkspace403JSONResponse Error
+
+func (response DeleteWorkspace403JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspace404Response struct {
+}
+
+func (response DeleteWorkspace404Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWorkspaceRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+}
+
+type GetWorkspaceResponseObject interface {
+	VisitGetWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type GetWorkspace200JSONResponse Workspace
+
+func (response GetWorkspace200JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace401Response struct {
+}
+
+func (response GetWorkspace401Response) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWorkspace404Response struct {
+}
+
+func (response GetWorkspace404Response) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RenameWorkspaceRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	Body        *RenameWorkspaceJSONRequestBody
+}
+
+type RenameWorkspaceResponseObject interface {
+	VisitRenameWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type RenameWorkspace200JSONResponse Workspace
+
+func (response RenameWorkspace200JSONResponse) VisitRenameWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameWorkspace400JSONResponse Error
+
+func (response RenameWorkspace400JSONResponse) VisitRenameWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameWorkspace401Response struct {
+}
+
+func (response RenameWorkspace401Response) VisitRenameWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RenameWorkspace403JSONResponse Error
+
+func (response RenameWorkspace403JSONResponse) VisitRenameWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameWorkspace404Response struct {
+}
+
+func (response RenameWorkspace404Response) VisitRenameWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListWorkspaceMembersRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+}
+
+type ListWorkspaceMembersResponseObject interface {
+	VisitListWorkspaceMembersResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaceMembers200JSONResponse []WorkspaceMember
+
+func (response ListWorkspaceMembers200JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceMembers401Response struct {
+}
+
+func (response ListWorkspaceMembers401Response) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWorkspaceMembers404Response struct {
+}
+
+func (response ListWorkspaceMembers404Response) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AddWorkspaceMemberRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	Body        *AddWorkspaceMemberJSONRequestBody
+}
+
+type AddWorkspaceMemberResponseObject interface {
+	VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type AddWorkspaceMember201JSONResponse WorkspaceMember
+
+func (response AddWorkspaceMember201JSONResponse) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkspaceMember400JSONResponse Error
+
+func (response AddWorkspaceMember400JSONResponse) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkspaceMember401Response struct {
+}
+
+func (response AddWorkspaceMember401Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddWorkspaceMember403JSONResponse Error
+
+func (response AddWorkspaceMember403JSONResponse) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkspaceMember404JSONResponse Error
+
+func (response AddWorkspaceMember404JSONResponse) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMemberRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	UserID      int64           `json:"userID"`
+}
+
+type RemoveWorkspaceMemberResponseObject interface {
+	VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveWorkspaceMember204Response struct {
+}
+
+func (response RemoveWorkspaceMember204Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveWorkspaceMember400JSONResponse Error
+
+func (response RemoveWorkspaceMember400JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember401Response struct {
+}
+
+func (response RemoveWorkspaceMember401Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RemoveWorkspaceMember403JSONResponse Error
+
+func (response RemoveWorkspaceMember403JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember404JSONResponse Error
+
+func (response RemoveWorkspaceMember404JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceMemberRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	UserID      int64           `json:"userID"`
+	Body        *UpdateWorkspaceMemberJSONRequestBody
+}
+
+type UpdateWorkspaceMemberResponseObject interface {
+	VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkspaceMember200JSONResponse WorkspaceMember
+
+func (response UpdateWorkspaceMember200JSONResponse) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceMember400JSONResponse Error
+
+func (response UpdateWorkspaceMember400JSONResponse) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceMember401Response struct {
+}
+
+func (response UpdateWorkspaceMember401Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateWorkspaceMember403JSONResponse Error
+
+func (response UpdateWorkspaceMember403JSONResponse) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceMember404JSONResponse Error
+
+func (response UpdateWorkspaceMember404JSONResponse) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse []Webhook
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401Response struct {
+}
+
+func (response ListWebhooks401Response) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWebhooks403JSONResponse Error
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks404Response struct {
+}
+
+func (response ListWebhooks404Response) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CreateWebhookRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	Body        *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse Webhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse Error
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401Response struct {
+}
+
+func (response CreateWebhook401Response) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWebhook403JSONResponse Error
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook404Response struct {
+}
+
+func (response CreateWebhook404Response) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteWebhookRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	WebhookID   WebhookIDPath   `json:"webhookID"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook401Response struct {
+}
+
+func (response DeleteWebhook401Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWebhook403JSONResponse Error
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse Error
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	WebhookID   WebhookIDPath   `json:"webhookID"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse Webhook
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook401Response struct {
+}
+
+func (response GetWebhook401Response) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWebhook403JSONResponse Error
+
+func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook404JSONResponse Error
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	WebhookID   WebhookIDPath   `json:"webhookID"`
+	Body        *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse Webhook
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook400JSONResponse Error
+
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook401Response struct {
+}
+
+func (response UpdateWebhook401Response) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateWebhook403JSONResponse Error
+
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse Error
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	WebhookID   WebhookIDPath   `json:"webhookID"`
+	Params      ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse []WebhookDelivery
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401Response struct {
+}
+
+func (response ListWebhookDeliveries401Response) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWebhookDeliveries403JSONResponse Error
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse Error
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookRequestObject struct {
+	WorkspaceID WorkspaceIDPath `json:"workspaceID"`
+	WebhookID   WebhookIDPath   `json:"webhookID"`
+	DeliveryID  int64           `json:"deliveryID"`
+}
+
+type RedeliverWebhookResponseObject interface {
+	VisitRedeliverWebhookResponse(w http.ResponseWriter) error
+}
+
+type RedeliverWebhook202JSONResponse WebhookDelivery
+
+func (response RedeliverWebhook202JSONResponse) VisitRedeliverWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhook401Response struct {
+}
+
+func (response RedeliverWebhook401Response) VisitRedeliverWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RedeliverWebhook403JSONResponse Error
+
+func (response RedeliverWebhook403JSONResponse) VisitRedeliverWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhook404JSONResponse Error
+
+func (response RedeliverWebhook404JSONResponse) VisitRedeliverWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Список пользователей с количеством документов и чанков
+	// (GET /admin/users)
+	AdminListUsers(ctx context.Context, request AdminListUsersRequestObject) (AdminListUsersResponseObject, error)
+	// Пользователь с количеством документов и чанков
+	// (GET /admin/users/{userID})
+	AdminGetUser(ctx context.Context, request AdminGetUserRequestObject) (AdminGetUserResponseObject, error)
+	// Заблокировать пользователя
+	// (POST /admin/users/{userID}/disable)
+	AdminDisableUser(ctx context.Context, request AdminDisableUserRequestObject) (AdminDisableUserResponseObject, error)
+	// Разблокировать пользователя
+	// (POST /admin/users/{userID}/enable)
+	AdminEnableUser(ctx context.Context, request AdminEnableUserRequestObject) (AdminEnableUserResponseObject, error)
+	// Отключить двухфакторную аутентификацию
+	// (POST /auth/2fa/disable)
+	DisableTwoFactor(ctx context.Context, request DisableTwoFactorRequestObject) (DisableTwoFactorResponseObject, error)
+	// Начать подключение TOTP
+	// (POST /auth/2fa/enroll)
+	BeginTwoFactorEnrollment(ctx context.Context, request BeginTwoFactorEnrollmentRequestObject) (BeginTwoFactorEnrollmentResponseObject, error)
+	// Подтвердить подключение TOTP первым кодом
+	// (POST /auth/2fa/enroll/confirm)
+	ConfirmTwoFactorEnrollment(ctx context.Context, request ConfirmTwoFactorEnrollmentRequestObject) (ConfirmTwoFactorEnrollmentResponseObject, error)
+	// Второй шаг входа - проверка TOTP-кода или кода восстановления
+	// (POST /auth/2fa/verify)
+	VerifyTwoFactorLogin(ctx context.Context, request VerifyTwoFactorLoginRequestObject) (VerifyTwoFactorLoginResponseObject, error)
+	// Выход со всех устройств
+	// (POST /auth/full_logout)
+	FullLogout(ctx context.Context, request FullLogoutRequestObject) (FullLogoutResponseObject, error)
+	// Вход в систему
+	// (POST /auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Выход из текущей сессии
+	// (POST /auth/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Обработка ответа OIDC-провайдера
+	// (GET /auth/oidc/callback)
+	OidcCallback(ctx context.Context, request OidcCallbackRequestObject) (OidcCallbackResponseObject, error)
+	// Вход через OIDC-провайдера
+	// (GET /auth/oidc/login)
+	OidcLogin(ctx context.Context, request OidcLoginRequestObject) (OidcLoginResponseObject, error)
+	// Установить новый пароль по одноразовому токену из письма
+	// (POST /auth/password-reset)
+	ResetPassword(ctx context.Context, request ResetPasswordRequestObject) (ResetPasswordResponseObject, error)
+	// Запросить письмо для сброса пароля
+	// (POST /auth/password-reset/request)
+	RequestPasswordReset(ctx context.Context, request RequestPasswordResetRequestObject) (RequestPasswordResetResponseObject, error)
+	// Обновление пары токенов
+	// (POST /auth/refresh)
+	Refresh(ctx context.Context, request RefreshRequestObject) (RefreshResponseObject, error)
+	// Регистрация нового пользователя
+	// (POST /auth/register)
+	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
+	// Подтверждение email по одноразовому токену из письма
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error)
+	// Повторно отправить письмо для подтверждения email
+	// (POST /auth/verify-email/request)
+	RequestEmailVerification(ctx context.Context, request RequestEmailVerificationRequestObject) (RequestEmailVerificationResponseObject, error)
+	// Получить список всех документов пользователя
+	// (GET /documents)
+	ListUserDocuments(ctx context.Context, request ListUserDocumentsRequestObject) (ListUserDocumentsResponseObject, error)
+	// Загрузить новый документ
+	// (POST /documents)
+	UploadDocument(ctx context.Context, request UploadDocumentRequestObject) (UploadDocumentResponseObject, error)
+	// Семантический поиск по всем документам
+	// (POST /documents/search)
+	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
+	// Очистить корзину
+	// (DELETE /documents/trash)
+	EmptyTrash(ctx context.Context, request EmptyTrashRequestObject) (EmptyTrashResponseObject, error)
+	// Список документов в корзине
+	// (GET /documents/trash)
+	ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error)
+	// Удалить документ
+	// (DELETE /documents/{documentID})
+	DeleteDocument(ctx context.Context, request DeleteDocumentRequestObject) (DeleteDocumentResponseObject, error)
+	// Получить информацию о конкретном документе
+	// (GET /documents/{documentID})
+	GetDocumentByID(ctx context.Context, request GetDocumentByIDRequestObject) (GetDocumentByIDResponseObject, error)
+	// Изменить описание, теги и пользовательские поля документа
+	// (PATCH /documents/{documentID})
+	UpdateDocument(ctx context.Context, request UpdateDocumentRequestObject) (UpdateDocumentResponseObject, error)
+	// Загрузить новую версию документа
+	// (PUT /documents/{documentID})
+	UploadDocumentVersion(ctx context.Context, request UploadDocumentVersionRequestObject) (UploadDocumentVersionResponseObject, error)
+	// Список чанков документа
+	// (GET /documents/{documentID}/chunks)
+	ListDocumentChunks(ctx context.Context, request ListDocumentChunksRequestObject) (ListDocumentChunksResponseObject, error)
+	// Скачать содержимое документа
+	// (GET /documents/{documentID}/content)
+	GetDocumentContent(ctx context.Context, request GetDocumentContentRequestObject) (GetDocumentContentResponseObject, error)
+	// Сравнить две версии документа
+	// (GET /documents/{documentID}/diff)
+	DiffDocumentVersions(ctx context.Context, request DiffDocumentVersionsRequestObject) (DiffDocumentVersionsResponseObject, error)
+	// Переместить документ в папку
+	// (PUT /documents/{documentID}/folder)
+	SetDocumentFolder(ctx context.Context, request SetDocumentFolderRequestObject) (SetDocumentFolderResponseObject, error)
+	// Список публичных ссылок на документ
+	// (GET /documents/{documentID}/links)
+	ListShareLinks(ctx context.Context, request ListShareLinksRequestObject) (ListShareLinksResponseObject, error)
+	// Создать публичную ссылку на документ только для чтения
+	// (POST /documents/{documentID}/links)
+	CreateShareLink(ctx context.Context, request CreateShareLinkRequestObject) (CreateShareLinkResponseObject, error)
+	// Отозвать публичную ссылку
+	// (DELETE /documents/{documentID}/links/{linkID})
+	RevokeShareLink(ctx context.Context, request RevokeShareLinkRequestObject) (RevokeShareLinkResponseObject, error)
+	// Восстановить документ из корзины
+	// (POST /documents/{documentID}/restore)
+	RestoreDocument(ctx context.Context, request RestoreDocumentRequestObject) (RestoreDocumentResponseObject, error)
+	// Семантический поиск по конкретному документу
+	// (POST /documents/{documentID}/search)
+	SearchInDocument(ctx context.Context, request SearchInDocumentRequestObject) (SearchInDocumentResponseObject, error)
+	// Список пользователей, которым выдан доступ к документу
+	// (GET /documents/{documentID}/shares)
+	ListDocumentShares(ctx context.Context, request ListDocumentSharesRequestObject) (ListDocumentSharesResponseObject, error)
+	// Поделиться документом с пользователем по email
+	// (POST /documents/{documentID}/shares)
+	ShareDocument(ctx context.Context, request ShareDocumentRequestObject) (ShareDocumentResponseObject, error)
+	// Отозвать доступ к документу
+	// (DELETE /documents/{documentID}/shares/{userID})
+	RevokeDocumentShare(ctx context.Context, request RevokeDocumentShareRequestObject) (RevokeDocumentShareResponseObject, error)
+	// Список версий документа
+	// (GET /documents/{documentID}/versions)
+	ListDocumentVersions(ctx context.Context, request ListDocumentVersionsRequestObject) (ListDocumentVersionsResponseObject, error)
+	// Восстановить версию документа
+	// (POST /documents/{documentID}/versions/{version}/restore)
+	RestoreDocumentVersion(ctx context.Context, request RestoreDocumentVersionRequestObject) (RestoreDocumentVersionResponseObject, error)
+	// Поток событий документов
+	// (GET /events)
+	StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error)
+	// Список папок
+	// (GET /folders)
+	ListFolders(ctx context.Context, request ListFoldersRequestObject) (ListFoldersResponseObject, error)
+	// Создать папку
+	// (POST /folders)
+	CreateFolder(ctx context.Context, request CreateFolderRequestObject) (CreateFolderResponseObject, error)
+	// Удалить папку
+	// (DELETE /folders/{folderID})
+	DeleteFolder(ctx context.Context, request DeleteFolderRequestObject) (DeleteFolderResponseObject, error)
+	// Получить папку
+	// (GET /folders/{folderID})
+	GetFolder(ctx context.Context, request GetFolderRequestObject) (GetFolderResponseObject, error)
+	// Переименовать папку
+	// (PUT /folders/{folderID})
+	RenameFolder(ctx context.Context, request RenameFolderRequestObject) (RenameFolderResponseObject, error)
+	// Переместить папку
+	// (POST /folders/{folderID}/move)
+	MoveFolder(ctx context.Context, request MoveFolderRequestObject) (MoveFolderResponseObject, error)
+	// Проверка работоспособности сервера
+	// (GET /ping)
+	Ping(ctx context.Context, request PingRequestObject) (PingResponseObject, error)
+	// Просмотр документа по публичной ссылке
+	// (GET /public/links/{token})
+	GetSharedDocument(ctx context.Context, request GetSharedDocumentRequestObject) (GetSharedDocumentResponseObject, error)
+	// Семантический поиск по документу из публичной ссылки
+	// (POST /public/links/{token}/search)
+	SearchSharedDocument(ctx context.Context, request SearchSharedDocumentRequestObject) (SearchSharedDocumentResponseObject, error)
+	// Возможности сервера загрузок tus
+	// (OPTIONS /uploads)
+	TusOptions(ctx context.Context, request TusOptionsRequestObject) (TusOptionsResponseObject, error)
+	// Создать возобновляемую загрузку (tus creation)
+	// (POST /uploads)
+	CreateUpload(ctx context.Context, request CreateUploadRequestObject) (CreateUploadResponseObject, error)
+	// Отменить загрузку (tus termination)
+	// (DELETE /uploads/{uploadID})
+	DeleteUpload(ctx context.Context, request DeleteUploadRequestObject) (DeleteUploadResponseObject, error)
+	// Текущее смещение загрузки
+	// (HEAD /uploads/{uploadID})
+	GetUploadOffset(ctx context.Context, request GetUploadOffsetRequestObject) (GetUploadOffsetResponseObject, error)
+	// Дописать данные в загрузку
+	// (PATCH /uploads/{uploadID})
+	AppendUpload(ctx context.Context, request AppendUploadRequestObject) (AppendUploadResponseObject, error)
+	// Удалить аккаунт
+	// (DELETE /users/me)
+	DeleteAccount(ctx context.Context, request DeleteAccountRequestObject) (DeleteAccountResponseObject, error)
+	// Получить профиль текущего пользователя
+	// (GET /users/me)
+	GetUserProfile(ctx context.Context, request GetUserProfileRequestObject) (GetUserProfileResponseObject, error)
+	// Сменить пароль
+	// (PUT /users/me/password)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+	// Список рабочих пространств пользователя
+	// (GET /workspaces)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	// Создать рабочее пространство
+	// (POST /workspaces)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+	// Удалить рабочее пространство
+	// (DELETE /workspaces/{workspaceID})
+	DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error)
+	// Получить рабочее пространство
+	// (GET /workspaces/{workspaceID})
+	GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error)
+	// Переименовать рабочее пространство
+	// (PUT /workspaces/{workspaceID})
+	RenameWorkspace(ctx context.Context, request RenameWorkspaceRequestObject) (RenameWorkspaceResponseObject, error)
+	// Список участников рабочего пространства
+	// (GET /workspaces/{workspaceID}/members)
+	ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error)
+	// Добавить участника по email
+	// (POST /workspaces/{workspaceID}/members)
+	AddWorkspaceMember(ctx context.Context, request AddWorkspaceMemberRequestObject) (AddWorkspaceMemberResponseObject, error)
+	// Удалить участника
+	// (DELETE /workspaces/{workspaceID}/members/{userID})
+	RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error)
+	// Изменить роль участника
+	// (PUT /workspaces/{workspaceID}/members/{userID})
+	UpdateWorkspaceMember(ctx context.Context, request UpdateWorkspaceMemberRequestObject) (UpdateWorkspaceMemberResponseObject, error)
+	// Список вебхуков рабочего пространства
+	// (GET /workspaces/{workspaceID}/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Зарегистрировать вебхук
+	// (POST /workspaces/{workspaceID}/webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Удалить вебхук
+	// (DELETE /workspaces/{workspaceID}/webhooks/{webhookID})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Получить вебхук
+	// (GET /workspaces/{workspaceID}/webhooks/{webhookID})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// Изменить вебхук
+	// (PUT /workspaces/{workspaceID}/webhooks/{webhookID})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// Журнал доставок вебхука
+	// (GET /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Повторить доставку
+	// (POST /workspaces/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+	RedeliverWebhook(ctx context.Context, request RedeliverWebhookRequestObject) (RedeliverWebhookResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AdminListUsers operation middleware
+func (sh *strictHandler) AdminListUsers(w http.ResponseWriter, r *http.Request, params AdminListUsersParams) {
+	var request AdminListUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListUsers(ctx, request.(AdminListUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListUsersResponseObject); ok {
+		if err := validResponse.VisitAdminListUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminGetUser operation middleware
+func (sh *strictHandler) AdminGetUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	var request AdminGetUserRequestObject
+
+	request.UserID = userID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetUser(ctx, request.(AdminGetUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminGetUserResponseObject); ok {
+		if err := validResponse.VisitAdminGetUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminDisableUser operation middleware
+func (sh *strictHandler) AdminDisableUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	var request AdminDisableUserRequestObject
+
+	request.UserID = userID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDisableUser(ctx, request.(AdminDisableUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDisableUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminDisableUserResponseObject); ok {
+		if err := validResponse.VisitAdminDisableUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminEnableUser operation middleware
+func (sh *strictHandler) AdminEnableUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	var request AdminEnableUserRequestObject
+
+	request.UserID = userID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminEnableUser(ctx, request.(AdminEnableUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminEnableUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminEnableUserResponseObject); ok {
+		if err := validResponse.VisitAdminEnableUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DisableTwoFactor operation middleware
+func (sh *strictHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var request DisableTwoFactorRequestObject
+
+	var body DisableTwoFactorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DisableTwoFactor(ctx, request.(DisableTwoFactorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DisableTwoFactor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DisableTwoFactorResponseObject); ok {
+		if err := validResponse.VisitDisableTwoFactorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BeginTwoFactorEnrollment operation middleware
+func (sh *strictHandler) BeginTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	var request BeginTwoFactorEnrollmentRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginTwoFactorEnrollment(ctx, request.(BeginTwoFactorEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginTwoFactorEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BeginTwoFactorEnrollmentResponseObject); ok {
+		if err := validResponse.VisitBeginTwoFactorEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmTwoFactorEnrollment operation middleware
+func (sh *strictHandler) ConfirmTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	var request ConfirmTwoFactorEnrollmentRequestObject
+
+	var body ConfirmTwoFactorEnrollmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmTwoFactorEnrollment(ctx, request.(ConfirmTwoFactorEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmTwoFactorEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfirmTwoFactorEnrollmentResponseObject); ok {
+		if err := validResponse.VisitConfirmTwoFactorEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyTwoFactorLogin operation middleware
+func (sh *strictHandler) VerifyTwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+	var request VerifyTwoFactorLoginRequestObject
+
+	var body VerifyTwoFactorLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyTwoFactorLogin(ctx, request.(VerifyTwoFactorLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyTwoFactorLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyTwoFactorLoginResponseObject); ok {
+		if err := validResponse.VisitVerifyTwoFactorLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FullLogout operation middleware
+func (sh *strictHandler) FullLogout(w http.ResponseWriter, r *http.Request) {
+	var request FullLogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FullLogout(ctx, request.(FullLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FullLogout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FullLogoutResponseObject); ok {
+		if err := validResponse.VisitFullLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OidcCallback operation middleware
+func (sh *strictHandler) OidcCallback(w http.ResponseWriter, r *http.Request, params OidcCallbackParams) {
+	var request OidcCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OidcCallback(ctx, request.(OidcCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OidcCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OidcCallbackResponseObject); ok {
+		if err := validResponse.VisitOidcCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OidcLogin operation middleware
+func (sh *strictHandler) OidcLogin(w http.ResponseWriter, r *http.Request, params OidcLoginParams) {
+	var request OidcLoginRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OidcLogin(ctx, request.(OidcLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OidcLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OidcLoginResponseObject); ok {
+		if err := validResponse.VisitOidcLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResetPassword operation middleware
+func (sh *strictHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var request ResetPasswordRequestObject
+
+	var body ResetPasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetPassword(ctx, request.(ResetPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetPassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResetPasswordResponseObject); ok {
+		if err := validResponse.VisitResetPasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestPasswordReset operation middleware
+func (sh *strictHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var request RequestPasswordResetRequestObject
+
+	var body RequestPasswordResetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestPasswordReset(ctx, request.(RequestPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestPasswordReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestPasswordResetResponseObject); ok {
+		if err := validResponse.VisitRequestPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Refresh operation middleware
+func (sh *strictHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var request RefreshRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Refresh(ctx, request.(RefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Refresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshResponseObject); ok {
+		if err := validResponse.VisitRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Register operation middleware
+func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var request RegisterRequestObject
+
+	var body RegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Register")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterResponseObject); ok {
+		if err := validResponse.VisitRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyEmail operation middleware
+func (sh *strictHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var request VerifyEmailRequestObject
+
+	var body VerifyEmailJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyEmail(ctx, request.(VerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyEmail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyEmailResponseObject); ok {
+		if err := validResponse.VisitVerifyEmailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestEmailVerification operation middleware
+func (sh *strictHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	var request RequestEmailVerificationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestEmailVerification(ctx, request.(RequestEmailVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestEmailVerification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestEmailVerificationResponseObject); ok {
+		if err := validResponse.VisitRequestEmailVerificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserDocuments operation middleware
+func (sh *strictHandler) ListUserDocuments(w http.ResponseWriter, r *http.Request, params ListUserDocumentsParams) {
+	var request ListUserDocumentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserDocuments(ctx, request.(ListUserDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserDocumentsResponseObject); ok {
+		if err := validResponse.VisitListUserDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadDocument operation middleware
+func (sh *strictHandler) UploadDocument(w http.ResponseWriter, r *http.Request, params UploadDocumentParams) {
+	var request UploadDocumentRequestObject
+
+	request.Params = params
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadDocument(ctx, request.(UploadDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadDocumentResponseObject); ok {
+		if err := validResponse.VisitUploadDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Search operation middleware
+func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request) {
+	var request SearchRequestObject
+
+	var body SearchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Search(ctx, request.(SearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Search")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchResponseObject); ok {
+		if err := validResponse.VisitSearchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EmptyTrash operation middleware
+func (sh *strictHandler) EmptyTrash(w http.ResponseWriter, r *http.Request, params EmptyTrashParams) {
+	var request EmptyTrashRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EmptyTrash(ctx, request.(EmptyTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EmptyTrash")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EmptyTrashResponseObject); ok {
+		if err := validResponse.VisitEmptyTrashResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTrash operation middleware
+func (sh *strictHandler) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	var request ListTrashRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrash(ctx, request.(ListTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrash")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTrashResponseObject); ok {
+		if err := validResponse.VisitListTrashResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDocument operation middleware
+func (sh *strictHandler) DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request DeleteDocumentRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDocument(ctx, request.(DeleteDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDocumentResponseObject); ok {
+		if err := validResponse.VisitDeleteDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDocumentByID operation middleware
+func (sh *strictHandler) GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request GetDocumentByIDRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentByID(ctx, request.(GetDocumentByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentByIDResponseObject); ok {
+		if err := validResponse.VisitGetDocumentByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateDocument operation middleware
+func (sh *strictHandler) UpdateDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request UpdateDocumentRequestObject
+
+	request.DocumentID = documentID
+
+	var body UpdateDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateDocument(ctx, request.(UpdateDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateDocumentResponseObject); ok {
+		if err := validResponse.VisitUpdateDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadDocumentVersion operation middleware
+func (sh *strictHandler) UploadDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request UploadDocumentVersionRequestObject
+
+	request.DocumentID = documentID
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadDocumentVersion(ctx, request.(UploadDocumentVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadDocumentVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadDocumentVersionResponseObject); ok {
+		if err := validResponse.VisitUploadDocumentVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDocumentChunks operation middleware
+func (sh *strictHandler) ListDocumentChunks(w http.ResponseWriter, r *http.Request, documentID int64, params ListDocumentChunksParams) {
+	var request ListDocumentChunksRequestObject
+
+	request.DocumentID = documentID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDocumentChunks(ctx, request.(ListDocumentChunksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDocumentChunks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDocumentChunksResponseObject); ok {
+		if err := validResponse.VisitListDocumentChunksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDocumentContent operation middleware
+func (sh *strictHandler) GetDocumentContent(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request GetDocumentContentRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentContent(ctx, request.(GetDocumentContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentContent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentContentResponseObject); ok {
+		if err := validResponse.VisitGetDocumentContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiffDocumentVersions operation middleware
+func (sh *strictHandler) DiffDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64, params DiffDocumentVersionsParams) {
+	var request DiffDocumentVersionsRequestObject
+
+	request.DocumentID = documentID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffDocumentVersions(ctx, request.(DiffDocumentVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffDocumentVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffDocumentVersionsResponseObject); ok {
+		if err := validResponse.VisitDiffDocumentVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetDocumentFolder operation middleware
+func (sh *strictHandler) SetDocumentFolder(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request SetDocumentFolderRequestObject
+
+	request.DocumentID = documentID
+
+	var body SetDocumentFolderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetDocumentFolder(ctx, request.(SetDocumentFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetDocumentFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetDocumentFolderResponseObject); ok {
+		if err := validResponse.VisitSetDocumentFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListShareLinks operation middleware
+func (sh *strictHandler) ListShareLinks(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request ListShareLinksRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListShareLinks(ctx, request.(ListShareLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListShareLinks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListShareLinksResponseObject); ok {
+		if err := validResponse.VisitListShareLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateShareLink operation middleware
+func (sh *strictHandler) CreateShareLink(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request CreateShareLinkRequestObject
+
+	request.DocumentID = documentID
+
+	var body CreateShareLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateShareLink(ctx, request.(CreateShareLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateShareLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateShareLinkResponseObject); ok {
+		if err := validResponse.VisitCreateShareLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeShareLink operation middleware
+func (sh *strictHandler) RevokeShareLink(w http.ResponseWriter, r *http.Request, documentID int64, linkID int64) {
+	var request RevokeShareLinkRequestObject
+
+	request.DocumentID = documentID
+	request.LinkID = linkID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeShareLink(ctx, request.(RevokeShareLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeShareLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeShareLinkResponseObject); ok {
+		if err := validResponse.VisitRevokeShareLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreDocument operation middleware
+func (sh *strictHandler) RestoreDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request RestoreDocumentRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreDocument(ctx, request.(RestoreDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreDocumentResponseObject); ok {
+		if err := validResponse.VisitRestoreDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchInDocument operation middleware
+func (sh *strictHandler) SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request SearchInDocumentRequestObject
+
+	request.DocumentID = documentID
+
+	var body SearchInDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchInDocument(ctx, request.(SearchInDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchInDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchInDocumentResponseObject); ok {
+		if err := validResponse.VisitSearchInDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDocumentShares operation middleware
+func (sh *strictHandler) ListDocumentShares(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request ListDocumentSharesRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDocumentShares(ctx, request.(ListDocumentSharesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDocumentShares")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDocumentSharesResponseObject); ok {
+		if err := validResponse.VisitListDocumentSharesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ShareDocument operation middleware
+func (sh *strictHandler) ShareDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request ShareDocumentRequestObject
+
+	request.DocumentID = documentID
+
+	var body ShareDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ShareDocument(ctx, request.(ShareDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ShareDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ShareDocumentResponseObject); ok {
+		if err := validResponse.VisitShareDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeDocumentShare operation middleware
+func (sh *strictHandler) RevokeDocumentShare(w http.ResponseWriter, r *http.Request, documentID int64, userID int64) {
+	var request RevokeDocumentShareRequestObject
+
+	request.DocumentID = documentID
+	request.UserID = userID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeDocumentShare(ctx, request.(RevokeDocumentShareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeDocumentShare")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeDocumentShareResponseObject); ok {
+		if err := validResponse.VisitRevokeDocumentShareResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDocumentVersions operation middleware
+func (sh *strictHandler) ListDocumentVersions(w http.ResponseWriter, r *http.Request, documentID int64) {
+	var request ListDocumentVersionsRequestObject
+
+	request.DocumentID = documentID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDocumentVersions(ctx, request.(ListDocumentVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDocumentVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDocumentVersionsResponseObject); ok {
+		if err := validResponse.VisitListDocumentVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreDocumentVersion operation middleware
+func (sh *strictHandler) RestoreDocumentVersion(w http.ResponseWriter, r *http.Request, documentID int64, version int32) {
+	var request RestoreDocumentVersionRequestObject
+
+	request.DocumentID = documentID
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreDocumentVersion(ctx, request.(RestoreDocumentVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreDocumentVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreDocumentVersionResponseObject); ok {
+		if err := validResponse.VisitRestoreDocumentVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamEvents operation middleware
+func (sh *strictHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	var request StreamEventsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamEvents(ctx, request.(StreamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamEventsResponseObject); ok {
+		if err := validResponse.VisitStreamEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListFolders operation middleware
+func (sh *strictHandler) ListFolders(w http.ResponseWriter, r *http.Request, params ListFoldersParams) {
+	var request ListFoldersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFolders(ctx, request.(ListFoldersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFolders")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFoldersResponseObject); ok {
+		if err := validResponse.VisitListFoldersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateFolder operation middleware
+func (sh *strictHandler) CreateFolder(w http.ResponseWriter, r *http.Request) {
+	var request CreateFolderRequestObject
+
+	var body CreateFolderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFolder(ctx, request.(CreateFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateFolderResponseObject); ok {
+		if err := validResponse.VisitCreateFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteFolder operation middleware
+func (sh *strictHandler) DeleteFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	var request DeleteFolderRequestObject
+
+	request.FolderID = folderID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFolder(ctx, request.(DeleteFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteFolderResponseObject); ok {
+		if err := validResponse.VisitDeleteFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFolder operation middleware
+func (sh *strictHandler) GetFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	var request GetFolderRequestObject
+
+	request.FolderID = folderID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFolder(ctx, request.(GetFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFolderResponseObject); ok {
+		if err := validResponse.VisitGetFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RenameFolder operation middleware
+func (sh *strictHandler) RenameFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	var request RenameFolderRequestObject
+
+	request.FolderID = folderID
+
+	var body RenameFolderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenameFolder(ctx, request.(RenameFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenameFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenameFolderResponseObject); ok {
+		if err := validResponse.VisitRenameFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MoveFolder operation middleware
+func (sh *strictHandler) MoveFolder(w http.ResponseWriter, r *http.Request, folderID FolderIDPath) {
+	var request MoveFolderRequestObject
+
+	request.FolderID = folderID
+
+	var body MoveFolderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveFolder(ctx, request.(MoveFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveFolder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MoveFolderResponseObject); ok {
+		if err := validResponse.VisitMoveFolderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Ping operation middleware
+func (sh *strictHandler) Ping(w http.ResponseWriter, r *http.Request) {
+	var request PingRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Ping(ctx, request.(PingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Ping")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PingResponseObject); ok {
+		if err := validResponse.VisitPingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSharedDocument operation middleware
+func (sh *strictHandler) GetSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken) {
+	var request GetSharedDocumentRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedDocument(ctx, request.(GetSharedDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSharedDocumentResponseObject); ok {
+		if err := validResponse.VisitGetSharedDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchSharedDocument operation middleware
+func (sh *strictHandler) SearchSharedDocument(w http.ResponseWriter, r *http.Request, token ShareLinkToken) {
+	var request SearchSharedDocumentRequestObject
+
+	request.Token = token
+
+	var body SearchSharedDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchSharedDocument(ctx, request.(SearchSharedDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchSharedDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchSharedDocumentResponseObject); ok {
+		if err := validResponse.VisitSearchSharedDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TusOptions operation middleware
+func (sh *strictHandler) TusOptions(w http.ResponseWriter, r *http.Request) {
+	var request TusOptionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TusOptions(ctx, request.(TusOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TusOptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TusOptionsResponseObject); ok {
+		if err := validResponse.VisitTusOptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateUpload operation middleware
+func (sh *strictHandler) CreateUpload(w http.ResponseWriter, r *http.Request, params CreateUploadParams) {
+	var request CreateUploadRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUpload(ctx, request.(CreateUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateUploadResponseObject); ok {
+		if err := validResponse.VisitCreateUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUpload operation middleware
+func (sh *strictHandler) DeleteUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteUploadParams) {
+	var request DeleteUploadRequestObject
+
+	request.UploadID = uploadID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUpload(ctx, request.(DeleteUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUploadResponseObject); ok {
+		if err := validResponse.VisitDeleteUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUploadOffset operation middleware
+func (sh *strictHandler) GetUploadOffset(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetUploadOffsetParams) {
+	var request GetUploadOffsetRequestObject
+
+	request.UploadID = uploadID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUploadOffset(ctx, request.(GetUploadOffsetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUploadOffset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUploadOffsetResponseObject); ok {
+		if err := validResponse.VisitGetUploadOffsetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AppendUpload operation middleware
+func (sh *strictHandler) AppendUpload(w http.ResponseWriter, r *http.Request, uploadID UploadID, params AppendUploadParams) {
+	var request AppendUploadRequestObject
+
+	request.UploadID = uploadID
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AppendUpload(ctx, request.(AppendUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AppendUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AppendUploadResponseObject); ok {
+		if err := validResponse.VisitAppendUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAccount operation middleware
+func (sh *strictHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	var request DeleteAccountRequestObject
+
+	var body DeleteAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAccount(ctx, request.(DeleteAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAccountResponseObject); ok {
+		if err := validResponse.VisitDeleteAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserProfile operation middleware
+func (sh *strictHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
+	var request GetUserProfileRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserProfile(ctx, request.(GetUserProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserProfileResponseObject); ok {
+		if err := validResponse.VisitGetUserProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var request ChangePasswordRequestObject
+
+	var body ChangePasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWorkspaces operation middleware
+func (sh *strictHandler) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkspacesRequestObject
 
-	_, err := w.Write([]byte(response))
-	return err
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkspaces(ctx, request.(ListWorkspacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkspaces")
+	}
 
-type GetUserProfileRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserProfileResponseObject interface {
-	VisitGetUserProfileResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkspacesResponseObject); ok {
+		if err := validResponse.VisitListWorkspacesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserProfile200JSONResponse User
+// CreateWorkspace operation middleware
+func (sh *strictHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var request CreateWorkspaceRequestObject
 
-func (response GetUserProfile200JSONResponse) VisitGetUserProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body CreateWorkspaceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWorkspace(ctx, request.(CreateWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWorkspace")
+	}
 
-type GetUserProfile401Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response GetUserProfile401Response) VisitGetUserProfileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWorkspaceResponseObject); ok {
+		if err := validResponse.VisitCreateWorkspaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Выход со всех устройств
-	// (POST /auth/full_logout)
-	FullLogout(ctx context.Context, request FullLogoutRequestObject) (FullLogoutResponseObject, error)
-	// Вход в систему
-	// (POST /auth/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Выход из текущей сессии
-	// (POST /auth/logout)
-	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
-	// Обновление пары токенов
-	// (POST /auth/refresh)
-	Refresh(ctx context.Context, request RefreshRequestObject) (RefreshResponseObject, error)
-	// Регистрация нового пользователя
-	// (POST /auth/register)
-	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
-	// Получить список всех документов пользователя
-	// (GET /documents)
-	ListUserDocuments(ctx context.Context, request ListUserDocumentsRequestObject) (ListUserDocumentsResponseObject, error)
-	// Загрузить новый документ
-	// (POST /documents)
-	UploadDocument(ctx context.Context, request UploadDocumentRequestObject) (UploadDocumentResponseObject, error)
-	// Семантический поиск по всем документам
-	// (POST /documents/search)
-	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
-	// Удалить документ
-	// (DELETE /documents/{documentID})
-	DeleteDocument(ctx context.Context, request DeleteDocumentRequestObject) (DeleteDocumentResponseObject, error)
-	// Получить информацию о конкретном документе
-	// (GET /documents/{documentID})
-	GetDocumentByID(ctx context.Context, request GetDocumentByIDRequestObject) (GetDocumentByIDResponseObject, error)
-	// Семантический поиск по конкретному документу
-	// (POST /documents/{documentID}/search)
-	SearchInDocument(ctx context.Context, request SearchInDocumentRequestObject) (SearchInDocumentResponseObject, error)
-	// Проверка работоспособности сервера
-	// (GET /ping)
-	Ping(ctx context.Context, request PingRequestObject) (PingResponseObject, error)
-	// Получить профиль текущего пользователя
-	// (GET /users/me)
-	GetUserProfile(ctx context.Context, request GetUserProfileRequestObject) (GetUserProfileResponseObject, error)
-}
+// DeleteWorkspace operation middleware
+func (sh *strictHandler) DeleteWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request DeleteWorkspaceRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.WorkspaceID = workspaceID
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWorkspace(ctx, request.(DeleteWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWorkspace")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteWorkspaceResponseObject); ok {
+		if err := validResponse.VisitDeleteWorkspaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// GetWorkspace operation middleware
+func (sh *strictHandler) GetWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request GetWorkspaceRequestObject
 
-// FullLogout operation middleware
-func (sh *strictHandler) FullLogout(w http.ResponseWriter, r *http.Request) {
-	var request FullLogoutRequestObject
+	request.WorkspaceID = workspaceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FullLogout(ctx, request.(FullLogoutRequestObject))
+		return sh.ssi.GetWorkspace(ctx, request.(GetWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FullLogout")
+		handler = middleware(handler, "GetWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FullLogoutResponseObject); ok {
-		if err := validResponse.VisitFullLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkspaceResponseObject); ok {
+		if err := validResponse.VisitGetWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1155,11 +9929,13 @@ func (sh *strictHandler) FullLogout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// RenameWorkspace operation middleware
+func (sh *strictHandler) RenameWorkspace(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request RenameWorkspaceRequestObject
 
-	var body LoginJSONRequestBody
+	request.WorkspaceID = workspaceID
+
+	var body RenameWorkspaceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1167,18 +9943,18 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.RenameWorkspace(ctx, request.(RenameWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "RenameWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(RenameWorkspaceResponseObject); ok {
+		if err := validResponse.VisitRenameWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1186,23 +9962,25 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	var request LogoutRequestObject
+// ListWorkspaceMembers operation middleware
+func (sh *strictHandler) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request ListWorkspaceMembersRequestObject
+
+	request.WorkspaceID = workspaceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.ListWorkspaceMembers(ctx, request.(ListWorkspaceMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "ListWorkspaceMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWorkspaceMembersResponseObject); ok {
+		if err := validResponse.VisitListWorkspaceMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1210,23 +9988,32 @@ func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Refresh operation middleware
-func (sh *strictHandler) Refresh(w http.ResponseWriter, r *http.Request) {
-	var request RefreshRequestObject
+// AddWorkspaceMember operation middleware
+func (sh *strictHandler) AddWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request AddWorkspaceMemberRequestObject
+
+	request.WorkspaceID = workspaceID
+
+	var body AddWorkspaceMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Refresh(ctx, request.(RefreshRequestObject))
+		return sh.ssi.AddWorkspaceMember(ctx, request.(AddWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Refresh")
+		handler = middleware(handler, "AddWorkspaceMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RefreshResponseObject); ok {
-		if err := validResponse.VisitRefreshResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitAddWorkspaceMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1234,30 +10021,26 @@ func (sh *strictHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Register operation middleware
-func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
-	var request RegisterRequestObject
+// RemoveWorkspaceMember operation middleware
+func (sh *strictHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64) {
+	var request RemoveWorkspaceMemberRequestObject
 
-	var body RegisterJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.WorkspaceID = workspaceID
+	request.UserID = userID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+		return sh.ssi.RemoveWorkspaceMember(ctx, request.(RemoveWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Register")
+		handler = middleware(handler, "RemoveWorkspaceMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterResponseObject); ok {
-		if err := validResponse.VisitRegisterResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveWorkspaceMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1265,23 +10048,33 @@ func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListUserDocuments operation middleware
-func (sh *strictHandler) ListUserDocuments(w http.ResponseWriter, r *http.Request) {
-	var request ListUserDocumentsRequestObject
+// UpdateWorkspaceMember operation middleware
+func (sh *strictHandler) UpdateWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, userID int64) {
+	var request UpdateWorkspaceMemberRequestObject
+
+	request.WorkspaceID = workspaceID
+	request.UserID = userID
+
+	var body UpdateWorkspaceMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListUserDocuments(ctx, request.(ListUserDocumentsRequestObject))
+		return sh.ssi.UpdateWorkspaceMember(ctx, request.(UpdateWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListUserDocuments")
+		handler = middleware(handler, "UpdateWorkspaceMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListUserDocumentsResponseObject); ok {
-		if err := validResponse.VisitListUserDocumentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkspaceMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1289,30 +10082,25 @@ func (sh *strictHandler) ListUserDocuments(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// UploadDocument operation middleware
-func (sh *strictHandler) UploadDocument(w http.ResponseWriter, r *http.Request) {
-	var request UploadDocumentRequestObject
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request ListWebhooksRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
+	request.WorkspaceID = workspaceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UploadDocument(ctx, request.(UploadDocumentRequestObject))
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UploadDocument")
+		handler = middleware(handler, "ListWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UploadDocumentResponseObject); ok {
-		if err := validResponse.VisitUploadDocumentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1320,11 +10108,13 @@ func (sh *strictHandler) UploadDocument(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// Search operation middleware
-func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request) {
-	var request SearchRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath) {
+	var request CreateWebhookRequestObject
 
-	var body SearchJSONRequestBody
+	request.WorkspaceID = workspaceID
+
+	var body CreateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1332,18 +10122,18 @@ func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Search(ctx, request.(SearchRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Search")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SearchResponseObject); ok {
-		if err := validResponse.VisitSearchResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1351,25 +10141,26 @@ func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteDocument operation middleware
-func (sh *strictHandler) DeleteDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
-	var request DeleteDocumentRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	var request DeleteWebhookRequestObject
 
-	request.DocumentID = documentID
+	request.WorkspaceID = workspaceID
+	request.WebhookID = webhookID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDocument(ctx, request.(DeleteDocumentRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDocument")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDocumentResponseObject); ok {
-		if err := validResponse.VisitDeleteDocumentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1377,25 +10168,26 @@ func (sh *strictHandler) DeleteDocument(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetDocumentByID operation middleware
-func (sh *strictHandler) GetDocumentByID(w http.ResponseWriter, r *http.Request, documentID int64) {
-	var request GetDocumentByIDRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	var request GetWebhookRequestObject
 
-	request.DocumentID = documentID
+	request.WorkspaceID = workspaceID
+	request.WebhookID = webhookID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDocumentByID(ctx, request.(GetDocumentByIDRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDocumentByID")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDocumentByIDResponseObject); ok {
-		if err := validResponse.VisitGetDocumentByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1403,13 +10195,14 @@ func (sh *strictHandler) GetDocumentByID(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// SearchInDocument operation middleware
-func (sh *strictHandler) SearchInDocument(w http.ResponseWriter, r *http.Request, documentID int64) {
-	var request SearchInDocumentRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath) {
+	var request UpdateWebhookRequestObject
 
-	request.DocumentID = documentID
+	request.WorkspaceID = workspaceID
+	request.WebhookID = webhookID
 
-	var body SearchInDocumentJSONRequestBody
+	var body UpdateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1417,18 +10210,18 @@ func (sh *strictHandler) SearchInDocument(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SearchInDocument(ctx, request.(SearchInDocumentRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SearchInDocument")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SearchInDocumentResponseObject); ok {
-		if err := validResponse.VisitSearchInDocumentResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1436,23 +10229,27 @@ func (sh *strictHandler) SearchInDocument(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// Ping operation middleware
-func (sh *strictHandler) Ping(w http.ResponseWriter, r *http.Request) {
-	var request PingRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.WorkspaceID = workspaceID
+	request.WebhookID = webhookID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Ping(ctx, request.(PingRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Ping")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PingResponseObject); ok {
-		if err := validResponse.VisitPingResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1460,23 +10257,27 @@ func (sh *strictHandler) Ping(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUserProfile operation middleware
-func (sh *strictHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
-	var request GetUserProfileRequestObject
+// RedeliverWebhook operation middleware
+func (sh *strictHandler) RedeliverWebhook(w http.ResponseWriter, r *http.Request, workspaceID WorkspaceIDPath, webhookID WebhookIDPath, deliveryID int64) {
+	var request RedeliverWebhookRequestObject
+
+	request.WorkspaceID = workspaceID
+	request.WebhookID = webhookID
+	request.DeliveryID = deliveryID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserProfile(ctx, request.(GetUserProfileRequestObject))
+		return sh.ssi.RedeliverWebhook(ctx, request.(RedeliverWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserProfile")
+		handler = middleware(handler, "RedeliverWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserProfileResponseObject); ok {
-		if err := validResponse.VisitGetUserProfileResponse(w); err != nil {
+	} else if validResponse, ok := response.(RedeliverWebhookResponseObject); ok {
+		if err := validResponse.VisitRedeliverWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"backend/internal/ratelimit"
 	"backend/internal/service"
 	"context"
 	"errors"
@@ -78,20 +79,35 @@ func (h *handler) Register(ctx context.Context, request RegisterRequestObject) (
 }
 
 func (h *handler) Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error) {
-	accessToken, refreshToken, err := h.service.Login(ctx, string(request.Body.Email), request.Body.Password)
+	result, err := h.service.Login(ctx, string(request.Body.Email), request.Body.Password)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			return Login401Response{}, nil
 		}
+		if errors.Is(err, service.ErrAccountDisabled) {
+			errorMessage := err.Error()
+			return Login403JSONResponse{Error: &errorMessage}, nil
+		}
+		var limitErr *ratelimit.LimitError
+		if errors.As(err, &limitErr) {
+			return Login429JSONResponse{tooManyRequests(limitErr)}, nil
+		}
 		return nil, err
 	}
 
+	if result.TwoFactorRequired() {
+		return Login202JSONResponse{
+			ChallengeToken: result.ChallengeToken,
+			ExpiresAt:      result.ChallengeExpiresAt,
+		}, nil
+	}
+
 	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
 	if !ok {
 		return nil, fmt.Errorf("response writer not found in context")
 	}
 
-	setTokensCookie(w, accessToken, refreshToken)
+	setTokensCookie(w, result.AccessToken, result.RefreshToken)
 	w.WriteHeader(http.StatusOK)
 
 	return nil, nil
@@ -154,3 +170,54 @@ func (h *handler) FullLogout(ctx context.Context, request FullLogoutRequestObjec
 
 	return nil, nil
 }
+
+func (h *handler) VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error) {
+	if err := h.service.VerifyEmail(ctx, request.Body.Token); err != nil {
+		if errors.Is(err, service.ErrInvalidUserToken) {
+			errorMessage := err.Error()
+			return VerifyEmail400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return VerifyEmail204Response{}, nil
+}
+
+func (h *handler) RequestEmailVerification(ctx context.Context, request RequestEmailVerificationRequestObject) (RequestEmailVerificationResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.RequestEmailVerification(ctx, userID); err != nil {
+		if errors.Is(err, service.ErrEmailAlreadyVerified) {
+			errorMessage := err.Error()
+			return RequestEmailVerification409JSONResponse{Error: &errorMessage}, nil
+		}
+		var limitErr *ratelimit.LimitError
+		if errors.As(err, &limitErr) {
+			return RequestEmailVerification429JSONResponse{tooManyRequests(limitErr)}, nil
+		}
+		return nil, err
+	}
+
+	return RequestEmailVerification204Response{}, nil
+}
+
+func (h *handler) RequestPasswordReset(ctx context.Context, request RequestPasswordResetRequestObject) (RequestPasswordResetResponseObject, error) {
+	if err := h.service.RequestPasswordReset(ctx, string(request.Body.Email)); err != nil {
+		return nil, err
+	}
+
+	return RequestPasswordReset202Response{}, nil
+}
+
+func (h *handler) ResetPassword(ctx context.Context, request ResetPasswordRequestObject) (ResetPasswordResponseObject, error) {
+	if err := h.service.ResetPassword(ctx, request.Body.Token, request.Body.Password); err != nil {
+		if errors.Is(err, service.ErrInvalidUserToken) {
+			errorMessage := err.Error()
+			return ResetPassword400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return ResetPassword204Response{}, nil
+}
@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"backend/internal/domain"
+	"backend/internal/service"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	errFilenameMissing       = errors.New("file name is missing")
+	errUnsupportedFileType   = errors.New("unsupported file type: only .txt files and .zip, .tar.gz archives are allowed")
+	errTooManyFiles          = errors.New("too many files in one request")
+	errInvalidArchive        = errors.New("invalid or corrupted archive")
+	errArchiveTooManyEntries = errors.New("archive has too many entries")
+	errArchiveTooLarge       = errors.New("archives expand beyond the allowed total size")
+	errUnsafeArchivePath     = errors.New("unsafe path in archive")
+	errNotRegularFile        = errors.New("archive entry is not a regular file")
+)
+
+// uploadResult — итог загрузки одного файла из запроса или записи архива.
+type uploadResult struct {
+	filename  string
+	archive   string
+	doc       *domain.Document
+	duplicate bool
+	err       error
+}
+
+// uploadBatch загружает файлы одного multipart-запроса и следит за общими ограничениями:
+// числом файлов и суммарным размером распакованных архивов.
+type uploadBatch struct {
+	h                *handler
+	ctx              context.Context
+	userID           int64
+	workspaceID      *int64
+	rejectDuplicates bool
+	expandedBudget   int64
+}
+
+func isArchiveFilename(filename string) bool {
+	lower := strings.ToLower(filename)
+	return strings.HasSuffix(lower, ".zip") || strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz")
+}
+
+// safeArchivePath нормализует путь записи архива и отклоняет абсолютные пути и выход за пределы архива.
+func safeArchivePath(name string) (string, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if name == "" || strings.HasPrefix(name, "/") || strings.Contains(name, ":") {
+		return "", false
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == ".." {
+			return "", false
+		}
+	}
+	return path.Clean(name), true
+}
+
+// skippedArchiveEntry — служебные файлы, которые архиваторы добавляют сами; в результатах они не показываются.
+func skippedArchiveEntry(name string) bool {
+	return strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), "._")
+}
+
+// isBatchFatal — ошибки, которые относятся ко всему запросу, а не к отдельному файлу.
+func isBatchFatal(err error) bool {
+	return errors.Is(err, service.ErrWorkspaceNotFound) || errors.Is(err, service.ErrWorkspaceForbidden)
+}
+
+// processPart загружает одну часть file: обычный файл или архив.
+// Ошибка возвращается только если продолжать обработку запроса нельзя.
+func (b *uploadBatch) processPart(filename, contentType string, r io.Reader) ([]uploadResult, error) {
+	switch {
+	case filename == "":
+		return []uploadResult{{err: errFilenameMissing}}, nil
+	case isArchiveFilename(filename):
+		return b.expandArchive(filename, r)
+	case allowedUploadFilename(filename):
+		result, err := b.uploadFile(filename, "", contentType, r, b.h.cfg.MaxUploadSize)
+		if err != nil {
+			return nil, err
+		}
+		return []uploadResult{result}, nil
+	}
+	return []uploadResult{{filename: filename, err: errUnsupportedFileType}}, nil
+}
+
+func (b *uploadBatch) uploadFile(filename, archive, contentType string, r io.Reader, maxSize int64) (uploadResult, error) {
+	result := uploadResult{filename: filename, archive: archive}
+
+	upload, err := spoolUpload(r, maxSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			result.err = err
+			return result, nil
+		}
+		return result, err
+	}
+	defer upload.Close()
+
+	if archive != "" {
+		b.expandedBudget -= upload.size
+	}
+
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType, err = upload.detectContentType()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.doc, result.err = b.h.service.UploadDocument(b.ctx, b.userID, b.workspaceID, filename, contentType, upload, upload.size)
+	var duplicateErr *domain.DuplicateDocumentError
+	if errors.As(result.err, &duplicateErr) {
+		result.doc, result.duplicate = duplicateErr.Document, true
+		if !b.rejectDuplicates {
+			result.err = nil
+		}
+	}
+	if isBatchFatal(result.err) {
+		return result, result.err
+	}
+	if result.err != nil {
+		b.h.log.Warn().Err(result.err).Int64("user_id", b.userID).Str("filename", filename).Msg("Файл отклонён при пакетной загрузке")
+	}
+	return result, nil
+}
+
+func (b *uploadBatch) expandArchive(filename string, r io.Reader) ([]uploadResult, error) {
+	archive, err := spoolUpload(r, b.h.cfg.MaxUploadSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			return []uploadResult{{filename: filename, err: err}}, nil
+		}
+		return nil, err
+	}
+	defer archive.Close()
+
+	if strings.HasSuffix(strings.ToLower(filename), ".zip") {
+		return b.expandZip(filename, archive)
+	}
+	return b.expandTarGz(filename, archive)
+}
+
+// uploadEntry загружает запись архива, не давая прочитать больше, чем осталось в общем бюджете распаковки.
+func (b *uploadBatch) uploadEntry(archive, name string, r io.Reader) (uploadResult, error) {
+	limit := min(b.h.cfg.MaxUploadSize, b.expandedBudget)
+	result, err := b.uploadFile(name, archive, "", r, limit)
+	if err != nil && !isBatchFatal(err) {
+		// Архив уже целиком на диске или в памяти, поэтому ошибка чтения записи означает повреждённый архив.
+		result.err, err = errInvalidArchive, nil
+	}
+	if errors.Is(result.err, errUploadTooLarge) && limit < b.h.cfg.MaxUploadSize {
+		result.err = errArchiveTooLarge
+	}
+	return result, err
+}
+
+func (b *uploadBatch) expandZip(filename string, archive *spooledUpload) ([]uploadResult, error) {
+	zr, err := zip.NewReader(archive, archive.size)
+	if err != nil && !errors.Is(err, zip.ErrInsecurePath) {
+		return []uploadResult{{filename: filename, err: errInvalidArchive}}, nil
+	}
+
+	var results []uploadResult
+	entries := 0
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || skippedArchiveEntry(f.Name) {
+			continue
+		}
+
+		entries++
+		if entries > b.h.cfg.MaxArchiveEntries {
+			results = append(results, uploadResult{filename: filename, err: errArchiveTooManyEntries})
+			break
+		}
+
+		name, ok := safeArchivePath(f.Name)
+		switch {
+		case !ok:
+			results = append(results, uploadResult{filename: f.Name, archive: filename, err: errUnsafeArchivePath})
+			continue
+		case !f.Mode().IsRegular():
+			results = append(results, uploadResult{filename: name, archive: filename, err: errNotRegularFile})
+			continue
+		case !allowedUploadFilename(name):
+			results = append(results, uploadResult{filename: name, archive: filename, err: errUnsupportedFileType})
+			continue
+		case f.UncompressedSize64 > uint64(b.expandedBudget):
+			// Заявленный размер уже не помещается в бюджет — дальше архив не распаковываем.
+			return append(results, uploadResult{filename: name, archive: filename, err: errArchiveTooLarge}), nil
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			results = append(results, uploadResult{filename: name, archive: filename, err: errInvalidArchive})
+			continue
+		}
+		result, err := b.uploadEntry(filename, name, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		if result.err == errArchiveTooLarge {
+			break
+		}
+	}
+	return results, nil
+}
+
+func (b *uploadBatch) expandTarGz(filename string, archive *spooledUpload) ([]uploadResult, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return []uploadResult{{filename: filename, err: errInvalidArchive}}, nil
+	}
+	defer gz.Close()
+
+	var results []uploadResult
+	tr := tar.NewReader(gz)
+	entries := 0
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		insecure := errors.Is(err, tar.ErrInsecurePath)
+		if err != nil && !insecure {
+			results = append(results, uploadResult{filename: filename, err: errInvalidArchive})
+			break
+		}
+		if hdr.Typeflag == tar.TypeDir || skippedArchiveEntry(hdr.Name) {
+			continue
+		}
+
+		entries++
+		if entries > b.h.cfg.MaxArchiveEntries {
+			results = append(results, uploadResult{filename: filename, err: errArchiveTooManyEntries})
+			break
+		}
+
+		name, ok := safeArchivePath(hdr.Name)
+		switch {
+		case insecure || !ok:
+			results = append(results, uploadResult{filename: hdr.Name, archive: filename, err: errUnsafeArchivePath})
+			continue
+		case hdr.Typeflag != tar.TypeReg:
+			results = append(results, uploadResult{filename: name, archive: filename, err: errNotRegularFile})
+			continue
+		case !allowedUploadFilename(name):
+			results = append(results, uploadResult{filename: name, archive: filename, err: errUnsupportedFileType})
+			continue
+		}
+
+		result, err := b.uploadEntry(filename, name, tr)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		if result.err == errArchiveTooLarge {
+			break
+		}
+	}
+	return results, nil
+}
+
+func uploadResultsToResponse(results []uploadResult) BatchUploadResult {
+	response := BatchUploadResult{Results: make([]UploadResult, len(results))}
+	for i, r := range results {
+		item := UploadResult{Filename: r.filename, Accepted: r.err == nil}
+		if r.archive != "" {
+			item.Archive = &r.archive
+		}
+		if r.duplicate {
+			item.Duplicate = &r.duplicate
+		}
+		if r.doc != nil {
+			doc := documentToResponse(r.doc)
+			item.Document = &doc
+		}
+		if r.err == nil {
+			response.Accepted++
+		} else {
+			reason := uploadRejectionReason(r.err)
+			item.Error = &reason
+			response.Rejected++
+		}
+		response.Results[i] = item
+	}
+	return response
+}
+
+// uploadRejectionReason возвращает причину отклонения для клиента, не раскрывая внутренние ошибки.
+func uploadRejectionReason(err error) string {
+	var quotaErr *domain.QuotaExceededError
+	var duplicateErr *domain.DuplicateDocumentError
+	switch {
+	case errors.As(err, &quotaErr),
+		errors.As(err, &duplicateErr),
+		errors.Is(err, errFilenameMissing),
+		errors.Is(err, errUnsupportedFileType),
+		errors.Is(err, errTooManyFiles),
+		errors.Is(err, errInvalidArchive),
+		errors.Is(err, errArchiveTooManyEntries),
+		errors.Is(err, errArchiveTooLarge),
+		errors.Is(err, errUnsafeArchivePath),
+		errors.Is(err, errNotRegularFile),
+		errors.Is(err, errUploadTooLarge):
+		return err.Error()
+	}
+	return "failed to process file"
+}
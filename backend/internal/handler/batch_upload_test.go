@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/service"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestSafeArchivePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{name: "notes.txt", want: "notes.txt", wantOK: true},
+		{name: "docs/notes.txt", want: "docs/notes.txt", wantOK: true},
+		{name: "docs/./notes.txt", want: "docs/notes.txt", wantOK: true},
+		{name: "docs//notes.txt", want: "docs/notes.txt", wantOK: true},
+		{name: "docs\\notes.txt", want: "docs/notes.txt", wantOK: true},
+		{name: "notes..txt", want: "notes..txt", wantOK: true},
+		{name: ""},
+		{name: "/etc/passwd.txt"},
+		{name: "\\windows\\notes.txt"},
+		{name: "C:/notes.txt"},
+		{name: "C:notes.txt"},
+		{name: "../notes.txt"},
+		{name: "docs/../../notes.txt"},
+		{name: "docs/../notes.txt"},
+		{name: "docs\\..\\..\\notes.txt"},
+	}
+
+	for _, tt := range tests {
+		got, ok := safeArchivePath(tt.name)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("safeArchivePath(%q) = %q, %v, want %q, %v", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// uploadService принимает документы без базы и запоминает их содержимое.
+type uploadService struct {
+	service.Service
+	uploaded map[string]string
+}
+
+func (s *uploadService) UploadDocument(ctx context.Context, userID int64, workspaceID *int64, filename, contentType string, content io.ReadSeeker, size int64) (*domain.Document, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	s.uploaded[filename] = string(data)
+	return &domain.Document{Filename: filename, SizeBytes: size}, nil
+}
+
+type archiveEntry struct {
+	name    string
+	body    string
+	symlink bool
+}
+
+func zipArchive(t *testing.T, entries []archiveEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		w, err := zw.Create(e.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, e.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T, entries []archiveEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}
+		if e.symlink {
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, "/etc/passwd", 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(tw, e.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestExpandArchive(t *testing.T) {
+	const (
+		maxEntries    = 3
+		maxUploadSize = 4096
+		budget        = 1000
+	)
+
+	bomb := strings.Repeat("a", 100_000)
+	half := strings.Repeat("b", 600)
+
+	// result — ожидаемый итог записи архива: имя и ошибка (nil — запись принята).
+	type result struct {
+		filename string
+		err      error
+	}
+
+	tests := []struct {
+		name         string
+		filename     string
+		archive      func(t *testing.T) []byte
+		want         []result
+		wantUploaded []string
+	}{
+		{
+			name:     "zip with nested files",
+			filename: "docs.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, []archiveEntry{{name: "a.txt", body: "a"}, {name: "dir/b.txt", body: "b"}})
+			},
+			want:         []result{{"a.txt", nil}, {"dir/b.txt", nil}},
+			wantUploaded: []string{"a.txt", "dir/b.txt"},
+		},
+		{
+			name:     "tar.gz with nested files",
+			filename: "docs.tar.gz",
+			archive: func(t *testing.T) []byte {
+				return tarGzArchive(t, []archiveEntry{{name: "a.txt", body: "a"}, {name: "dir/b.txt", body: "b"}})
+			},
+			want:         []result{{"a.txt", nil}, {"dir/b.txt", nil}},
+			wantUploaded: []string{"a.txt", "dir/b.txt"},
+		},
+		{
+			name:     "zip path traversal",
+			filename: "docs.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, []archiveEntry{{name: "../evil.txt", body: "x"}, {name: "/abs.txt", body: "x"}, {name: "ok.txt", body: "ok"}})
+			},
+			want:         []result{{"../evil.txt", errUnsafeArchivePath}, {"/abs.txt", errUnsafeArchivePath}, {"ok.txt", nil}},
+			wantUploaded: []string{"ok.txt"},
+		},
+		{
+			name:     "tar.gz path traversal",
+			filename: "docs.tgz",
+			archive: func(t *testing.T) []byte {
+				return tarGzArchive(t, []archiveEntry{{name: "dir/../../evil.txt", body: "x"}, {name: "ok.txt", body: "ok"}})
+			},
+			want:         []result{{"dir/../../evil.txt", errUnsafeArchivePath}, {"ok.txt", nil}},
+			wantUploaded: []string{"ok.txt"},
+		},
+		{
+			name:     "tar.gz symlink",
+			filename: "docs.tar.gz",
+			archive: func(t *testing.T) []byte {
+				return tarGzArchive(t, []archiveEntry{{name: "link.txt", symlink: true}})
+			},
+			want: []result{{"link.txt", errNotRegularFile}},
+		},
+		{
+			name:     "unsupported file and service entries",
+			filename: "docs.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, []archiveEntry{
+					{name: "image.png", body: "x"},
+					{name: "__MACOSX/._a.txt", body: "x"},
+					{name: "dir/._b.txt", body: "x"},
+				})
+			},
+			want: []result{{"image.png", errUnsupportedFileType}},
+		},
+		{
+			name:     "too many entries",
+			filename: "docs.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, []archiveEntry{
+					{name: "1.txt", body: "1"}, {name: "2.txt", body: "2"}, {name: "3.txt", body: "3"}, {name: "4.txt", body: "4"},
+				})
+			},
+			want:         []result{{"1.txt", nil}, {"2.txt", nil}, {"3.txt", nil}, {"docs.zip", errArchiveTooManyEntries}},
+			wantUploaded: []string{"1.txt", "2.txt", "3.txt"},
+		},
+		{
+			name:     "zip bomb stops at declared size",
+			filename: "bomb.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, []archiveEntry{{name: "bomb.txt", body: bomb}, {name: "after.txt", body: "x"}})
+			},
+			want: []result{{"bomb.txt", errArchiveTooLarge}},
+		},
+		{
+			name:     "tar.gz bomb stops at the budget",
+			filename: "bomb.tar.gz",
+			archive: func(t *testing.T) []byte {
+				return tarGzArchive(t, []archiveEntry{{name: "bomb.txt", body: bomb}, {name: "after.txt", body: "x"}})
+			},
+			want: []result{{"bomb.txt", errArchiveTooLarge}},
+		},
+		{
+			name:     "budget is shared between entries",
+			filename: "docs.tar.gz",
+			archive: func(t *testing.T) []byte {
+				return tarGzArchive(t, []archiveEntry{{name: "1.txt", body: half}, {name: "2.txt", body: half}})
+			},
+			want:         []result{{"1.txt", nil}, {"2.txt", errArchiveTooLarge}},
+			wantUploaded: []string{"1.txt"},
+		},
+		{
+			name:     "corrupted zip",
+			filename: "docs.zip",
+			archive:  func(t *testing.T) []byte { return []byte("not a zip") },
+			want:     []result{{"docs.zip", errInvalidArchive}},
+		},
+		{
+			name:     "corrupted tar.gz",
+			filename: "docs.tar.gz",
+			archive:  func(t *testing.T) []byte { return []byte("not a gzip") },
+			want:     []result{{"docs.tar.gz", errInvalidArchive}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := zerolog.Nop()
+			svc := &uploadService{uploaded: make(map[string]string)}
+			h := &handler{
+				cfg:     &config.HandlerConfig{MaxUploadSize: maxUploadSize, MaxArchiveEntries: maxEntries},
+				service: svc,
+				log:     &log,
+			}
+			b := &uploadBatch{h: h, ctx: context.Background(), userID: 1, expandedBudget: budget}
+
+			results, err := b.processPart(tt.filename, "", bytes.NewReader(tt.archive(t)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(results) != len(tt.want) {
+				t.Fatalf("got %d results, want %d: %+v", len(results), len(tt.want), results)
+			}
+			for i, want := range tt.want {
+				if results[i].filename != want.filename || !errors.Is(results[i].err, want.err) {
+					t.Errorf("result %d = %q (%v), want %q (%v)", i, results[i].filename, results[i].err, want.filename, want.err)
+				}
+			}
+
+			if len(svc.uploaded) != len(tt.wantUploaded) {
+				t.Errorf("uploaded %d files, want %d", len(svc.uploaded), len(tt.wantUploaded))
+			}
+			for _, name := range tt.wantUploaded {
+				if _, ok := svc.uploaded[name]; !ok {
+					t.Errorf("file %q is not uploaded", name)
+				}
+			}
+			if b.expandedBudget < 0 {
+				t.Errorf("expanded budget = %d, want non-negative", b.expandedBudget)
+			}
+		})
+	}
+}
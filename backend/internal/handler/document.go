@@ -1,16 +1,107 @@
 package handler
 
 import (
+	"backend/internal/domain"
 	"backend/internal/service"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/jwtauth/v5"
 )
 
+func documentToResponse(d *domain.Document) Document {
+	response := Document{
+		Id:              d.ID,
+		UserID:          d.UserID,
+		WorkspaceID:     d.WorkspaceID,
+		Filename:        d.Filename,
+		SharedWithMe:    d.SharedWithMe,
+		CurrentVersion:  d.CurrentVersion,
+		FolderID:        d.FolderID,
+		Description:     d.Description,
+		Tags:            d.Tags,
+		Metadata:        d.Metadata,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+		NullEmbeddings:  d.NullEmbeddings,
+		TotalEmbeddings: d.TotalEmbeddings,
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	if response.Metadata == nil {
+		response.Metadata = DocumentMetadata{}
+	}
+	if d.SharePermission != "" {
+		permission := SharePermission(d.SharePermission)
+		response.SharePermission = &permission
+	}
+	if d.ContentSHA256 != "" {
+		response.ContentSHA256 = &d.ContentSHA256
+	}
+	return response
+}
+
+func chunkToResponse(c *domain.Chunk) Chunk {
+	return Chunk{
+		Id:         c.ID,
+		DocumentID: c.DocumentID,
+		Text:       c.Text,
+		Embedded:   &c.Embedded,
+	}
+}
+
+// downloadContentTypes — типы, с которыми оригинальный файл отдается как есть.
+// Content-Type файла присылает клиент при загрузке, поэтому остальные типы (например, text/html
+// или image/svg+xml, в которых браузер выполнит скрипт) отдаются как application/octet-stream.
+var downloadContentTypes = map[string]bool{
+	"text/plain":       true,
+	"text/markdown":    true,
+	"text/csv":         true,
+	"application/json": true,
+	"application/pdf":  true,
+}
+
+// downloadContentType возвращает Content-Type для отдачи файла: разрешенный тип с кодировкой
+// для текстовых типов или application/octet-stream.
+func downloadContentType(contentType string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !downloadContentTypes[mediaType] {
+		return "application/octet-stream"
+	}
+	if charset := params["charset"]; charset != "" && strings.HasPrefix(mediaType, "text/") {
+		return mime.FormatMediaType(mediaType, map[string]string{"charset": charset})
+	}
+	return mediaType
+}
+
+// documentContentResponse отдаёт содержимое документа с его настоящим Content-Type, если он
+// входит в downloadContentTypes, вместо application/octet-stream, который выставляет сгенерированный ответ.
+type documentContentResponse struct {
+	content *domain.DocumentContent
+}
+
+func (response documentContentResponse) VisitGetDocumentContentResponse(w http.ResponseWriter) error {
+	defer response.content.Body.Close()
+
+	w.Header().Set("Content-Type", downloadContentType(response.content.ContentType))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if response.content.SizeBytes > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(response.content.SizeBytes, 10))
+	}
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": response.content.Filename}))
+	w.WriteHeader(http.StatusOK)
+
+	_, err := io.Copy(w, response.content.Body)
+	return err
+}
+
 func (h *handler) UploadDocument(ctx context.Context, request UploadDocumentRequestObject) (UploadDocumentResponseObject, error) {
 	_, claims, _ := jwtauth.FromContext(ctx)
 	userIDFloat, ok := claims["user_id"].(float64)
@@ -26,10 +117,17 @@ func (h *handler) UploadDocument(ctx context.Context, request UploadDocumentRequ
 		return UploadDocument400Response{}, fmt.Errorf("invalid multipart request")
 	}
 
-	var fileContent []byte
-	var filename string
-	foundFile := false
+	batch := &uploadBatch{
+		h:                h,
+		ctx:              ctx,
+		userID:           userID,
+		workspaceID:      request.Params.WorkspaceID,
+		rejectDuplicates: request.Params.OnDuplicate != nil && *request.Params.OnDuplicate == Reject,
+		expandedBudget:   h.cfg.MaxArchiveExpandedSize,
+	}
 
+	var results []uploadResult
+	fileParts := 0
 	for {
 		part, err := multipartReader.NextPart()
 		if err == io.EOF {
@@ -40,132 +138,161 @@ func (h *handler) UploadDocument(ctx context.Context, request UploadDocumentRequ
 			return UploadDocument400Response{}, fmt.Errorf("invalid multipart data")
 		}
 
-		partName := part.FormName()
-		partFilename := part.FileName()
-
-		if partName == "file" {
-			if partFilename == "" {
-				err = part.Close()
-				if err != nil {
-					return nil, err
-				}
-				h.log.Warn().Int64("user_id", userID).Msg("Пустое имя файла в части 'file'")
-				return UploadDocument400Response{}, fmt.Errorf("file name is missing")
-			}
-
-			if !strings.HasSuffix(strings.ToLower(partFilename), ".txt") {
-				err = part.Close()
-
-				if err != nil {
-					return nil, err
-				}
-				h.log.Warn().
-					Int64("user_id", userID).
-					Str("filename", partFilename).
-					Msg("Запрещённый тип файла — разрешены только .txt")
-				return UploadDocument400Response{}, fmt.Errorf("only .txt files allowed")
-			}
-
-			fileContent, err = io.ReadAll(part)
-			if err != nil {
-				err = part.Close()
-				if err != nil {
-					return nil, err
-				}
-				h.log.Error().Err(err).Int64("user_id", userID).Msg("Ошибка чтения содержимого файла")
-				return nil, fmt.Errorf("failed to read file content")
-			}
-
-			filename = partFilename
-			foundFile = true
-			h.log.Info().
-				Int64("user_id", userID).
-				Str("filename", filename).
-				Int("size_bytes", len(fileContent)).
-				Msg("Файл успешно прочитан")
-
-			err = part.Close()
-			if err != nil {
+		if part.FormName() != "file" {
+			if err := part.Close(); err != nil {
 				return nil, err
 			}
-
-			break
+			continue
 		}
 
-		err = part.Close()
+		fileParts++
+		partResults := []uploadResult{{filename: part.FileName(), err: errTooManyFiles}}
+		if fileParts <= h.cfg.MaxUploadFiles {
+			partResults, err = batch.processPart(part.FileName(), part.Header.Get("Content-Type"), part)
+		}
+		if closeErr := part.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
 		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrWorkspaceNotFound):
+				return UploadDocument404Response{}, nil
+			case errors.Is(err, service.ErrWorkspaceForbidden):
+				return UploadDocument403JSONResponse{Error: err.Error()}, nil
+			}
+			h.log.Error().Err(err).Int64("user_id", userID).Msg("Ошибка чтения содержимого файла")
 			return nil, err
 		}
+		results = append(results, partResults...)
 	}
 
-	if !foundFile || fileContent == nil {
+	if fileParts == 0 {
 		h.log.Warn().Int64("user_id", userID).Msg("Файл не был найден в запросе")
-		return UploadDocument400Response{}, fmt.Errorf("no file uploaded")
+		return UploadDocument400Response{}, nil
 	}
 
-	const maxSize = 10 * 1024 * 1024 // 10 MB
-	if len(fileContent) > maxSize {
-		h.log.Warn().
-			Int64("user_id", userID).
-			Str("filename", filename).
-			Int("size_bytes", len(fileContent)).
-			Msg("Файл превышает допустимый размер (10MB)")
-		return UploadDocument400Response{}, fmt.Errorf("file too large: max 10MB")
+	// Один обычный файл — прежний формат ответа, чтобы не ломать существующих клиентов.
+	if fileParts == 1 && len(results) == 1 && results[0].archive == "" && !isArchiveFilename(results[0].filename) {
+		return h.singleUploadResponse(userID, results[0])
 	}
 
+	response := uploadResultsToResponse(results)
 	h.log.Info().
 		Int64("user_id", userID).
-		Str("filename", filename).
-		Int("size_bytes", len(fileContent)).
-		Msg("Передача файла в сервис для обработки")
+		Int("accepted", response.Accepted).
+		Int("rejected", response.Rejected).
+		Msg("Пакетная загрузка документов завершена")
 
-	doc, err := h.service.UploadDocument(ctx, userID, filename, fileContent)
-	if err != nil {
+	return UploadDocument207JSONResponse(response), nil
+}
+
+func (h *handler) singleUploadResponse(userID int64, result uploadResult) (UploadDocumentResponseObject, error) {
+	if result.err != nil {
+		var quotaErr *domain.QuotaExceededError
+		var duplicateErr *domain.DuplicateDocumentError
+		switch {
+		case errors.As(result.err, &duplicateErr):
+			return UploadDocument409JSONResponse{
+				Error:    duplicateErr.Error(),
+				Document: documentToResponse(duplicateErr.Document),
+			}, nil
+		case errors.Is(result.err, errUploadTooLarge):
+			errorMessage := fmt.Sprintf("file too large: max %d bytes", h.cfg.MaxUploadSize)
+			return UploadDocument413JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(result.err, errFilenameMissing), errors.Is(result.err, errUnsupportedFileType):
+			h.log.Warn().Int64("user_id", userID).Str("filename", result.filename).Err(result.err).Msg("Файл отклонён")
+			return UploadDocument400Response{}, nil
+		case errors.As(result.err, &quotaErr):
+			h.log.Warn().Int64("user_id", userID).Str("resource", quotaErr.Resource).Msg("Превышена квота при загрузке документа")
+			return UploadDocument403JSONResponse(quotaError(quotaErr)), nil
+		}
 		h.log.Error().
-			Err(err).
+			Err(result.err).
 			Int64("user_id", userID).
-			Str("filename", filename).
+			Str("filename", result.filename).
 			Msg("Ошибка в сервисе при обработке документа")
-		return nil, err
+		return nil, result.err
+	}
+
+	if result.duplicate {
+		return UploadDocument200JSONResponse(documentToResponse(result.doc)), nil
 	}
 
 	h.log.Info().
 		Int64("user_id", userID).
-		Int64("document_id", doc.ID).
-		Str("filename", doc.Filename).
+		Int64("document_id", result.doc.ID).
+		Str("filename", result.doc.Filename).
 		Msg("Документ успешно загружен и обработан")
 
-	return UploadDocument201JSONResponse{
-		Id:              doc.ID,
-		UserID:          doc.UserID,
-		Filename:        doc.Filename,
-		NullEmbeddings:  doc.NullEmbeddings,
-		TotalEmbeddings: doc.TotalEmbeddings,
-	}, nil
+	return UploadDocument201JSONResponse(documentToResponse(result.doc)), nil
 }
 
 func (h *handler) ListUserDocuments(ctx context.Context, request ListUserDocumentsRequestObject) (ListUserDocumentsResponseObject, error) {
 	_, claims, _ := jwtauth.FromContext(ctx)
 	userID := int64(claims["user_id"].(float64))
 
-	docs, err := h.service.ListUserDocuments(ctx, userID)
+	params := request.Params
+	query := domain.DocumentListQuery{
+		WorkspaceID: params.WorkspaceID,
+		FolderID:    params.FolderID,
+	}
+	if params.Filename != nil {
+		query.Filename = *params.Filename
+	}
+	if params.Status != nil {
+		query.Status = string(*params.Status)
+	}
+	if params.Tag != nil {
+		query.Tags = *params.Tag
+	}
+	if params.Sort != nil {
+		query.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		descending := *params.Order == Desc
+		query.Descending = &descending
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	page, err := h.service.ListUserDocuments(ctx, userID, query)
 	if err != nil {
+		switch {
+		case isDocumentListError(err), isMetadataValidationError(err):
+			errorMessage := err.Error()
+			return ListUserDocuments400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrFolderNotFound):
+			return ListUserDocuments404Response{}, nil
+		}
 		h.log.Error().Err(err).Int64("userID", userID).Msg("HANDLER ERROR: Ошибка сервиса")
 		return nil, err
 	}
 
-	responseDocs := make(ListUserDocuments200JSONResponse, len(docs))
-	for i, d := range docs {
-		responseDocs[i] = Document{
-			Id:              d.ID,
-			UserID:          d.UserID,
-			Filename:        d.Filename,
-			NullEmbeddings:  d.NullEmbeddings,
-			TotalEmbeddings: d.TotalEmbeddings,
-		}
+	// Тело ответа — массив документов, как до появления постраничного вывода,
+	// поэтому общее число и курсор следующей страницы передаются в заголовках.
+	response := ListUserDocuments200JSONResponse{
+		Body: make([]Document, len(page.Items)),
+		Headers: ListUserDocuments200ResponseHeaders{
+			XTotalCount: page.Total,
+			XNextCursor: page.NextCursor,
+		},
 	}
+	for i := range page.Items {
+		response.Body[i] = documentToResponse(&page.Items[i])
+	}
+
+	return response, nil
+}
 
-	return responseDocs, nil
+func isDocumentListError(err error) bool {
+	return errors.Is(err, service.ErrInvalidDocumentSort) ||
+		errors.Is(err, service.ErrInvalidDocumentStatus) ||
+		errors.Is(err, service.ErrInvalidCursor) ||
+		errors.Is(err, service.ErrFilenameFilterTooLong)
 }
 
 func (h *handler) DeleteDocument(ctx context.Context, request DeleteDocumentRequestObject) (DeleteDocumentResponseObject, error) {
@@ -175,20 +302,89 @@ func (h *handler) DeleteDocument(ctx context.Context, request DeleteDocumentRequ
 	docID := request.DocumentID
 
 	if err := h.service.DeleteUserDocument(ctx, userID, docID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return DeleteDocument404Response{}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			errorMessage := err.Error()
+			return DeleteDocument403JSONResponse{Error: &errorMessage}, nil
+		}
 		return nil, err
 	}
 
 	return DeleteDocument204Response{}, nil
 }
 
+func (h *handler) UpdateDocument(ctx context.Context, request UpdateDocumentRequestObject) (UpdateDocumentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	update := domain.DocumentMetadataUpdate{Description: request.Body.Description}
+	if request.Body.Tags != nil {
+		update.Tags = *request.Body.Tags
+		if update.Tags == nil {
+			update.Tags = []string{}
+		}
+	}
+	if request.Body.Metadata != nil {
+		update.Metadata = *request.Body.Metadata
+		if update.Metadata == nil {
+			update.Metadata = map[string]string{}
+		}
+	}
+
+	doc, err := h.service.UpdateDocumentMetadata(ctx, userID, request.DocumentID, update)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case isMetadataValidationError(err):
+			return UpdateDocument400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentForbidden):
+			return UpdateDocument403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return UpdateDocument404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return UpdateDocument200JSONResponse(documentToResponse(doc)), nil
+}
+
+func isMetadataValidationError(err error) bool {
+	return errors.Is(err, service.ErrDescriptionTooLong) ||
+		errors.Is(err, service.ErrTooManyTags) ||
+		errors.Is(err, service.ErrInvalidTag) ||
+		errors.Is(err, service.ErrTooManyMetadataFields) ||
+		errors.Is(err, service.ErrInvalidMetadataField)
+}
+
 func (h *handler) Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error) {
 	_, claims, _ := jwtauth.FromContext(ctx)
 	userID := int64(claims["user_id"].(float64))
 
 	query := request.Body.Query
 
-	results, err := h.service.Search(ctx, userID, query)
+	var filter domain.SearchFilter
+	if request.Body.Tags != nil {
+		filter.Tags = *request.Body.Tags
+	}
+	if request.Body.Metadata != nil {
+		filter.Metadata = *request.Body.Metadata
+	}
+	filter.FolderID = request.Body.FolderID
+
+	results, err := h.service.Search(ctx, userID, request.Body.WorkspaceID, query, filter)
 	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case isMetadataValidationError(err):
+			errorMessage := err.Error()
+			return Search400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrFolderNotFound):
+			return Search404Response{}, nil
+		case errors.As(err, &quotaErr):
+			return Search429JSONResponse{searchQuotaExceeded(quotaErr)}, nil
+		}
 		return nil, err
 	}
 
@@ -216,6 +412,10 @@ func (h *handler) SearchInDocument(ctx context.Context, request SearchInDocument
 
 	results, err := h.service.SearchInDocument(ctx, userID, docID, query)
 	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			return SearchInDocument429JSONResponse{searchQuotaExceeded(quotaErr)}, nil
+		}
 		return nil, err
 	}
 
@@ -246,11 +446,41 @@ func (h *handler) GetDocumentByID(ctx context.Context, request GetDocumentByIDRe
 		return nil, err
 	}
 
-	return GetDocumentByID200JSONResponse{
-		Id:              d.ID,
-		UserID:          d.UserID,
-		Filename:        d.Filename,
-		NullEmbeddings:  d.NullEmbeddings,
-		TotalEmbeddings: d.TotalEmbeddings,
-	}, nil
+	return GetDocumentByID200JSONResponse(documentToResponse(d)), nil
+}
+
+func (h *handler) ListDocumentChunks(ctx context.Context, request ListDocumentChunksRequestObject) (ListDocumentChunksResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	page, size := pageParams(request.Params.Page, request.Params.Size)
+	chunks, total, err := h.service.ListDocumentChunks(ctx, userID, request.DocumentID, page, size)
+	if err != nil {
+		if errors.Is(err, service.ErrDocumentNotFound) {
+			return ListDocumentChunks404Response{}, nil
+		}
+		return nil, err
+	}
+
+	items := make([]Chunk, len(chunks))
+	for i := range chunks {
+		items[i] = chunkToResponse(&chunks[i])
+	}
+
+	return ListDocumentChunks200JSONResponse{Items: items, Total: total}, nil
+}
+
+func (h *handler) GetDocumentContent(ctx context.Context, request GetDocumentContentRequestObject) (GetDocumentContentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	content, err := h.service.GetDocumentContent(ctx, userID, request.DocumentID)
+	if err != nil {
+		if errors.Is(err, service.ErrDocumentNotFound) {
+			return GetDocumentContent404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return documentContentResponse{content: content}, nil
 }
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func documentShareToResponse(s *domain.DocumentShare) DocumentShare {
+	return DocumentShare{
+		UserID:     s.UserID,
+		Email:      openapi_types.Email(s.Email),
+		Permission: SharePermission(s.Permission),
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func (h *handler) ListDocumentShares(ctx context.Context, request ListDocumentSharesRequestObject) (ListDocumentSharesResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	shares, err := h.service.ListDocumentShares(ctx, userID, request.DocumentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			errorMessage := err.Error()
+			return ListDocumentShares403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return ListDocumentShares404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListDocumentShares200JSONResponse, len(shares))
+	for i := range shares {
+		response[i] = documentShareToResponse(&shares[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) ShareDocument(ctx context.Context, request ShareDocumentRequestObject) (ShareDocumentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	share, err := h.service.ShareDocument(ctx, userID, request.DocumentID, string(request.Body.Email), string(request.Body.Permission))
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidSharePermission), errors.Is(err, service.ErrCannotShareWithSelf):
+			return ShareDocument400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			return ShareDocument403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound), errors.Is(err, service.ErrUserNotFound):
+			return ShareDocument404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return ShareDocument201JSONResponse(documentShareToResponse(share)), nil
+}
+
+func (h *handler) RevokeDocumentShare(ctx context.Context, request RevokeDocumentShareRequestObject) (RevokeDocumentShareResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.RevokeDocumentShare(ctx, userID, request.DocumentID, request.UserID); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			return RevokeDocumentShare403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound), errors.Is(err, service.ErrDocumentShareNotFound):
+			return RevokeDocumentShare404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return RevokeDocumentShare204Response{}, nil
+}
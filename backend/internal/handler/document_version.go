@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func documentVersionToResponse(v *domain.DocumentVersion) DocumentVersion {
+	response := DocumentVersion{
+		Version:   v.Version,
+		SizeBytes: v.SizeBytes,
+		Chunks:    v.Chunks,
+		CreatedBy: v.CreatedBy,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.ContentSHA256 != "" {
+		response.ContentSHA256 = &v.ContentSHA256
+	}
+	return response
+}
+
+func versionDiffToResponse(d *domain.VersionDiff) VersionDiff {
+	response := VersionDiff{
+		From:    d.From,
+		To:      d.To,
+		Added:   d.Added,
+		Removed: d.Removed,
+		Equal:   d.Equal,
+		Chunks:  make([]ChunkDiff, len(d.Chunks)),
+	}
+	for i, c := range d.Chunks {
+		response.Chunks[i] = ChunkDiff{
+			Op:        ChunkDiffOp(c.Op),
+			FromIndex: c.FromIndex,
+			ToIndex:   c.ToIndex,
+			Text:      c.Text,
+		}
+	}
+	return response
+}
+
+func (h *handler) UploadDocumentVersion(ctx context.Context, request UploadDocumentVersionRequestObject) (UploadDocumentVersionResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if request.Body == nil {
+		errorMessage := "invalid multipart request"
+		return UploadDocumentVersion400JSONResponse{Error: &errorMessage}, nil
+	}
+
+	for {
+		part, err := request.Body.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errorMessage := "invalid multipart data"
+			return UploadDocumentVersion400JSONResponse{Error: &errorMessage}, nil
+		}
+		if part.FormName() != "file" {
+			if err := part.Close(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		response, err := h.uploadDocumentVersionPart(ctx, userID, request.DocumentID, part.FileName(), part.Header.Get("Content-Type"), part)
+		if closeErr := part.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		return response, err
+	}
+
+	errorMessage := "file is missing"
+	return UploadDocumentVersion400JSONResponse{Error: &errorMessage}, nil
+}
+
+func (h *handler) uploadDocumentVersionPart(ctx context.Context, userID, documentID int64, filename, contentType string, r io.Reader) (UploadDocumentVersionResponseObject, error) {
+	if !allowedUploadFilename(filename) {
+		errorMessage := "unsupported file type: only .txt files are allowed"
+		return UploadDocumentVersion400JSONResponse{Error: &errorMessage}, nil
+	}
+
+	upload, err := spoolUpload(r, h.cfg.MaxUploadSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			errorMessage := err.Error()
+			return UploadDocumentVersion413JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+	defer upload.Close()
+
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType, err = upload.detectContentType()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err := h.service.UploadDocumentVersion(ctx, userID, documentID, contentType, upload, upload.size)
+	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return UploadDocumentVersion404Response{}, nil
+		case errors.Is(err, service.ErrDocumentForbidden):
+			return UploadDocumentVersion403JSONResponse{Error: err.Error()}, nil
+		case errors.As(err, &quotaErr):
+			return UploadDocumentVersion403JSONResponse(quotaError(quotaErr)), nil
+		}
+		h.log.Error().Err(err).Int64("user_id", userID).Int64("document_id", documentID).Msg("Ошибка загрузки новой версии документа")
+		return nil, err
+	}
+
+	return UploadDocumentVersion200JSONResponse(documentToResponse(doc)), nil
+}
+
+func (h *handler) ListDocumentVersions(ctx context.Context, request ListDocumentVersionsRequestObject) (ListDocumentVersionsResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	versions, err := h.service.ListDocumentVersions(ctx, userID, request.DocumentID)
+	if err != nil {
+		if errors.Is(err, service.ErrDocumentNotFound) {
+			return ListDocumentVersions404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListDocumentVersions200JSONResponse, len(versions))
+	for i := range versions {
+		response[i] = documentVersionToResponse(&versions[i])
+	}
+	return response, nil
+}
+
+func (h *handler) RestoreDocumentVersion(ctx context.Context, request RestoreDocumentVersionRequestObject) (RestoreDocumentVersionResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	doc, err := h.service.RestoreDocumentVersion(ctx, userID, request.DocumentID, request.Version)
+	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case errors.Is(err, service.ErrDocumentNotFound), errors.Is(err, service.ErrVersionNotFound):
+			return RestoreDocumentVersion404Response{}, nil
+		case errors.Is(err, service.ErrDocumentForbidden):
+			return RestoreDocumentVersion403JSONResponse{Error: err.Error()}, nil
+		case errors.As(err, &quotaErr):
+			return RestoreDocumentVersion403JSONResponse(quotaError(quotaErr)), nil
+		}
+		return nil, err
+	}
+
+	h.log.Info().Int64("user_id", userID).Int64("document_id", doc.ID).Int32("version", request.Version).Msg("Версия документа восстановлена")
+
+	return RestoreDocumentVersion200JSONResponse(documentToResponse(doc)), nil
+}
+
+func (h *handler) DiffDocumentVersions(ctx context.Context, request DiffDocumentVersionsRequestObject) (DiffDocumentVersionsResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	diff, err := h.service.DiffDocumentVersions(ctx, userID, request.DocumentID, request.Params.From, request.Params.To)
+	if err != nil {
+		if errors.Is(err, service.ErrDocumentNotFound) || errors.Is(err, service.ErrVersionNotFound) {
+			return DiffDocumentVersions404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return DiffDocumentVersions200JSONResponse(versionDiffToResponse(diff)), nil
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+// eventStreamHeartbeat — как часто в поток без событий отправляется комментарий,
+// чтобы прокси и балансировщики не закрывали соединение как простаивающее.
+const eventStreamHeartbeat = 25 * time.Second
+
+// eventStreamUserCheck — как часто открытый поток перепроверяет в базе, что аккаунт не отключен и не удален.
+const eventStreamUserCheck = time.Minute
+
+func (h *handler) StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error) {
+	token, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	checkUser := func(ctx context.Context) error {
+		user, err := h.service.GetUserProfile(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.Disabled {
+			return service.ErrAccountDisabled
+		}
+		return nil
+	}
+
+	if err := checkUser(ctx); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrAccountDisabled):
+			return StreamEvents403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return StreamEvents401Response{}, nil
+		}
+		return nil, err
+	}
+
+	events, unsubscribe, err := h.service.SubscribeDocumentEvents(userID)
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyEventStreams) {
+			errorMessage := err.Error()
+			return StreamEvents429JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return eventStreamResponse{
+		ctx:         ctx,
+		events:      events,
+		unsubscribe: unsubscribe,
+		expiresAt:   token.Expiration(),
+		checkUser:   checkUser,
+	}, nil
+}
+
+// eventStreamResponse держит соединение открытым и пишет события документов в формате Server-Sent Events,
+// пока клиент не отключится, сервис не закроет поток, не истечет access token или аккаунт не будет отключен.
+type eventStreamResponse struct {
+	ctx         context.Context
+	events      <-chan domain.DocumentEvent
+	unsubscribe func()
+	// expiresAt — срок действия access token, с которым открыт поток; нулевое время — без срока.
+	expiresAt time.Time
+	// checkUser возвращает ошибку, если аккаунт отключен или удален.
+	checkUser func(ctx context.Context) error
+}
+
+func (response eventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	defer response.unsubscribe()
+
+	rc := http.NewResponseController(w)
+	// Общий таймаут записи сервера оборвал бы поток через несколько секунд.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	userCheck := time.NewTicker(eventStreamUserCheck)
+	defer userCheck.Stop()
+
+	// Поток не должен переживать токен, с которым открыт: клиент переподключится с обновленным.
+	var expired <-chan time.Time
+	if !response.expiresAt.IsZero() {
+		expiry := time.NewTimer(time.Until(response.expiresAt))
+		defer expiry.Stop()
+		expired = expiry.C
+	}
+
+	for {
+		var err error
+		select {
+		case <-response.ctx.Done():
+			return nil
+		case <-expired:
+			return nil
+		case <-userCheck.C:
+			if err := response.checkUser(response.ctx); err != nil {
+				if errors.Is(err, service.ErrAccountDisabled) || errors.Is(err, service.ErrUserNotFound) || response.ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			continue
+		case event, ok := <-response.events:
+			if !ok {
+				return nil
+			}
+			err = writeDocumentEvent(w, event)
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			if response.ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func writeDocumentEvent(w http.ResponseWriter, event domain.DocumentEvent) error {
+	data, err := json.Marshal(DocumentEvent{
+		Type:            DocumentEventType(event.Type),
+		DocumentID:      event.DocumentID,
+		WorkspaceID:     event.WorkspaceID,
+		CurrentVersion:  event.CurrentVersion,
+		Status:          DocumentStatus(event.Status()),
+		NullEmbeddings:  event.NullEmbeddings,
+		TotalEmbeddings: event.TotalEmbeddings,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func folderToResponse(f *domain.Folder) Folder {
+	return Folder{
+		Id:          f.ID,
+		WorkspaceID: f.WorkspaceID,
+		ParentID:    f.ParentID,
+		Name:        f.Name,
+		Role:        WorkspaceRole(f.WorkspaceRole),
+		Documents:   f.Documents,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
+func isFolderNameError(err error) bool {
+	return errors.Is(err, service.ErrFolderNameRequired) ||
+		errors.Is(err, service.ErrFolderNameTooLong) ||
+		errors.Is(err, service.ErrInvalidFolderName)
+}
+
+func (h *handler) ListFolders(ctx context.Context, request ListFoldersRequestObject) (ListFoldersResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	folders, err := h.service.ListFolders(ctx, userID, request.Params.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNotFound) {
+			return ListFolders404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListFolders200JSONResponse, len(folders))
+	for i := range folders {
+		response[i] = folderToResponse(&folders[i])
+	}
+	return response, nil
+}
+
+func (h *handler) CreateFolder(ctx context.Context, request CreateFolderRequestObject) (CreateFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	folder, err := h.service.CreateFolder(ctx, userID, request.Body.WorkspaceID, request.Body.ParentID, request.Body.Name)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case isFolderNameError(err), errors.Is(err, service.ErrFolderWorkspaceMismatch):
+			return CreateFolder400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return CreateFolder403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrFolderNotFound):
+			return CreateFolder404JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrFolderNameTaken):
+			return CreateFolder409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return CreateFolder201JSONResponse(folderToResponse(folder)), nil
+}
+
+func (h *handler) GetFolder(ctx context.Context, request GetFolderRequestObject) (GetFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	folder, err := h.service.GetFolder(ctx, userID, request.FolderID)
+	if err != nil {
+		if errors.Is(err, service.ErrFolderNotFound) {
+			return GetFolder404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return GetFolder200JSONResponse(folderToResponse(folder)), nil
+}
+
+func (h *handler) RenameFolder(ctx context.Context, request RenameFolderRequestObject) (RenameFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	folder, err := h.service.RenameFolder(ctx, userID, request.FolderID, request.Body.Name)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case isFolderNameError(err):
+			return RenameFolder400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return RenameFolder403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrFolderNotFound):
+			return RenameFolder404Response{}, nil
+		case errors.Is(err, service.ErrFolderNameTaken):
+			return RenameFolder409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return RenameFolder200JSONResponse(folderToResponse(folder)), nil
+}
+
+func (h *handler) MoveFolder(ctx context.Context, request MoveFolderRequestObject) (MoveFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	folder, err := h.service.MoveFolder(ctx, userID, request.FolderID, request.Body.ParentID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrFolderCycle), errors.Is(err, service.ErrFolderWorkspaceMismatch):
+			return MoveFolder400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return MoveFolder403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrFolderNotFound):
+			return MoveFolder404JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrFolderNameTaken):
+			return MoveFolder409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return MoveFolder200JSONResponse(folderToResponse(folder)), nil
+}
+
+func (h *handler) DeleteFolder(ctx context.Context, request DeleteFolderRequestObject) (DeleteFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	trashed, err := h.service.DeleteFolder(ctx, userID, request.FolderID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return DeleteFolder403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrFolderNotFound):
+			return DeleteFolder404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return DeleteFolder200JSONResponse{TrashedDocuments: trashed}, nil
+}
+
+func (h *handler) SetDocumentFolder(ctx context.Context, request SetDocumentFolderRequestObject) (SetDocumentFolderResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	doc, err := h.service.SetDocumentFolder(ctx, userID, request.DocumentID, request.Body.FolderID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrFolderWorkspaceMismatch):
+			return SetDocumentFolder400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentForbidden):
+			return SetDocumentFolder403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound), errors.Is(err, service.ErrFolderNotFound):
+			return SetDocumentFolder404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return SetDocumentFolder200JSONResponse(documentToResponse(doc)), nil
+}
@@ -2,6 +2,8 @@ package handler
 
 import (
 	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/ratelimit"
 	"backend/internal/service"
 	"errors"
 	"net/http"
@@ -23,6 +25,7 @@ type handler struct {
 	cfg       *config.HandlerConfig
 	service   service.Service
 	tokenAuth *jwtauth.JWTAuth
+	limiter   *ratelimit.Limiter
 	log       *zerolog.Logger
 }
 
@@ -30,12 +33,14 @@ func NewHandler(
 	cfg *config.HandlerConfig,
 	service service.Service,
 	tokenAuth *jwtauth.JWTAuth,
+	limiter *ratelimit.Limiter,
 	log *zerolog.Logger,
 ) MyHandler {
 	return &handler{
 		cfg:       cfg,
 		service:   service,
 		tokenAuth: tokenAuth,
+		limiter:   limiter,
 		log:       log,
 	}
 }
@@ -46,12 +51,22 @@ func (h *handler) Init() http.Handler {
 	r.Use(cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:3000"},
 		AllowCredentials: true,
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		ExposedHeaders:   []string{"Link"},
-		MaxAge:           300,
+		AllowedHeaders: []string{
+			"Accept", "Authorization", "Content-Type", "X-CSRF-Token",
+			"Tus-Resumable", "Upload-Length", "Upload-Metadata", "Upload-Offset", "Upload-Checksum",
+		},
+		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		ExposedHeaders: []string{
+			"Link", "Location", "X-Total-Count", "X-Next-Cursor",
+			"Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Tus-Checksum-Algorithm",
+			"Upload-Offset", "Upload-Length", "Upload-Document-ID",
+		},
+		MaxAge: 300,
 	}).Handler)
 
+	if h.cfg.TrustProxy {
+		r.Use(middleware.RealIP)
+	}
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RequestID)
 	r.Use(contextInjector)
@@ -74,31 +89,124 @@ func (h *handler) Init() http.Handler {
 	r.Get("/ping", wrapper.Ping)
 
 	r.Route("/auth", func(r chi.Router) {
-		r.Post("/register", wrapper.Register)
-		r.Post("/login", wrapper.Login)
+		r.With(h.ipRateLimit("register")).Post("/register", wrapper.Register)
+		r.With(h.ipRateLimit("login")).Post("/login", wrapper.Login)
 		r.Post("/refresh", wrapper.Refresh)
+		r.With(h.ipRateLimit("verify_email")).Post("/verify-email", wrapper.VerifyEmail)
+		r.With(h.ipRateLimit("password_reset_request")).Post("/password-reset/request", wrapper.RequestPasswordReset)
+		r.With(h.ipRateLimit("password_reset")).Post("/password-reset", wrapper.ResetPassword)
+		r.With(h.ipRateLimit("2fa")).Post("/2fa/verify", wrapper.VerifyTwoFactorLogin)
+
+		r.Route("/oidc", func(r chi.Router) {
+			r.Get("/login", wrapper.OidcLogin)
+			r.Get("/callback", wrapper.OidcCallback)
+		})
 
 		r.Group(func(r chi.Router) {
 			r.Use(jwtMiddleware...)
 
 			r.Post("/logout", wrapper.Logout)
 			r.Post("/full_logout", wrapper.FullLogout)
+			r.With(h.ipRateLimit("verify_email_request")).Post("/verify-email/request", wrapper.RequestEmailVerification)
+			r.Post("/2fa/enroll", wrapper.BeginTwoFactorEnrollment)
+			r.Post("/2fa/enroll/confirm", wrapper.ConfirmTwoFactorEnrollment)
+			r.Post("/2fa/disable", wrapper.DisableTwoFactor)
 		})
 	})
 
+	r.Route("/uploads", func(r chi.Router) {
+		r.Use(tusResumable)
+
+		r.Options("/", wrapper.TusOptions)
+
+		r.Group(func(r chi.Router) {
+			r.Use(jwtMiddleware...)
+
+			r.Post("/", wrapper.CreateUpload)
+			r.Head("/{uploadID}", wrapper.GetUploadOffset)
+			r.Patch("/{uploadID}", wrapper.AppendUpload)
+			r.Delete("/{uploadID}", wrapper.DeleteUpload)
+		})
+	})
+
+	r.Route("/public/links/{token}", func(r chi.Router) {
+		r.Use(h.ipRateLimitRule("share_link", h.limiter.Config().ShareLink))
+
+		r.Get("/", wrapper.GetSharedDocument)
+		r.Post("/search", wrapper.SearchSharedDocument)
+	})
+
 	r.Group(func(r chi.Router) {
 		r.Use(jwtMiddleware...)
 
 		r.Route("/users", func(r chi.Router) {
 			r.Get("/me", wrapper.GetUserProfile)
+			r.Delete("/me", wrapper.DeleteAccount)
+			r.Put("/me/password", wrapper.ChangePassword)
+		})
+
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(h.requireRole(domain.RoleAdmin))
+
+			r.Get("/users", wrapper.AdminListUsers)
+			r.Get("/users/{userID}", wrapper.AdminGetUser)
+			r.Post("/users/{userID}/disable", wrapper.AdminDisableUser)
+			r.Post("/users/{userID}/enable", wrapper.AdminEnableUser)
+		})
+
+		r.Route("/workspaces", func(r chi.Router) {
+			r.Get("/", wrapper.ListWorkspaces)
+			r.Post("/", wrapper.CreateWorkspace)
+			r.Get("/{workspaceID}", wrapper.GetWorkspace)
+			r.Put("/{workspaceID}", wrapper.RenameWorkspace)
+			r.Delete("/{workspaceID}", wrapper.DeleteWorkspace)
+			r.Get("/{workspaceID}/members", wrapper.ListWorkspaceMembers)
+			r.Post("/{workspaceID}/members", wrapper.AddWorkspaceMember)
+			r.Put("/{workspaceID}/members/{userID}", wrapper.UpdateWorkspaceMember)
+			r.Delete("/{workspaceID}/members/{userID}", wrapper.RemoveWorkspaceMember)
+			r.Get("/{workspaceID}/webhooks", wrapper.ListWebhooks)
+			r.Post("/{workspaceID}/webhooks", wrapper.CreateWebhook)
+			r.Get("/{workspaceID}/webhooks/{webhookID}", wrapper.GetWebhook)
+			r.Put("/{workspaceID}/webhooks/{webhookID}", wrapper.UpdateWebhook)
+			r.Delete("/{workspaceID}/webhooks/{webhookID}", wrapper.DeleteWebhook)
+			r.Get("/{workspaceID}/webhooks/{webhookID}/deliveries", wrapper.ListWebhookDeliveries)
+			r.Post("/{workspaceID}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver", wrapper.RedeliverWebhook)
+		})
+
+		r.Get("/events", wrapper.StreamEvents)
+
+		r.Route("/folders", func(r chi.Router) {
+			r.Get("/", wrapper.ListFolders)
+			r.Post("/", wrapper.CreateFolder)
+			r.Get("/{folderID}", wrapper.GetFolder)
+			r.Put("/{folderID}", wrapper.RenameFolder)
+			r.Delete("/{folderID}", wrapper.DeleteFolder)
+			r.Post("/{folderID}/move", wrapper.MoveFolder)
 		})
 
 		r.Route("/documents", func(r chi.Router) {
 			r.Post("/", wrapper.UploadDocument)
 			r.Get("/", wrapper.ListUserDocuments)
+			r.Get("/trash", wrapper.ListTrash)
+			r.Delete("/trash", wrapper.EmptyTrash)
 			r.Get("/{documentID}", wrapper.GetDocumentByID)
+			r.Patch("/{documentID}", wrapper.UpdateDocument)
+			r.Put("/{documentID}", wrapper.UploadDocumentVersion)
 			r.Delete("/{documentID}", wrapper.DeleteDocument)
+			r.Get("/{documentID}/chunks", wrapper.ListDocumentChunks)
+			r.Get("/{documentID}/content", wrapper.GetDocumentContent)
+			r.Put("/{documentID}/folder", wrapper.SetDocumentFolder)
+			r.Post("/{documentID}/restore", wrapper.RestoreDocument)
+			r.Get("/{documentID}/versions", wrapper.ListDocumentVersions)
+			r.Post("/{documentID}/versions/{version}/restore", wrapper.RestoreDocumentVersion)
+			r.Get("/{documentID}/diff", wrapper.DiffDocumentVersions)
 			r.Post("/{documentID}/search", wrapper.SearchInDocument)
+			r.Get("/{documentID}/shares", wrapper.ListDocumentShares)
+			r.Post("/{documentID}/shares", wrapper.ShareDocument)
+			r.Delete("/{documentID}/shares/{userID}", wrapper.RevokeDocumentShare)
+			r.Get("/{documentID}/links", wrapper.ListShareLinks)
+			r.Post("/{documentID}/links", wrapper.CreateShareLink)
+			r.Delete("/{documentID}/links/{linkID}", wrapper.RevokeShareLink)
 			r.Post("/search", wrapper.Search)
 		})
 	})
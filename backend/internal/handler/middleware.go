@@ -1,8 +1,18 @@
 package handler
 
 import (
+	"backend/internal/config"
+	"backend/internal/ratelimit"
+	"backend/internal/service"
 	"context"
+	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/go-chi/jwtauth/v5"
 )
 
 type contextKey string
@@ -20,3 +30,83 @@ func contextInjector(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func (h *handler) ipRateLimit(scope string) func(http.Handler) http.Handler {
+	return h.ipRateLimitRule(scope, h.limiter.Config().IP)
+}
+
+func (h *handler) ipRateLimitRule(scope string, rule config.RateLimitRule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			err := h.limiter.Allow(r.Context(), ratelimit.IPKey(scope, clientIP(r)), rule)
+			if err != nil {
+				var limitErr *ratelimit.LimitError
+				if errors.As(err, &limitErr) {
+					h.log.Warn().Str("scope", scope).Str("ip", clientIP(r)).Msg("IP rate limit exceeded")
+					writeTooManyRequests(w, limitErr)
+					return
+				}
+				h.log.Err(err).Str("scope", scope).Msg("rate limiter failed")
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeTooManyRequests(w http.ResponseWriter, limitErr *ratelimit.LimitError) {
+	errorMessage := limitErr.Error()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(Error{Error: &errorMessage})
+}
+
+func tooManyRequests(limitErr *ratelimit.LimitError) TooManyRequestsJSONResponse {
+	errorMessage := limitErr.Error()
+	return TooManyRequestsJSONResponse{
+		Body:    Error{Error: &errorMessage},
+		Headers: TooManyRequestsResponseHeaders{RetryAfter: limitErr.RetryAfterSeconds()},
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// requireRole пропускает только пользователей с одной из ролей. Роль и статус берутся из базы, а не из токена,
+// чтобы отключенный или лишенный роли администратор терял доступ сразу, а не после истечения access token.
+func (h *handler) requireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, claims, _ := jwtauth.FromContext(r.Context())
+			userID, ok := claims["user_id"].(float64)
+			if !ok {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			user, err := h.service.GetUserProfile(r.Context(), int64(userID))
+			if err != nil {
+				if errors.Is(err, service.ErrUserNotFound) {
+					http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+					return
+				}
+				h.log.Err(err).Msg("failed to load user for role check")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			if user.Disabled || !slices.Contains(roles, user.Role) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
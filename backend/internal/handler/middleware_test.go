@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/rs/zerolog"
+)
+
+// profileService отдает профили пользователей из памяти, как их видит база.
+type profileService struct {
+	service.Service
+	users map[int64]domain.User
+}
+
+func (s *profileService) GetUserProfile(ctx context.Context, userID int64) (*domain.User, error) {
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, service.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+func TestRequireRole(t *testing.T) {
+	const (
+		admin    int64 = 1
+		demoted  int64 = 2
+		disabled int64 = 3
+		deleted  int64 = 4
+	)
+
+	log := zerolog.Nop()
+	h := &handler{
+		service: &profileService{users: map[int64]domain.User{
+			admin:    {ID: admin, Role: domain.RoleAdmin},
+			demoted:  {ID: demoted, Role: domain.RoleUser},
+			disabled: {ID: disabled, Role: domain.RoleAdmin, Disabled: true},
+		}},
+		log: &log,
+	}
+	tokenAuth := jwtauth.New("HS256", []byte("test-secret"), nil)
+
+	tests := []struct {
+		name   string
+		userID int64
+		want   int
+	}{
+		{name: "admin", userID: admin, want: http.StatusOK},
+		{name: "admin demoted after token was issued", userID: demoted, want: http.StatusForbidden},
+		{name: "admin disabled after token was issued", userID: disabled, want: http.StatusForbidden},
+		{name: "deleted user", userID: deleted, want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Токен выдан, когда пользователь еще был администратором.
+			_, tokenString, err := tokenAuth.Encode(map[string]any{"user_id": tt.userID, "role": domain.RoleAdmin})
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := tokenAuth.Decode(tokenString)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+			r = r.WithContext(jwtauth.NewContext(r.Context(), token, nil))
+			w := httptest.NewRecorder()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			h.requireRole(domain.RoleAdmin)(next).ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
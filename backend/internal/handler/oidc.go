@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	oidcStateCookie    = "oidc_state"
+	oidcNonceCookie    = "oidc_nonce"
+	oidcVerifierCookie = "oidc_verifier"
+	oidcCookiePath     = "/auth/oidc"
+)
+
+var oidcCookieExpires time.Duration = 10 * time.Minute
+
+func setOIDCCookies(w http.ResponseWriter, state, nonce, verifier string) {
+	for name, value := range map[string]string{
+		oidcStateCookie:    state,
+		oidcNonceCookie:    nonce,
+		oidcVerifierCookie: verifier,
+	} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     name,
+			Value:    value,
+			Path:     oidcCookiePath,
+			Expires:  time.Now().Add(oidcCookieExpires),
+			HttpOnly: true,
+			Secure:   false,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+}
+
+func clearOIDCCookies(w http.ResponseWriter) {
+	for _, name := range []string{oidcStateCookie, oidcNonceCookie, oidcVerifierCookie} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     name,
+			Value:    "",
+			Path:     oidcCookiePath,
+			HttpOnly: true,
+			MaxAge:   -1,
+		})
+	}
+}
+
+// twoFactorChallengeURL возвращает адрес фронтенда, на котором пользователь вводит второй фактор.
+// Токен шага входа передается во фрагменте: браузер не отправляет его на сервер и не пишет в Referer.
+func twoFactorChallengeURL(frontendURL string, result *domain.LoginResult) string {
+	params := url.Values{}
+	params.Set("challengeToken", result.ChallengeToken)
+	params.Set("expiresAt", result.ChallengeExpiresAt.UTC().Format(time.RFC3339))
+	return frontendURL + "#" + params.Encode()
+}
+
+func (h *handler) OidcLogin(ctx context.Context, request OidcLoginRequestObject) (OidcLoginResponseObject, error) {
+	loginRequest, err := h.service.BeginOIDCLogin(request.Params.Reauth != nil && *request.Params.Reauth)
+	if err != nil {
+		if errors.Is(err, service.ErrOIDCDisabled) {
+			return OidcLogin404Response{}, nil
+		}
+		return nil, err
+	}
+
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+
+	setOIDCCookies(w, loginRequest.State, loginRequest.Nonce, loginRequest.Verifier)
+
+	return OidcLogin302Response{
+		Headers: OidcLogin302ResponseHeaders{Location: loginRequest.AuthURL},
+	}, nil
+}
+
+func (h *handler) OidcCallback(ctx context.Context, request OidcCallbackRequestObject) (OidcCallbackResponseObject, error) {
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+	r, ok := ctx.Value(requestKey).(*http.Request)
+	if !ok {
+		return nil, fmt.Errorf("request not found in context")
+	}
+
+	clearOIDCCookies(w)
+
+	if request.Params.Error != nil {
+		h.log.Warn().Str("error", *request.Params.Error).Msg("OIDC provider returned an error")
+		errorMessage := "identity provider returned an error: " + *request.Params.Error
+		return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+	}
+	if request.Params.Code == nil || request.Params.State == nil {
+		errorMessage := "code and state are required"
+		return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+	}
+
+	stateCookie, err := r.Cookie(oidcStateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(*request.Params.State)) != 1 {
+		errorMessage := "invalid or expired login state"
+		return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+	}
+	nonceCookie, err := r.Cookie(oidcNonceCookie)
+	if err != nil {
+		errorMessage := "invalid or expired login state"
+		return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+	}
+	verifierCookie, err := r.Cookie(oidcVerifierCookie)
+	if err != nil {
+		errorMessage := "invalid or expired login state"
+		return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+	}
+
+	result, err := h.service.OIDCLogin(ctx, *request.Params.Code, verifierCookie.Value, nonceCookie.Value)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOIDCDisabled):
+			return OidcCallback404Response{}, nil
+		case errors.Is(err, service.ErrAccountDisabled):
+			errorMessage := err.Error()
+			return OidcCallback403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrOIDCEmailNotVerified):
+			errorMessage := err.Error()
+			return OidcCallback409JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrOIDCInvalidLogin), errors.Is(err, service.ErrOIDCEmailMissing):
+			errorMessage := err.Error()
+			return OidcCallback400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	if result.TwoFactorRequired() {
+		return OidcCallback302Response{
+			Headers: OidcCallback302ResponseHeaders{Location: twoFactorChallengeURL(h.cfg.FrontendURL, result)},
+		}, nil
+	}
+
+	setTokensCookie(w, result.AccessToken, result.RefreshToken)
+
+	return OidcCallback302Response{
+		Headers: OidcCallback302ResponseHeaders{Location: h.cfg.FrontendURL},
+	}, nil
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestOidcCallbackRejectsInvalidState(t *testing.T) {
+	log := zerolog.Nop()
+	// Сервис не нужен: все случаи должны отклоняться до обмена кода.
+	h := &handler{log: &log}
+
+	state := "state-1"
+	code := "code-1"
+	providerError := "access_denied"
+	allCookies := map[string]string{
+		oidcStateCookie:    state,
+		oidcNonceCookie:    "nonce-1",
+		oidcVerifierCookie: "verifier-1",
+	}
+
+	tests := []struct {
+		name    string
+		params  OidcCallbackParams
+		cookies map[string]string
+	}{
+		{
+			name:    "provider error",
+			params:  OidcCallbackParams{Error: &providerError, Code: &code, State: &state},
+			cookies: allCookies,
+		},
+		{
+			name:    "missing code",
+			params:  OidcCallbackParams{State: &state},
+			cookies: allCookies,
+		},
+		{
+			name:   "missing state cookie",
+			params: OidcCallbackParams{Code: &code, State: &state},
+			cookies: map[string]string{
+				oidcNonceCookie:    "nonce-1",
+				oidcVerifierCookie: "verifier-1",
+			},
+		},
+		{
+			name:   "state mismatch",
+			params: OidcCallbackParams{Code: &code, State: &state},
+			cookies: map[string]string{
+				oidcStateCookie:    "state-2",
+				oidcNonceCookie:    "nonce-1",
+				oidcVerifierCookie: "verifier-1",
+			},
+		},
+		{
+			name:   "missing nonce cookie",
+			params: OidcCallbackParams{Code: &code, State: &state},
+			cookies: map[string]string{
+				oidcStateCookie:    state,
+				oidcVerifierCookie: "verifier-1",
+			},
+		},
+		{
+			name:   "missing PKCE verifier cookie",
+			params: OidcCallbackParams{Code: &code, State: &state},
+			cookies: map[string]string{
+				oidcStateCookie: state,
+				oidcNonceCookie: "nonce-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback", nil)
+			for name, value := range tt.cookies {
+				r.AddCookie(&http.Cookie{Name: name, Value: value})
+			}
+			w := httptest.NewRecorder()
+			ctx := context.WithValue(context.WithValue(r.Context(), responseWriterKey, w), requestKey, r)
+
+			response, err := h.OidcCallback(ctx, OidcCallbackRequestObject{Params: tt.params})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := response.(OidcCallback400JSONResponse); !ok {
+				t.Fatalf("response = %T, want OidcCallback400JSONResponse", response)
+			}
+
+			// Одноразовые state, nonce и verifier удаляются при любом исходе.
+			cleared := make(map[string]bool)
+			for _, cookie := range w.Result().Cookies() {
+				if cookie.MaxAge < 0 {
+					cleared[cookie.Name] = true
+				}
+			}
+			for _, name := range []string{oidcStateCookie, oidcNonceCookie, oidcVerifierCookie} {
+				if !cleared[name] {
+					t.Errorf("cookie %s is not cleared", name)
+				}
+			}
+		})
+	}
+}
+
+func TestTwoFactorChallengeURL(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	location := twoFactorChallengeURL("http://localhost:3000/", &domain.LoginResult{
+		ChallengeToken:     "token+/=",
+		ChallengeExpiresAt: expiresAt,
+	})
+
+	// Токен передается только во фрагменте, чтобы не попасть в журналы серверов и Referer.
+	base, fragment, ok := strings.Cut(location, "#")
+	if !ok || base != "http://localhost:3000/" {
+		t.Fatalf("location = %q, want frontend URL with a fragment", location)
+	}
+	params, err := url.ParseQuery(fragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := params.Get("challengeToken"); got != "token+/=" {
+		t.Errorf("challengeToken = %q, want %q", got, "token+/=")
+	}
+	if got := params.Get("expiresAt"); got != "2026-10-18T09:00:00Z" {
+		t.Errorf("expiresAt = %q, want %q", got, "2026-10-18T09:00:00Z")
+	}
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func shareLinkToResponse(l *domain.ShareLink) ShareLink {
+	return ShareLink{
+		Id:         l.ID,
+		DocumentID: l.DocumentID,
+		Active:     l.Active(time.Now()),
+		ExpiresAt:  l.ExpiresAt,
+		RevokedAt:  l.RevokedAt,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+func (h *handler) ListShareLinks(ctx context.Context, request ListShareLinksRequestObject) (ListShareLinksResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	links, err := h.service.ListShareLinks(ctx, userID, request.DocumentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			errorMessage := err.Error()
+			return ListShareLinks403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return ListShareLinks404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListShareLinks200JSONResponse, len(links))
+	for i := range links {
+		response[i] = shareLinkToResponse(&links[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) CreateShareLink(ctx context.Context, request CreateShareLinkRequestObject) (CreateShareLinkResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	var expiresAt *time.Time
+	if request.Body != nil {
+		expiresAt = request.Body.ExpiresAt
+	}
+
+	link, token, err := h.service.CreateShareLink(ctx, userID, request.DocumentID, expiresAt)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrShareLinkExpiresInPast):
+			return CreateShareLink400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			return CreateShareLink403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound):
+			return CreateShareLink404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := shareLinkToResponse(link)
+	return CreateShareLink201JSONResponse{
+		Id:         response.Id,
+		DocumentID: response.DocumentID,
+		Active:     response.Active,
+		ExpiresAt:  response.ExpiresAt,
+		RevokedAt:  response.RevokedAt,
+		CreatedAt:  response.CreatedAt,
+		Token:      token,
+	}, nil
+}
+
+func (h *handler) RevokeShareLink(ctx context.Context, request RevokeShareLinkRequestObject) (RevokeShareLinkResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.RevokeShareLink(ctx, userID, request.DocumentID, request.LinkID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrDocumentShareForbidden):
+			errorMessage := err.Error()
+			return RevokeShareLink403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrDocumentNotFound), errors.Is(err, service.ErrShareLinkNotFound):
+			return RevokeShareLink404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return RevokeShareLink204Response{}, nil
+}
+
+func (h *handler) GetSharedDocument(ctx context.Context, request GetSharedDocumentRequestObject) (GetSharedDocumentResponseObject, error) {
+	doc, chunks, err := h.service.GetSharedDocument(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrShareLinkNotFound) {
+			return GetSharedDocument404Response{}, nil
+		}
+		return nil, err
+	}
+
+	responseChunks := make([]Chunk, len(chunks))
+	for i := range chunks {
+		responseChunks[i] = chunkToResponse(&chunks[i])
+	}
+
+	return GetSharedDocument200JSONResponse{
+		Id:       doc.ID,
+		Filename: doc.Filename,
+		Chunks:   responseChunks,
+	}, nil
+}
+
+func (h *handler) SearchSharedDocument(ctx context.Context, request SearchSharedDocumentRequestObject) (SearchSharedDocumentResponseObject, error) {
+	results, err := h.service.SearchSharedDocument(ctx, request.Token, request.Body.Query)
+	if err != nil {
+		if errors.Is(err, service.ErrShareLinkNotFound) {
+			return SearchSharedDocument404Response{}, nil
+		}
+		return nil, err
+	}
+
+	responseResults := make(SearchSharedDocument200JSONResponse, len(results))
+	for i, r := range results {
+		responseResults[i] = SearchResult{
+			Id:         &r.ID,
+			DocumentID: &r.DocumentID,
+			Text:       &r.Text,
+			Distance:   &r.Distance,
+		}
+	}
+
+	return responseResults, nil
+}
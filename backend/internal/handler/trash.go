@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func (h *handler) ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	docs, err := h.service.ListTrash(ctx, userID, request.Params.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNotFound) {
+			return ListTrash404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListTrash200JSONResponse, len(docs))
+	for i, d := range docs {
+		response[i] = TrashedDocument{
+			Document:  documentToResponse(&d.Document),
+			DeletedAt: d.DeletedAt,
+			DeletedBy: d.DeletedBy,
+			PurgeAt:   d.PurgeAt,
+		}
+	}
+	return response, nil
+}
+
+func (h *handler) RestoreDocument(ctx context.Context, request RestoreDocumentRequestObject) (RestoreDocumentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	doc, err := h.service.RestoreDocument(ctx, userID, request.DocumentID)
+	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case errors.Is(err, service.ErrTrashedDocumentNotFound):
+			return RestoreDocument404Response{}, nil
+		case errors.As(err, &quotaErr):
+			return RestoreDocument403JSONResponse(quotaError(quotaErr)), nil
+		}
+		return nil, err
+	}
+
+	return RestoreDocument200JSONResponse(documentToResponse(doc)), nil
+}
+
+func (h *handler) EmptyTrash(ctx context.Context, request EmptyTrashRequestObject) (EmptyTrashResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	deleted, err := h.service.EmptyTrash(ctx, userID, request.Params.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNotFound) {
+			return EmptyTrash404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return EmptyTrash200JSONResponse{Deleted: deleted}, nil
+}
@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination,checksum"
+)
+
+// tusResumable выставляет Tus-Resumable во всех ответах и отклоняет запросы клиентов
+// с другой версией протокола. OPTIONS по спецификации tus не требует этого заголовка.
+func tusResumable(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Tus-Resumable", tusVersion)
+
+		if r.Method != http.MethodOptions && r.Header.Get("Tus-Resumable") != tusVersion {
+			w.Header().Set("Tus-Version", tusVersion)
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseUploadMetadata разбирает заголовок Upload-Metadata: пары "ключ base64(значение)" через запятую.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, fmt.Errorf("invalid Upload-Metadata: empty key")
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Upload-Metadata value for key %q", key)
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+// parseUploadChecksum разбирает заголовок Upload-Checksum: "алгоритм base64(сумма)".
+func parseUploadChecksum(header string) (*service.UploadChecksum, error) {
+	algorithm, encoded, ok := strings.Cut(header, " ")
+	if !ok {
+		return nil, fmt.Errorf("invalid Upload-Checksum header")
+	}
+	if !slices.Contains(service.UploadChecksumAlgorithms, algorithm) {
+		return nil, service.ErrUnsupportedChecksum
+	}
+	sum, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Upload-Checksum header")
+	}
+	return &service.UploadChecksum{Algorithm: algorithm, Sum: sum}, nil
+}
+
+func uploadDocumentID(u *domain.Upload) string {
+	if u.DocumentID == nil {
+		return ""
+	}
+	return strconv.FormatInt(*u.DocumentID, 10)
+}
+
+// maxResumableUploadSize возвращает предел размера tus-загрузки. Без отдельной настройки
+// действует предел обычной загрузки.
+func (h *handler) maxResumableUploadSize() int64 {
+	if h.cfg.MaxResumableUploadSize > 0 {
+		return h.cfg.MaxResumableUploadSize
+	}
+	return h.cfg.MaxUploadSize
+}
+
+// extendUploadDeadlines продлевает сроки чтения и записи соединения для одного PATCH:
+// общие таймауты сервера рассчитаны на короткие запросы и оборвали бы передачу большого куска по медленной сети.
+func (h *handler) extendUploadDeadlines(w http.ResponseWriter) error {
+	if h.cfg.ResumableUploadTimeout <= 0 {
+		return nil
+	}
+
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(h.cfg.ResumableUploadTimeout)
+	if err := rc.SetReadDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if err := rc.SetWriteDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+func (h *handler) TusOptions(ctx context.Context, request TusOptionsRequestObject) (TusOptionsResponseObject, error) {
+	return TusOptions204Response{
+		Headers: TusOptions204ResponseHeaders{
+			TusVersion:           tusVersion,
+			TusExtension:         tusExtensions,
+			TusMaxSize:           h.maxResumableUploadSize(),
+			TusChecksumAlgorithm: strings.Join(service.UploadChecksumAlgorithms, ","),
+		},
+	}, nil
+}
+
+func (h *handler) CreateUpload(ctx context.Context, request CreateUploadRequestObject) (CreateUploadResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	r, ok := ctx.Value(requestKey).(*http.Request)
+	if !ok {
+		return nil, fmt.Errorf("request not found in context")
+	}
+
+	if request.Params.UploadLength < 0 {
+		errorMessage := "Upload-Length must not be negative"
+		return CreateUpload400JSONResponse{Error: &errorMessage}, nil
+	}
+	if maxSize := h.maxResumableUploadSize(); request.Params.UploadLength > maxSize {
+		errorMessage := fmt.Sprintf("file too large: max %d bytes", maxSize)
+		return CreateUpload413JSONResponse{Error: &errorMessage}, nil
+	}
+
+	var metadata map[string]string
+	if request.Params.UploadMetadata != nil {
+		var err error
+		metadata, err = parseUploadMetadata(*request.Params.UploadMetadata)
+		if err != nil {
+			errorMessage := err.Error()
+			return CreateUpload400JSONResponse{Error: &errorMessage}, nil
+		}
+	}
+
+	filename := metadata["filename"]
+	if !allowedUploadFilename(filename) {
+		errorMessage := "Upload-Metadata must contain a .txt filename"
+		return CreateUpload400JSONResponse{Error: &errorMessage}, nil
+	}
+
+	upload, err := h.service.CreateUpload(ctx, userID, request.Params.WorkspaceID, filename, metadata["filetype"], request.Params.UploadLength)
+	if err != nil {
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case errors.Is(err, service.ErrWorkspaceNotFound):
+			return CreateUpload404Response{}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return CreateUpload403JSONResponse{Error: err.Error()}, nil
+		case errors.As(err, &quotaErr):
+			return CreateUpload403JSONResponse(quotaError(quotaErr)), nil
+		}
+		return nil, err
+	}
+
+	h.log.Info().Int64("user_id", userID).Str("upload_id", upload.ID).Str("filename", filename).Msg("Создана tus-загрузка")
+
+	return CreateUpload201Response{
+		Headers: CreateUpload201ResponseHeaders{Location: path.Join(r.URL.Path, upload.ID)},
+	}, nil
+}
+
+func (h *handler) GetUploadOffset(ctx context.Context, request GetUploadOffsetRequestObject) (GetUploadOffsetResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	upload, err := h.service.GetUpload(ctx, userID, request.UploadID)
+	if err != nil {
+		if errors.Is(err, service.ErrUploadNotFound) {
+			return GetUploadOffset404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return GetUploadOffset200Response{
+		Headers: GetUploadOffset200ResponseHeaders{
+			UploadOffset:     upload.Offset,
+			UploadLength:     upload.Length,
+			UploadDocumentID: uploadDocumentID(upload),
+			CacheControl:     "no-store",
+		},
+	}, nil
+}
+
+func (h *handler) AppendUpload(ctx context.Context, request AppendUploadRequestObject) (AppendUploadResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	r, ok := ctx.Value(requestKey).(*http.Request)
+	if !ok {
+		return nil, fmt.Errorf("request not found in context")
+	}
+	if r.Header.Get("Content-Type") != "application/offset+octet-stream" {
+		return AppendUpload415Response{}, nil
+	}
+
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+	if err := h.extendUploadDeadlines(w); err != nil {
+		return nil, err
+	}
+
+	var checksum *service.UploadChecksum
+	if request.Params.UploadChecksum != nil {
+		var err error
+		checksum, err = parseUploadChecksum(*request.Params.UploadChecksum)
+		if err != nil {
+			errorMessage := err.Error()
+			return AppendUpload400JSONResponse{Error: &errorMessage}, nil
+		}
+	}
+
+	upload, err := h.service.AppendUpload(ctx, userID, request.UploadID, request.Params.UploadOffset, request.Body, checksum)
+	if err != nil {
+		errorMessage := err.Error()
+		var quotaErr *domain.QuotaExceededError
+		switch {
+		case errors.Is(err, service.ErrUploadNotFound), errors.Is(err, service.ErrWorkspaceNotFound):
+			return AppendUpload404Response{}, nil
+		case errors.Is(err, service.ErrUploadOffsetMismatch):
+			return AppendUpload409JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrUploadLocked):
+			return AppendUpload423JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrUploadChecksumMismatch):
+			return AppendUpload460JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrUnsupportedChecksum):
+			return AppendUpload400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return AppendUpload403JSONResponse{Error: errorMessage}, nil
+		case errors.As(err, &quotaErr):
+			return AppendUpload403JSONResponse(quotaError(quotaErr)), nil
+		}
+		h.log.Error().Err(err).Int64("user_id", userID).Str("upload_id", request.UploadID).Msg("Ошибка записи tus-загрузки")
+		return nil, err
+	}
+
+	if upload.DocumentID != nil {
+		h.log.Info().Int64("user_id", userID).Str("upload_id", upload.ID).Int64("document_id", *upload.DocumentID).Msg("tus-загрузка завершена, документ создан")
+	}
+
+	return AppendUpload204Response{
+		Headers: AppendUpload204ResponseHeaders{
+			UploadOffset:     upload.Offset,
+			UploadDocumentID: uploadDocumentID(upload),
+		},
+	}, nil
+}
+
+func (h *handler) DeleteUpload(ctx context.Context, request DeleteUploadRequestObject) (DeleteUploadResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.DeleteUpload(ctx, userID, request.UploadID); err != nil {
+		if errors.Is(err, service.ErrUploadNotFound) {
+			return DeleteUpload404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return DeleteUpload204Response{}, nil
+}
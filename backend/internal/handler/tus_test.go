@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"backend/internal/config"
+	"backend/internal/service"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestParseUploadChecksum(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        string
+		wantAlgorithm string
+		wantSum       []byte
+		wantErr       error
+		wantAnyErr    bool
+	}{
+		{name: "sha256", header: "sha256 AQID", wantAlgorithm: "sha256", wantSum: []byte{1, 2, 3}},
+		{name: "sha1", header: "sha1 AQID", wantAlgorithm: "sha1", wantSum: []byte{1, 2, 3}},
+		{name: "md5", header: "md5 AQID", wantAlgorithm: "md5", wantSum: []byte{1, 2, 3}},
+		{name: "unsupported algorithm", header: "crc32 AQID", wantErr: service.ErrUnsupportedChecksum},
+		{name: "algorithm in upper case", header: "SHA256 AQID", wantErr: service.ErrUnsupportedChecksum},
+		{name: "missing sum", header: "sha256", wantAnyErr: true},
+		{name: "invalid base64", header: "sha256 not-base64!", wantAnyErr: true},
+		{name: "empty header", header: "", wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checksum, err := parseUploadChecksum(tt.header)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.wantAnyErr:
+				if err == nil {
+					t.Fatal("header is accepted, want error")
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if checksum.Algorithm != tt.wantAlgorithm || !bytes.Equal(checksum.Sum, tt.wantSum) {
+				t.Errorf("checksum = %s %v, want %s %v", checksum.Algorithm, checksum.Sum, tt.wantAlgorithm, tt.wantSum)
+			}
+		})
+	}
+}
+
+func TestMaxResumableUploadSize(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.HandlerConfig
+		want int64
+	}{
+		{name: "separate limit", cfg: config.HandlerConfig{MaxUploadSize: 10, MaxResumableUploadSize: 1000}, want: 1000},
+		{name: "falls back to upload limit", cfg: config.HandlerConfig{MaxUploadSize: 10}, want: 10},
+	}
+
+	for _, tt := range tests {
+		h := &handler{cfg: &tt.cfg}
+		if got := h.maxResumableUploadSize(); got != tt.want {
+			t.Errorf("%s: maxResumableUploadSize = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExtendUploadDeadlines(t *testing.T) {
+	const readTimeout = 100 * time.Millisecond
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		wantOK  bool
+	}{
+		{name: "server read timeout applies", timeout: 0, wantOK: false},
+		{name: "deadline extended for the upload", timeout: 5 * time.Second, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := zerolog.Nop()
+			h := &handler{cfg: &config.HandlerConfig{ResumableUploadTimeout: tt.timeout}, log: &log}
+
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := h.extendUploadDeadlines(w); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				if _, err := io.Copy(io.Discard, r.Body); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			server.Config.ReadTimeout = readTimeout
+			server.Config.WriteTimeout = readTimeout
+			server.Start()
+			defer server.Close()
+
+			// Тело приходит медленнее, чем позволяет общий таймаут чтения сервера.
+			body, bodyWriter := io.Pipe()
+			go func() {
+				for range 3 {
+					time.Sleep(readTimeout)
+					if _, err := bodyWriter.Write([]byte("chunk")); err != nil {
+						return
+					}
+				}
+				bodyWriter.Close()
+			}()
+
+			req, err := http.NewRequest(http.MethodPatch, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := server.Client().Do(req)
+			ok := err == nil && resp.StatusCode == http.StatusNoContent
+			if err == nil {
+				resp.Body.Close()
+			}
+			if ok != tt.wantOK {
+				t.Errorf("upload succeeded = %v, want %v (err %v)", ok, tt.wantOK, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"backend/internal/service"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func (h *handler) VerifyTwoFactorLogin(ctx context.Context, request VerifyTwoFactorLoginRequestObject) (VerifyTwoFactorLoginResponseObject, error) {
+	accessToken, refreshToken, err := h.service.VerifyLoginChallenge(ctx, request.Body.ChallengeToken, request.Body.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTwoFactorCode) ||
+			errors.Is(err, service.ErrLoginChallengeNotFound) ||
+			errors.Is(err, service.ErrTwoFactorNotEnabled) {
+			errorMessage := err.Error()
+			return VerifyTwoFactorLogin401JSONResponse{Error: &errorMessage}, nil
+		}
+		if errors.Is(err, service.ErrAccountDisabled) {
+			errorMessage := err.Error()
+			return VerifyTwoFactorLogin403JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+
+	setTokensCookie(w, accessToken, refreshToken)
+	w.WriteHeader(http.StatusOK)
+
+	return nil, nil
+}
+
+func (h *handler) BeginTwoFactorEnrollment(ctx context.Context, request BeginTwoFactorEnrollmentRequestObject) (BeginTwoFactorEnrollmentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	enrollment, err := h.service.BeginTOTPEnrollment(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTwoFactorNotConfigured):
+			return BeginTwoFactorEnrollment404Response{}, nil
+		case errors.Is(err, service.ErrTwoFactorAlreadyEnabled):
+			errorMessage := err.Error()
+			return BeginTwoFactorEnrollment409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return BeginTwoFactorEnrollment200JSONResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}, nil
+}
+
+func (h *handler) ConfirmTwoFactorEnrollment(ctx context.Context, request ConfirmTwoFactorEnrollmentRequestObject) (ConfirmTwoFactorEnrollmentResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	recoveryCodes, err := h.service.ConfirmTOTPEnrollment(ctx, userID, request.Body.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTwoFactorCode), errors.Is(err, service.ErrTOTPEnrollmentNotFound):
+			errorMessage := err.Error()
+			return ConfirmTwoFactorEnrollment400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrTwoFactorAlreadyEnabled):
+			errorMessage := err.Error()
+			return ConfirmTwoFactorEnrollment409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return ConfirmTwoFactorEnrollment200JSONResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *handler) DisableTwoFactor(ctx context.Context, request DisableTwoFactorRequestObject) (DisableTwoFactorResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	reauth := reauthFromRequest(claims, request.Body.Password, &request.Body.Code)
+	if err := h.service.DisableTOTP(ctx, userID, reauth); err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			return DisableTwoFactor401Response{}, nil
+		case errors.Is(err, service.ErrReauthenticationRequired):
+			errorMessage := err.Error()
+			return DisableTwoFactor403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrInvalidTwoFactorCode), errors.Is(err, service.ErrTwoFactorNotEnabled):
+			errorMessage := err.Error()
+			return DisableTwoFactor400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return DisableTwoFactor204Response{}, nil
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// uploadMemoryThreshold — размер, до которого загружаемый файл держится в памяти.
+// Файлы крупнее сбрасываются во временный файл на диске.
+const uploadMemoryThreshold = 1024 * 1024
+
+var errUploadTooLarge = errors.New("file too large")
+
+// allowedUploadFilename проверяет, что документ можно загрузить: пока поддерживаются только .txt файлы.
+func allowedUploadFilename(filename string) bool {
+	return filename != "" && strings.HasSuffix(strings.ToLower(filename), ".txt")
+}
+
+type uploadContent interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+// spooledUpload — содержимое загруженного файла, прочитанное с ограничением размера.
+// Небольшие файлы хранятся в памяти, крупные — во временном файле, который удаляется в Close.
+type spooledUpload struct {
+	uploadContent
+	size int64
+	file *os.File
+}
+
+// spoolUpload читает r не больше maxSize байт. Если данных больше, возвращает errUploadTooLarge,
+// не дочитывая остаток.
+func spoolUpload(r io.Reader, maxSize int64) (*spooledUpload, error) {
+	limited := io.LimitReader(r, maxSize+1)
+
+	var buf bytes.Buffer
+	n, err := io.CopyN(&buf, limited, uploadMemoryThreshold+1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if n > maxSize {
+		return nil, errUploadTooLarge
+	}
+	if n <= uploadMemoryThreshold {
+		return &spooledUpload{uploadContent: bytes.NewReader(buf.Bytes()), size: n}, nil
+	}
+
+	file, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return nil, err
+	}
+	upload := &spooledUpload{uploadContent: file, file: file}
+
+	size, err := io.Copy(file, io.MultiReader(&buf, limited))
+	if err == nil && size > maxSize {
+		err = errUploadTooLarge
+	}
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		upload.Close()
+		return nil, err
+	}
+
+	upload.size = size
+	return upload, nil
+}
+
+// detectContentType определяет тип содержимого по первым байтам и возвращает указатель чтения в начало.
+func (u *spooledUpload) detectContentType() (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(u, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	if _, err := u.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+func (u *spooledUpload) Close() error {
+	if u.file == nil {
+		return nil
+	}
+	closeErr := u.file.Close()
+	if err := os.Remove(u.file.Name()); err != nil {
+		return err
+	}
+	return closeErr
+}
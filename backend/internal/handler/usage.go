@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"time"
+)
+
+func quotaUsage(used, limit int64) QuotaUsage {
+	usage := QuotaUsage{Used: used}
+	if limit > 0 {
+		usage.Limit = &limit
+	}
+	return usage
+}
+
+func usageToResponse(u *domain.Usage) Usage {
+	return Usage{
+		StorageBytes:  quotaUsage(u.SizeBytes, u.MaxBytes),
+		Documents:     quotaUsage(u.Documents, u.MaxDocuments),
+		Chunks:        quotaUsage(u.Chunks, u.MaxChunks),
+		SearchesToday: quotaUsage(u.SearchesToday, u.MaxSearchesPerDay),
+	}
+}
+
+func quotaError(err *domain.QuotaExceededError) QuotaError {
+	resource := QuotaErrorResource(err.Resource)
+	return QuotaError{
+		Error:    err.Error(),
+		Resource: &resource,
+		Limit:    &err.Limit,
+		Used:     &err.Used,
+	}
+}
+
+// searchQuotaExceeded формирует ответ 429: дневной лимит поисков сбрасывается в полночь UTC.
+func searchQuotaExceeded(err *domain.QuotaExceededError) SearchQuotaExceededJSONResponse {
+	now := time.Now().UTC()
+	resetAt := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	return SearchQuotaExceededJSONResponse{
+		Body:    quotaError(err),
+		Headers: SearchQuotaExceededResponseHeaders{RetryAfter: int(resetAt.Sub(now).Seconds()) + 1},
+	}
+}
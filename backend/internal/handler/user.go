@@ -1,7 +1,13 @@
 package handler
 
 import (
+	"backend/internal/domain"
+	"backend/internal/service"
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-chi/jwtauth/v5"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -17,8 +23,88 @@ func (h *handler) GetUserProfile(ctx context.Context, request GetUserProfileRequ
 		return nil, err
 	}
 
+	usage, err := h.service.GetUserUsage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	responseUsage := usageToResponse(usage)
+
 	return GetUserProfile200JSONResponse{
-		Id:    &user.ID,
-		Email: (*openapi_types.Email)(&user.Email),
+		Id:               &user.ID,
+		Email:            (*openapi_types.Email)(&user.Email),
+		EmailVerified:    &user.EmailVerified,
+		TwoFactorEnabled: &user.TwoFactorEnabled,
+		Role:             (*UserRole)(&user.Role),
+		Usage:            &responseUsage,
 	}, nil
 }
+
+func (h *handler) ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	reauth := reauthFromRequest(claims, request.Body.CurrentPassword, request.Body.Code)
+	accessToken, refreshToken, err := h.service.ChangePassword(ctx, userID, reauth, request.Body.NewPassword)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			return ChangePassword401Response{}, nil
+		case errors.Is(err, service.ErrReauthenticationRequired), errors.Is(err, service.ErrInvalidTwoFactorCode):
+			return ChangePassword403JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+
+	setTokensCookie(w, accessToken, refreshToken)
+
+	return ChangePassword204Response{}, nil
+}
+
+func (h *handler) DeleteAccount(ctx context.Context, request DeleteAccountRequestObject) (DeleteAccountResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	reauth := reauthFromRequest(claims, request.Body.Password, request.Body.Code)
+	if err := h.service.DeleteAccount(ctx, userID, reauth); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			return DeleteAccount401Response{}, nil
+		case errors.Is(err, service.ErrReauthenticationRequired), errors.Is(err, service.ErrInvalidTwoFactorCode):
+			return DeleteAccount403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrSoleWorkspaceOwner):
+			return DeleteAccount409JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	w, ok := ctx.Value(responseWriterKey).(http.ResponseWriter)
+	if !ok {
+		return nil, fmt.Errorf("response writer not found in context")
+	}
+
+	clearTokensCookie(w)
+
+	return DeleteAccount204Response{}, nil
+}
+
+// reauthFromRequest собирает данные повторной аутентификации из тела запроса и токена доступа.
+func reauthFromRequest(claims map[string]interface{}, password, code *string) domain.Reauthentication {
+	var reauth domain.Reauthentication
+	if password != nil {
+		reauth.Password = *password
+	}
+	if code != nil {
+		reauth.Code = *code
+	}
+	if authTime, ok := claims[service.OIDCAuthTimeClaim].(float64); ok {
+		reauth.OIDCAuthTime = time.Unix(int64(authTime), 0)
+	}
+	return reauth
+}
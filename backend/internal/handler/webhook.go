@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+func webhookToResponse(w *domain.Webhook) Webhook {
+	events := make([]WebhookEventType, len(w.Events))
+	for i, event := range w.Events {
+		events[i] = WebhookEventType(event)
+	}
+	return Webhook{
+		Id:          w.ID,
+		WorkspaceID: w.WorkspaceID,
+		Url:         w.URL,
+		Events:      events,
+		Enabled:     w.Enabled,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+func webhookDeliveryToResponse(d *domain.WebhookDelivery) WebhookDelivery {
+	delivery := WebhookDelivery{
+		Id:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		EventType:      WebhookEventType(d.EventType),
+		Status:         WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.ResponseBody != "" {
+		delivery.ResponseBody = &d.ResponseBody
+	}
+	if d.Error != "" {
+		delivery.Error = &d.Error
+	}
+	return delivery
+}
+
+func webhookEventsFromRequest(events *[]WebhookEventType) []string {
+	if events == nil {
+		return nil
+	}
+	result := make([]string, len(*events))
+	for i, event := range *events {
+		result[i] = string(event)
+	}
+	return result
+}
+
+func isWebhookValidationError(err error) bool {
+	return errors.Is(err, service.ErrInvalidWebhookURL) ||
+		errors.Is(err, service.ErrWebhookPrivateAddress) ||
+		errors.Is(err, service.ErrInvalidWebhookEvent) ||
+		errors.Is(err, service.ErrInvalidWebhookSecret)
+}
+
+func (h *handler) ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	webhooks, err := h.service.ListWebhooks(ctx, userID, request.WorkspaceID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return ListWebhooks403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound):
+			return ListWebhooks404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListWebhooks200JSONResponse, len(webhooks))
+	for i := range webhooks {
+		response[i] = webhookToResponse(&webhooks[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	events := webhookEventsFromRequest(request.Body.Events)
+	webhook, err := h.service.CreateWebhook(ctx, userID, request.WorkspaceID, request.Body.Url, events, request.Body.Secret)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case isWebhookValidationError(err), errors.Is(err, service.ErrTooManyWebhooks):
+			return CreateWebhook400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return CreateWebhook403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWebhooksNotConfigured):
+			return CreateWebhook404Response{}, nil
+		}
+		return nil, err
+	}
+
+	// Секрет показывается только при создании: потом его нельзя прочитать, только заменить.
+	response := webhookToResponse(webhook)
+	response.Secret = &webhook.Secret
+	return CreateWebhook201JSONResponse(response), nil
+}
+
+func (h *handler) GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	webhook, err := h.service.GetWebhook(ctx, userID, request.WorkspaceID, request.WebhookID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return GetWebhook403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWebhookNotFound):
+			return GetWebhook404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return GetWebhook200JSONResponse(webhookToResponse(webhook)), nil
+}
+
+func (h *handler) UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	update := domain.WebhookUpdate{
+		URL:     request.Body.Url,
+		Secret:  request.Body.Secret,
+		Events:  webhookEventsFromRequest(request.Body.Events),
+		Enabled: request.Body.Enabled,
+	}
+	webhook, err := h.service.UpdateWebhook(ctx, userID, request.WorkspaceID, request.WebhookID, update)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case isWebhookValidationError(err):
+			return UpdateWebhook400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return UpdateWebhook403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound),
+			errors.Is(err, service.ErrWebhookNotFound),
+			errors.Is(err, service.ErrWebhooksNotConfigured):
+			return UpdateWebhook404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return UpdateWebhook200JSONResponse(webhookToResponse(webhook)), nil
+}
+
+func (h *handler) DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.DeleteWebhook(ctx, userID, request.WorkspaceID, request.WebhookID); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return DeleteWebhook403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWebhookNotFound):
+			return DeleteWebhook404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return DeleteWebhook204Response{}, nil
+}
+
+func (h *handler) ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	page, size := pageParams(request.Params.Page, request.Params.Size)
+	deliveries, err := h.service.ListWebhookDeliveries(ctx, userID, request.WorkspaceID, request.WebhookID, page, size)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return ListWebhookDeliveries403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWebhookNotFound):
+			return ListWebhookDeliveries404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListWebhookDeliveries200JSONResponse, len(deliveries))
+	for i := range deliveries {
+		response[i] = webhookDeliveryToResponse(&deliveries[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) RedeliverWebhook(ctx context.Context, request RedeliverWebhookRequestObject) (RedeliverWebhookResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	delivery, err := h.service.RedeliverWebhook(ctx, userID, request.WorkspaceID, request.WebhookID, request.DeliveryID)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return RedeliverWebhook403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound),
+			errors.Is(err, service.ErrWebhookNotFound),
+			errors.Is(err, service.ErrWebhookDeliveryNotFound):
+			return RedeliverWebhook404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return RedeliverWebhook202JSONResponse(webhookDeliveryToResponse(delivery)), nil
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"backend/internal/domain"
+	"backend/internal/service"
+	"context"
+	"errors"
+
+	"github.com/go-chi/jwtauth/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func workspaceToResponse(w *domain.Workspace) Workspace {
+	return Workspace{
+		Id:        w.ID,
+		Name:      w.Name,
+		Personal:  w.Personal,
+		Role:      WorkspaceRole(w.Role),
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func workspaceMemberToResponse(m *domain.WorkspaceMember) WorkspaceMember {
+	return WorkspaceMember{
+		UserID:    m.UserID,
+		Email:     openapi_types.Email(m.Email),
+		Role:      WorkspaceRole(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (h *handler) ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	workspaces, err := h.service.ListWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(ListWorkspaces200JSONResponse, len(workspaces))
+	for i := range workspaces {
+		response[i] = workspaceToResponse(&workspaces[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	workspace, err := h.service.CreateWorkspace(ctx, userID, request.Body.Name)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNameRequired) {
+			errorMessage := err.Error()
+			return CreateWorkspace400JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return CreateWorkspace201JSONResponse(workspaceToResponse(workspace)), nil
+}
+
+func (h *handler) GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	workspace, err := h.service.GetWorkspace(ctx, userID, request.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNotFound) {
+			return GetWorkspace404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return GetWorkspace200JSONResponse(workspaceToResponse(workspace)), nil
+}
+
+func (h *handler) RenameWorkspace(ctx context.Context, request RenameWorkspaceRequestObject) (RenameWorkspaceResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	workspace, err := h.service.RenameWorkspace(ctx, userID, request.WorkspaceID, request.Body.Name)
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceNameRequired):
+			return RenameWorkspace400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden):
+			return RenameWorkspace403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound):
+			return RenameWorkspace404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return RenameWorkspace200JSONResponse(workspaceToResponse(workspace)), nil
+}
+
+func (h *handler) DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.DeleteWorkspace(ctx, userID, request.WorkspaceID); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrWorkspaceForbidden), errors.Is(err, service.ErrPersonalWorkspace):
+			return DeleteWorkspace403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound):
+			return DeleteWorkspace404Response{}, nil
+		}
+		return nil, err
+	}
+
+	return DeleteWorkspace204Response{}, nil
+}
+
+func (h *handler) ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	members, err := h.service.ListWorkspaceMembers(ctx, userID, request.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, service.ErrWorkspaceNotFound) {
+			return ListWorkspaceMembers404Response{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(ListWorkspaceMembers200JSONResponse, len(members))
+	for i := range members {
+		response[i] = workspaceMemberToResponse(&members[i])
+	}
+
+	return response, nil
+}
+
+func (h *handler) AddWorkspaceMember(ctx context.Context, request AddWorkspaceMemberRequestObject) (AddWorkspaceMemberResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	member, err := h.service.AddWorkspaceMember(ctx, userID, request.WorkspaceID, string(request.Body.Email), string(request.Body.Role))
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidWorkspaceRole), errors.Is(err, service.ErrLastWorkspaceOwner):
+			return AddWorkspaceMember400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden), errors.Is(err, service.ErrPersonalWorkspace):
+			return AddWorkspaceMember403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrUserNotFound):
+			return AddWorkspaceMember404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return AddWorkspaceMember201JSONResponse(workspaceMemberToResponse(member)), nil
+}
+
+func (h *handler) UpdateWorkspaceMember(ctx context.Context, request UpdateWorkspaceMemberRequestObject) (UpdateWorkspaceMemberResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	member, err := h.service.UpdateWorkspaceMemberRole(ctx, userID, request.WorkspaceID, request.UserID, string(request.Body.Role))
+	if err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidWorkspaceRole), errors.Is(err, service.ErrLastWorkspaceOwner):
+			return UpdateWorkspaceMember400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden), errors.Is(err, service.ErrPersonalWorkspace):
+			return UpdateWorkspaceMember403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWorkspaceMemberNotFound):
+			return UpdateWorkspaceMember404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return UpdateWorkspaceMember200JSONResponse(workspaceMemberToResponse(member)), nil
+}
+
+func (h *handler) RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error) {
+	_, claims, _ := jwtauth.FromContext(ctx)
+	userID := int64(claims["user_id"].(float64))
+
+	if err := h.service.RemoveWorkspaceMember(ctx, userID, request.WorkspaceID, request.UserID); err != nil {
+		errorMessage := err.Error()
+		switch {
+		case errors.Is(err, service.ErrLastWorkspaceOwner):
+			return RemoveWorkspaceMember400JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceForbidden), errors.Is(err, service.ErrPersonalWorkspace):
+			return RemoveWorkspaceMember403JSONResponse{Error: &errorMessage}, nil
+		case errors.Is(err, service.ErrWorkspaceNotFound), errors.Is(err, service.ErrWorkspaceMemberNotFound):
+			return RemoveWorkspaceMember404JSONResponse{Error: &errorMessage}, nil
+		}
+		return nil, err
+	}
+
+	return RemoveWorkspaceMember204Response{}, nil
+}
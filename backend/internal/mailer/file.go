@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFile(dir, from string) (Mailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail dir '%s': %w", dir, err)
+	}
+	return &fileMailer{dir: dir, from: from}, nil
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg Message) error {
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), msg.To)
+	path := filepath.Join(m.dir, filepath.Base(name))
+
+	if err := os.WriteFile(path, buildRFC822(m.from, msg.To, msg.Subject, msg.Body), 0o644); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type logMailer struct {
+	log *zerolog.Logger
+}
+
+func NewLog(log *zerolog.Logger) Mailer {
+	return &logMailer{log: log}
+}
+
+func (m *logMailer) Send(ctx context.Context, msg Message) error {
+	m.log.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Msg("Mail (log driver)")
+	return nil
+}
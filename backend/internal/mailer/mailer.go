@@ -0,0 +1,48 @@
+package mailer
+
+import (
+	"backend/internal/config"
+	"bytes"
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+func New(cfg *config.MailConfig, log *zerolog.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTP(cfg.SMTP, cfg.From), nil
+	case "file":
+		return NewFile(cfg.Dir, cfg.From)
+	case "log", "":
+		return NewLog(log), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver: '%s'", cfg.Driver)
+	}
+}
+
+func buildRFC822(from, to, subject, body string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", (&mail.Address{Address: from}).String())
+	fmt.Fprintf(&b, "To: %s\r\n", (&mail.Address{Address: to}).String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(body)
+	return b.Bytes()
+}
@@ -0,0 +1,41 @@
+package mailer
+
+import (
+	"backend/internal/config"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTP(cfg *config.SMTPConfig, from string) Mailer {
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return &smtpMailer{
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body := buildRFC822(m.from, msg.To, msg.Subject, msg.Body)
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, body); err != nil {
+		return fmt.Errorf("failed to send mail via smtp: %w", err)
+	}
+	return nil
+}
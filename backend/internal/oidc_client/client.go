@@ -0,0 +1,112 @@
+package oidc_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+var ErrNonceMismatch = errors.New("id token nonce mismatch")
+
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	// AuthTime — время, когда пользователь последний раз вводил учетные данные у провайдера.
+	AuthTime time.Time
+}
+
+type Client struct {
+	verifier     *oidc.IDTokenVerifier
+	oauth2Config oauth2.Config
+}
+
+func NewClient(ctx context.Context, issuer, clientID, clientSecret, redirectURL string, scopes []string) (*Client, error) {
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc provider '%s': %w", issuer, err)
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{"profile", "email"}
+	}
+
+	return &Client{
+		verifier: provider.Verifier(&oidc.Config{ClientID: clientID}),
+		oauth2Config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       append([]string{oidc.ScopeOpenID}, scopes...),
+		},
+	}, nil
+}
+
+// AuthCodeURL возвращает адрес страницы авторизации провайдера.
+// С reauth провайдер заново запрашивает учетные данные, даже если у пользователя уже есть сессия.
+func (c *Client) AuthCodeURL(state, nonce, verifier string, reauth bool) string {
+	opts := []oauth2.AuthCodeOption{
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(verifier),
+	}
+	if reauth {
+		opts = append(opts,
+			oauth2.SetAuthURLParam("prompt", "login"),
+			oauth2.SetAuthURLParam("max_age", "0"),
+		)
+	}
+	return c.oauth2Config.AuthCodeURL(state, opts...)
+}
+
+func (c *Client) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	token, err := c.oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	idToken, err := c.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, ErrNonceMismatch
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		AuthTime      int64  `json:"auth_time"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse id token claims: %w", err)
+	}
+
+	// auth_time необязателен, если его не запрашивали через max_age; тогда считаем временем входа выпуск токена.
+	authTime := idToken.IssuedAt
+	if claims.AuthTime > 0 {
+		authTime = time.Unix(claims.AuthTime, 0)
+	}
+
+	return &Identity{
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		AuthTime:      authTime,
+	}, nil
+}
+
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
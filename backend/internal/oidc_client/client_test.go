@@ -0,0 +1,302 @@
+package oidc_client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Тесты клиента работают с поддельным провайдером на httptest: он публикует discovery и ключи,
+// подписывает ID token и, как настоящий провайдер, сверяет code_verifier с code_challenge (PKCE).
+
+const (
+	testClientID = "test-client"
+	testCode     = "test-code"
+	testKeyID    = "test-key"
+)
+
+type fakeProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	// challenge — code_challenge из запроса авторизации, который провайдер запомнил для testCode.
+	challenge string
+	// claims — утверждения ID token, выдаваемого в обмен на testCode.
+	claims map[string]any
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &fakeProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                p.server.URL,
+			"authorization_endpoint":                p.server.URL + "/authorize",
+			"token_endpoint":                        p.server.URL + "/token",
+			"jwks_uri":                              p.server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": testKeyID,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("code") != testCode || pkceChallenge(r.PostForm.Get("code_verifier")) != p.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     p.sign(t, p.claims),
+		})
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// sign выпускает JWT с подписью RS256 ключом провайдера.
+func (p *fakeProvider) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": testKeyID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (p *fakeProvider) idTokenClaims(nonce string, issuedAt time.Time) map[string]any {
+	return map[string]any{
+		"iss":            p.server.URL,
+		"sub":            "user-1",
+		"aud":            testClientID,
+		"iat":            issuedAt.Unix(),
+		"exp":            issuedAt.Add(time.Hour).Unix(),
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func newTestClient(t *testing.T, p *fakeProvider) *Client {
+	t.Helper()
+
+	client, err := NewClient(context.Background(), p.server.URL, testClientID, "secret", "http://localhost/callback", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	provider := newFakeProvider(t)
+	client := newTestClient(t, provider)
+	verifier := GenerateVerifier()
+
+	tests := []struct {
+		name   string
+		reauth bool
+		want   map[string]string
+	}{
+		{
+			name: "login",
+			want: map[string]string{"prompt": "", "max_age": ""},
+		},
+		{
+			name:   "reauthentication",
+			reauth: true,
+			want:   map[string]string{"prompt": "login", "max_age": "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authURL, err := url.Parse(client.AuthCodeURL("state-1", "nonce-1", verifier, tt.reauth))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(authURL.String(), provider.server.URL+"/authorize?") {
+				t.Fatalf("auth URL %q does not point to the provider", authURL)
+			}
+
+			query := authURL.Query()
+			want := map[string]string{
+				"state":                 "state-1",
+				"nonce":                 "nonce-1",
+				"client_id":             testClientID,
+				"response_type":         "code",
+				"code_challenge":        pkceChallenge(verifier),
+				"code_challenge_method": "S256",
+			}
+			for name, value := range tt.want {
+				want[name] = value
+			}
+			for name, value := range want {
+				if got := query.Get(name); got != value {
+					t.Errorf("%s = %q, want %q", name, got, value)
+				}
+			}
+			if !strings.Contains(query.Get("scope"), "openid") {
+				t.Errorf("scope %q does not request openid", query.Get("scope"))
+			}
+			if query.Get("code_verifier") != "" {
+				t.Error("auth URL leaks the PKCE verifier")
+			}
+		})
+	}
+}
+
+func TestExchange(t *testing.T) {
+	issuedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	authTime := issuedAt.Add(-5 * time.Minute)
+
+	tests := []struct {
+		name         string
+		verifier     string
+		nonce        string
+		claims       func(claims map[string]any)
+		wantErr      error
+		wantAnyErr   bool
+		wantAuthTime time.Time
+	}{
+		{
+			name:         "valid login without auth_time",
+			nonce:        "nonce-1",
+			wantAuthTime: issuedAt,
+		},
+		{
+			name:  "valid login with auth_time",
+			nonce: "nonce-1",
+			claims: func(claims map[string]any) {
+				claims["auth_time"] = authTime.Unix()
+			},
+			wantAuthTime: authTime,
+		},
+		{
+			name:    "nonce mismatch",
+			nonce:   "another-nonce",
+			wantErr: ErrNonceMismatch,
+		},
+		{
+			name:       "wrong PKCE verifier",
+			verifier:   GenerateVerifier(),
+			nonce:      "nonce-1",
+			wantAnyErr: true,
+		},
+		{
+			name:  "token for another client",
+			nonce: "nonce-1",
+			claims: func(claims map[string]any) {
+				claims["aud"] = "another-client"
+			},
+			wantAnyErr: true,
+		},
+		{
+			name:  "expired token",
+			nonce: "nonce-1",
+			claims: func(claims map[string]any) {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newFakeProvider(t)
+			client := newTestClient(t, provider)
+
+			verifier := GenerateVerifier()
+			provider.challenge = pkceChallenge(verifier)
+			provider.claims = provider.idTokenClaims("nonce-1", issuedAt)
+			if tt.claims != nil {
+				tt.claims(provider.claims)
+			}
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+
+			identity, err := client.Exchange(context.Background(), testCode, verifier, tt.nonce)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Exchange error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.wantAnyErr:
+				if err == nil {
+					t.Fatal("Exchange succeeded, want error")
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if identity.Issuer != provider.server.URL || identity.Subject != "user-1" {
+				t.Errorf("identity = %s/%s, want %s/user-1", identity.Issuer, identity.Subject, provider.server.URL)
+			}
+			if identity.Email != "user@example.com" || !identity.EmailVerified {
+				t.Errorf("email = %q (verified %v), want verified user@example.com", identity.Email, identity.EmailVerified)
+			}
+			if !identity.AuthTime.Equal(tt.wantAuthTime) {
+				t.Errorf("AuthTime = %v, want %v", identity.AuthTime, tt.wantAuthTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"backend/internal/config"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type LimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("too many requests, retry after %d seconds", e.RetryAfterSeconds())
+}
+
+func (e *LimitError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+type Limiter struct {
+	store Store
+	cfg   *config.RateLimitConfig
+	log   *zerolog.Logger
+}
+
+func New(store Store, cfg *config.RateLimitConfig, log *zerolog.Logger) *Limiter {
+	return &Limiter{
+		store: store,
+		cfg:   cfg,
+		log:   log,
+	}
+}
+
+func (l *Limiter) Config() *config.RateLimitConfig {
+	return l.cfg
+}
+
+// Allow засчитывает попытку по ключу и возвращает *LimitError, если лимит правила превышен.
+// Правило с нулевым Limit отключено.
+func (l *Limiter) Allow(ctx context.Context, key string, rule config.RateLimitRule) error {
+	if rule.Limit <= 0 {
+		return nil
+	}
+
+	c, err := l.store.Incr(ctx, key, rule.Window)
+	if err != nil {
+		return err
+	}
+	if c.Count > int64(rule.Limit) {
+		return &LimitError{RetryAfter: time.Until(c.ExpiresAt)}
+	}
+	return nil
+}
+
+func (l *Limiter) CheckLockout(ctx context.Context, account string) error {
+	if l.cfg.Lockout.Threshold <= 0 {
+		return nil
+	}
+
+	c, err := l.store.Get(ctx, lockKey(account))
+	if err != nil {
+		return err
+	}
+	if c.Count > 0 {
+		return &LimitError{RetryAfter: time.Until(c.ExpiresAt)}
+	}
+	return nil
+}
+
+// RegisterLoginFailure засчитывает неудачный вход. Начиная с порога Threshold
+// аккаунт блокируется, и каждая следующая неудача удваивает срок блокировки.
+func (l *Limiter) RegisterLoginFailure(ctx context.Context, account string) error {
+	lockout := l.cfg.Lockout
+	if lockout.Threshold <= 0 {
+		return nil
+	}
+
+	c, err := l.store.Incr(ctx, failuresKey(account), lockout.Window)
+	if err != nil {
+		return err
+	}
+	if c.Count < int64(lockout.Threshold) {
+		return nil
+	}
+
+	duration := lockout.BaseDuration << min(c.Count-int64(lockout.Threshold), 30)
+	if duration <= 0 || duration > lockout.MaxDuration {
+		duration = lockout.MaxDuration
+	}
+
+	if _, err := l.store.Incr(ctx, lockKey(account), duration); err != nil {
+		return err
+	}
+
+	l.log.Warn().
+		Int64("failures", c.Count).
+		Dur("lock_duration", duration).
+		Msg("Account temporarily locked after failed logins")
+
+	return nil
+}
+
+func (l *Limiter) ResetLoginFailures(ctx context.Context, account string) error {
+	if err := l.store.Reset(ctx, failuresKey(account)); err != nil {
+		return err
+	}
+	return l.store.Reset(ctx, lockKey(account))
+}
+
+func (l *Limiter) RunCleanup(ctx context.Context) {
+	if l.cfg.CleanupInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(l.cfg.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.store.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				l.log.Err(err).Msg("failed to cleanup rate limit counters")
+			}
+		}
+	}
+}
+
+func IPKey(scope, ip string) string {
+	return "ip:" + scope + ":" + ip
+}
+
+func AccountKey(scope, account string) string {
+	return "account:" + scope + ":" + normalizeAccount(account)
+}
+
+func failuresKey(account string) string {
+	return "lockout:failures:" + normalizeAccount(account)
+}
+
+func lockKey(account string) string {
+	return "lockout:lock:" + normalizeAccount(account)
+}
+
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
@@ -0,0 +1,215 @@
+package ratelimit
+
+import (
+	"backend/internal/config"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func newTestLimiter(cfg *config.RateLimitConfig) *Limiter {
+	log := zerolog.Nop()
+	return New(NewMemoryStore(), cfg, &log)
+}
+
+func TestAllow(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     config.RateLimitRule
+		attempts int
+		// wantLimited — номера попыток (с 1), которые должны быть отклонены.
+		wantLimited map[int]bool
+	}{
+		{
+			name:        "under limit",
+			rule:        config.RateLimitRule{Limit: 3, Window: time.Minute},
+			attempts:    3,
+			wantLimited: map[int]bool{},
+		},
+		{
+			name:        "over limit",
+			rule:        config.RateLimitRule{Limit: 2, Window: time.Minute},
+			attempts:    4,
+			wantLimited: map[int]bool{3: true, 4: true},
+		},
+		{
+			name:        "disabled rule",
+			rule:        config.RateLimitRule{Limit: 0, Window: time.Minute},
+			attempts:    10,
+			wantLimited: map[int]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newTestLimiter(&config.RateLimitConfig{})
+			ctx := context.Background()
+
+			for attempt := 1; attempt <= tt.attempts; attempt++ {
+				err := limiter.Allow(ctx, IPKey("login", "192.0.2.1"), tt.rule)
+				var limitErr *LimitError
+				limited := errors.As(err, &limitErr)
+				if err != nil && !limited {
+					t.Fatal(err)
+				}
+				if limited != tt.wantLimited[attempt] {
+					t.Fatalf("attempt %d limited = %v, want %v", attempt, limited, tt.wantLimited[attempt])
+				}
+				if limited && (limitErr.RetryAfterSeconds() < 1 || limitErr.RetryAfter > tt.rule.Window) {
+					t.Errorf("attempt %d RetryAfter = %v, want within (0, %v]", attempt, limitErr.RetryAfter, tt.rule.Window)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowKeysAreIndependent(t *testing.T) {
+	limiter := newTestLimiter(&config.RateLimitConfig{})
+	ctx := context.Background()
+	rule := config.RateLimitRule{Limit: 1, Window: time.Minute}
+
+	if err := limiter.Allow(ctx, IPKey("login", "192.0.2.1"), rule); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		wantLimited bool
+	}{
+		{name: "same key", key: IPKey("login", "192.0.2.1"), wantLimited: true},
+		{name: "other IP", key: IPKey("login", "192.0.2.2"), wantLimited: false},
+		{name: "other scope", key: IPKey("register", "192.0.2.1"), wantLimited: false},
+		{name: "account key", key: AccountKey("login", "192.0.2.1"), wantLimited: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := limiter.Allow(ctx, tt.key, rule)
+			var limitErr *LimitError
+			if limited := errors.As(err, &limitErr); limited != tt.wantLimited {
+				t.Errorf("limited = %v, want %v (err %v)", limited, tt.wantLimited, err)
+			}
+		})
+	}
+}
+
+func TestAllowWindowExpires(t *testing.T) {
+	limiter := newTestLimiter(&config.RateLimitConfig{})
+	ctx := context.Background()
+	rule := config.RateLimitRule{Limit: 1, Window: 20 * time.Millisecond}
+	key := AccountKey("verify_email", "42")
+
+	if err := limiter.Allow(ctx, key, rule); err != nil {
+		t.Fatal(err)
+	}
+	if err := limiter.Allow(ctx, key, rule); err == nil {
+		t.Fatal("second attempt in the window is allowed")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if err := limiter.Allow(ctx, key, rule); err != nil {
+		t.Fatalf("attempt after the window = %v, want allowed", err)
+	}
+}
+
+func TestAccountKeyNormalizesAccount(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"user@example.com", "User@Example.com"},
+		{"user@example.com", "  user@example.com "},
+	}
+
+	for _, tt := range tests {
+		if AccountKey("login", tt.a) != AccountKey("login", tt.b) {
+			t.Errorf("AccountKey(%q) != AccountKey(%q)", tt.a, tt.b)
+		}
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	const account = "User@Example.com"
+
+	tests := []struct {
+		name     string
+		lockout  config.LockoutConfig
+		failures int
+		// wantLock — ожидаемый срок блокировки после failures неудач; 0 — аккаунт не заблокирован.
+		wantLock time.Duration
+	}{
+		{
+			name:     "below threshold",
+			lockout:  config.LockoutConfig{Threshold: 3, Window: time.Hour, BaseDuration: time.Minute, MaxDuration: time.Hour},
+			failures: 2,
+		},
+		{
+			name:     "at threshold",
+			lockout:  config.LockoutConfig{Threshold: 3, Window: time.Hour, BaseDuration: time.Minute, MaxDuration: time.Hour},
+			failures: 3,
+			wantLock: time.Minute,
+		},
+		{
+			name:     "doubles after threshold",
+			lockout:  config.LockoutConfig{Threshold: 3, Window: time.Hour, BaseDuration: time.Minute, MaxDuration: time.Hour},
+			failures: 5,
+			wantLock: 4 * time.Minute,
+		},
+		{
+			name:     "capped by max duration",
+			lockout:  config.LockoutConfig{Threshold: 1, Window: time.Hour, BaseDuration: time.Minute, MaxDuration: 5 * time.Minute},
+			failures: 40,
+			wantLock: 5 * time.Minute,
+		},
+		{
+			name:     "disabled",
+			lockout:  config.LockoutConfig{Threshold: 0},
+			failures: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newTestLimiter(&config.RateLimitConfig{Lockout: tt.lockout})
+			ctx := context.Background()
+
+			for range tt.failures {
+				// Вход проверяет блокировку до пароля, поэтому следующая неудача засчитывается
+				// только после того, как предыдущая блокировка истекла.
+				if err := limiter.store.Reset(ctx, lockKey(account)); err != nil {
+					t.Fatal(err)
+				}
+				if err := limiter.RegisterLoginFailure(ctx, account); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// Блокировка общая для разного написания адреса.
+			err := limiter.CheckLockout(ctx, "user@example.com")
+			var limitErr *LimitError
+			locked := errors.As(err, &limitErr)
+			if err != nil && !locked {
+				t.Fatal(err)
+			}
+			if locked != (tt.wantLock > 0) {
+				t.Fatalf("locked = %v, want %v", locked, tt.wantLock > 0)
+			}
+			if !locked {
+				return
+			}
+			if limitErr.RetryAfter > tt.wantLock || limitErr.RetryAfter < tt.wantLock-time.Second {
+				t.Errorf("RetryAfter = %v, want about %v", limitErr.RetryAfter, tt.wantLock)
+			}
+
+			if err := limiter.ResetLoginFailures(ctx, account); err != nil {
+				t.Fatal(err)
+			}
+			if err := limiter.CheckLockout(ctx, account); err != nil {
+				t.Errorf("CheckLockout after reset = %v, want nil", err)
+			}
+		})
+	}
+}
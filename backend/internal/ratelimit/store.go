@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type Store interface {
+	Incr(ctx context.Context, key string, window time.Duration) (*domain.RateLimitCounter, error)
+	Get(ctx context.Context, key string) (*domain.RateLimitCounter, error)
+	Reset(ctx context.Context, key string) error
+	Cleanup(ctx context.Context) error
+}
+
+func NewStore(cfg *config.RateLimitConfig, repo repository.RateLimitRepository) (Store, error) {
+	switch cfg.Store {
+	case "postgres":
+		return NewPostgresStore(repo), nil
+	case "memory", "":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store: '%s'", cfg.Store)
+	}
+}
+
+type memoryStore struct {
+	mu       sync.Mutex
+	counters map[string]domain.RateLimitCounter
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{counters: make(map[string]domain.RateLimitCounter)}
+}
+
+func (s *memoryStore) Incr(ctx context.Context, key string, window time.Duration) (*domain.RateLimitCounter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	c, ok := s.counters[key]
+	if !ok || !c.ExpiresAt.After(now) {
+		c = domain.RateLimitCounter{ExpiresAt: now.Add(window)}
+	}
+	c.Count++
+	s.counters[key] = c
+
+	return &c, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (*domain.RateLimitCounter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if !ok || !c.ExpiresAt.After(time.Now()) {
+		return &domain.RateLimitCounter{}, nil
+	}
+	return &c, nil
+}
+
+func (s *memoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.counters, key)
+	return nil
+}
+
+func (s *memoryStore) Cleanup(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, c := range s.counters {
+		if !c.ExpiresAt.After(now) {
+			delete(s.counters, key)
+		}
+	}
+	return nil
+}
+
+type postgresStore struct {
+	repo repository.RateLimitRepository
+}
+
+func NewPostgresStore(repo repository.RateLimitRepository) Store {
+	return &postgresStore{repo: repo}
+}
+
+func (s *postgresStore) Incr(ctx context.Context, key string, window time.Duration) (*domain.RateLimitCounter, error) {
+	return s.repo.IncrRateLimit(ctx, key, time.Now().Add(window))
+}
+
+func (s *postgresStore) Get(ctx context.Context, key string) (*domain.RateLimitCounter, error) {
+	c, err := s.repo.GetRateLimit(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.RateLimitCounter{}, nil
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *postgresStore) Reset(ctx context.Context, key string) error {
+	return s.repo.DeleteRateLimit(ctx, key)
+}
+
+func (s *postgresStore) Cleanup(ctx context.Context) error {
+	return s.repo.DeleteExpiredRateLimits(ctx)
+}
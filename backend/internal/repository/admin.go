@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+)
+
+type AdminRepository interface {
+	ListUsersWithStats(ctx context.Context, page, size int64) ([]domain.UserWithStats, error)
+	CountUsers(ctx context.Context) (int64, error)
+	GetUserWithStats(ctx context.Context, id int64) (*domain.UserWithStats, error)
+	DisableUser(ctx context.Context, id int64) (bool, error)
+	EnableUser(ctx context.Context, id int64) (bool, error)
+	SetUserRoleByEmail(ctx context.Context, email, role string) (bool, error)
+}
+
+func userWithStatsToDomain(u queries.ListUsersWithStatsRow) *domain.UserWithStats {
+	return &domain.UserWithStats{
+		User: domain.User{
+			ID:            u.ID,
+			Email:         u.Email,
+			EmailVerified: u.EmailVerified,
+			Role:          u.Role,
+			Disabled:      u.DisabledAt.Valid,
+		},
+		DocumentsCount: u.DocumentsCount,
+		ChunksCount:    u.ChunksCount,
+	}
+}
+
+func (p *postgres) ListUsersWithStats(ctx context.Context, page, size int64) ([]domain.UserWithStats, error) {
+	offset, limit := calcOffsetLimit(page, size)
+	users, err := p.q.ListUsersWithStats(ctx, queries.ListUsersWithStatsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainUsers := make([]domain.UserWithStats, len(users))
+	for i, u := range users {
+		domainUsers[i] = *userWithStatsToDomain(u)
+	}
+
+	return domainUsers, nil
+}
+
+func (p *postgres) CountUsers(ctx context.Context) (int64, error) {
+	return p.q.CountUsers(ctx)
+}
+
+func (p *postgres) GetUserWithStats(ctx context.Context, id int64) (*domain.UserWithStats, error) {
+	u, err := p.q.GetUserWithStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return userWithStatsToDomain(queries.ListUsersWithStatsRow(u)), nil
+}
+
+func (p *postgres) DisableUser(ctx context.Context, id int64) (bool, error) {
+	rows, err := p.q.DisableUser(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) EnableUser(ctx context.Context, id int64) (bool, error) {
+	rows, err := p.q.EnableUser(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) SetUserRoleByEmail(ctx context.Context, email, role string) (bool, error) {
+	rows, err := p.q.SetUserRoleByEmail(ctx, queries.SetUserRoleByEmailParams{
+		Email: email,
+		Role:  role,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AuditRepository interface {
+	CreateAuditEvent(ctx context.Context, event domain.AuditEvent) error
+}
+
+func (p *postgres) CreateAuditEvent(ctx context.Context, event domain.AuditEvent) error {
+	details := event.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+
+	return p.q.CreateAuditEvent(ctx, queries.CreateAuditEventParams{
+		UserID:  pgtype.Int8{Int64: event.UserID, Valid: event.UserID != 0},
+		Action:  event.Action,
+		Details: detailsJSON,
+	})
+}
@@ -14,6 +14,10 @@ type AuthRepository interface {
 	GetRefreshByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
 	DeleteRefreshToken(ctx context.Context, tokenHash string) error
 	DeleteAllUserRefreshTokens(ctx context.Context, userID int64) error
+
+	CreateUserToken(ctx context.Context, userID int64, purpose domain.UserTokenPurpose, tokenHash string, expiresAt time.Time) error
+	ConsumeUserToken(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error)
+	DeleteUserTokens(ctx context.Context, userID int64, purpose domain.UserTokenPurpose) error
 }
 
 func refreshTokenToDomain(t queries.RefreshToken) *domain.RefreshToken {
@@ -25,6 +29,16 @@ func refreshTokenToDomain(t queries.RefreshToken) *domain.RefreshToken {
 	}
 }
 
+func userTokenToDomain(t queries.UserToken) *domain.UserToken {
+	return &domain.UserToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   domain.UserTokenPurpose(t.Purpose),
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt.Time,
+	}
+}
+
 func (p *postgres) CreateRefreshToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
 	return p.q.CreateRefreshToken(ctx, queries.CreateRefreshTokenParams{
 		UserID:    userID,
@@ -48,3 +62,30 @@ func (p *postgres) DeleteRefreshToken(ctx context.Context, tokenHash string) err
 func (p *postgres) DeleteAllUserRefreshTokens(ctx context.Context, userID int64) error {
 	return p.q.DeleteAllUserRefreshTokens(ctx, userID)
 }
+
+func (p *postgres) CreateUserToken(ctx context.Context, userID int64, purpose domain.UserTokenPurpose, tokenHash string, expiresAt time.Time) error {
+	return p.q.CreateUserToken(ctx, queries.CreateUserTokenParams{
+		UserID:    userID,
+		Purpose:   string(purpose),
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+}
+
+func (p *postgres) ConsumeUserToken(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	t, err := p.q.ConsumeUserToken(ctx, queries.ConsumeUserTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   string(purpose),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userTokenToDomain(t), nil
+}
+
+func (p *postgres) DeleteUserTokens(ctx context.Context, userID int64, purpose domain.UserTokenPurpose) error {
+	return p.q.DeleteUserTokens(ctx, queries.DeleteUserTokensParams{
+		UserID:  userID,
+		Purpose: string(purpose),
+	})
+}
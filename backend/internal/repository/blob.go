@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+)
+
+type BlobRepository interface {
+	CreateBlob(ctx context.Context, blob domain.Blob) (*domain.Blob, error)
+	GetBlobByID(ctx context.Context, id int64) (*domain.Blob, error)
+	GetWorkspaceBlobIDs(ctx context.Context, workspaceID int64) ([]int64, error)
+	GetDocumentsBlobIDs(ctx context.Context, documentIDs []int64) ([]int64, error)
+	DeleteOrphanBlobs(ctx context.Context, ids []int64) ([]string, error)
+}
+
+func blobToDomain(b queries.Blob) *domain.Blob {
+	return &domain.Blob{
+		ID:          b.ID,
+		StorageKey:  b.StorageKey,
+		SHA256:      b.Sha256,
+		SizeBytes:   b.SizeBytes,
+		ContentType: b.ContentType,
+		CreatedAt:   b.CreatedAt.Time,
+	}
+}
+
+func (p *postgres) CreateBlob(ctx context.Context, blob domain.Blob) (*domain.Blob, error) {
+	b, err := p.q.CreateBlob(ctx, queries.CreateBlobParams{
+		StorageKey:  blob.StorageKey,
+		Sha256:      blob.SHA256,
+		SizeBytes:   blob.SizeBytes,
+		ContentType: blob.ContentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blobToDomain(b), nil
+}
+
+func (p *postgres) GetBlobByID(ctx context.Context, id int64) (*domain.Blob, error) {
+	b, err := p.q.GetBlobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return blobToDomain(b), nil
+}
+
+func (p *postgres) GetWorkspaceBlobIDs(ctx context.Context, workspaceID int64) ([]int64, error) {
+	return p.q.GetWorkspaceBlobIDs(ctx, workspaceID)
+}
+
+func (p *postgres) GetDocumentsBlobIDs(ctx context.Context, documentIDs []int64) ([]int64, error) {
+	if len(documentIDs) == 0 {
+		return nil, nil
+	}
+	return p.q.GetDocumentsBlobIDs(ctx, documentIDs)
+}
+
+func (p *postgres) DeleteOrphanBlobs(ctx context.Context, ids []int64) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return p.q.DeleteOrphanBlobs(ctx, ids)
+}
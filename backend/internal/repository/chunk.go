@@ -10,27 +10,44 @@ import (
 )
 
 type ChunkRepository interface {
-	CreateChunk(ctx context.Context, userID, documentID int64, title, chunkText string) (*domain.Chunk, error)
+	CreateChunk(ctx context.Context, userID, workspaceID, documentID int64, version int32, title, chunkText string) (*domain.Chunk, error)
+	CreateChunks(ctx context.Context, userID, workspaceID, documentID int64, version int32, title string, texts []domain.ChunkText) ([]domain.Chunk, error)
+	CopyVersionChunks(ctx context.Context, userID, documentID int64, fromVersion, toVersion int32) ([]domain.Chunk, error)
+	GetVersionChunkTexts(ctx context.Context, documentID int64, version int32) ([]string, error)
+	GetUnembeddedVersionChunkIDs(ctx context.Context, documentID int64, version int32) ([]int64, error)
 	GetChunksByDocumentID(ctx context.Context, documentID, userID int64) ([]domain.Chunk, error)
-	SearchUserChunks(ctx context.Context, userID int64, embedding []float32, limit int32) ([]domain.SearchResult, error)
+	GetChunksByDocumentIDPage(ctx context.Context, documentID, userID, page, size int64) ([]domain.Chunk, error)
+	SearchUserChunks(ctx context.Context, userID int64, workspaceID *int64, folderIDs []int64, filter domain.SearchFilter, embedding []float32, limit int32) ([]domain.SearchResult, error)
 	SearchChunksInDocument(ctx context.Context, userID, documentID int64, embedding []float32, limit int32) ([]domain.SearchResult, error)
+	GetDocumentChunks(ctx context.Context, documentID int64) ([]domain.Chunk, error)
+	DequeueChunkEmbeddings(ctx context.Context, chunkIDs []int64) error
+	SearchDocumentChunks(ctx context.Context, documentID int64, embedding []float32, limit int32) ([]domain.SearchResult, error)
 }
 
-func chunkToDomain(c queries.Chunk) *domain.Chunk {
+// chunkToDomain принимает строку GetChunksByDocumentID; строки остальных
+// запросов на чтение чанков имеют ту же форму и приводятся к ней.
+func chunkToDomain(c queries.GetChunksByDocumentIDRow) *domain.Chunk {
 	return &domain.Chunk{
-		ID:         c.ID,
-		UserID:     c.UserID,
-		DocumentID: c.DocumentID,
-		Text:       c.Text,
+		ID:           c.ID,
+		UserID:       int8Ptr(c.UserID),
+		WorkspaceID:  c.WorkspaceID,
+		DocumentID:   c.DocumentID,
+		Title:        c.Title,
+		Text:         c.Text,
+		OverlapBytes: int4Ptr(c.OverlapBytes),
+		Embedded:     c.Embedded,
 	}
 }
 
 func chunkRowToDomain(c queries.CreateChunkRow) *domain.Chunk {
 	return &domain.Chunk{
-		ID:         c.ID,
-		UserID:     c.UserID,
-		DocumentID: c.DocumentID,
-		Text:       c.Text,
+		ID:          c.ID,
+		UserID:      int8Ptr(c.UserID),
+		WorkspaceID: c.WorkspaceID,
+		DocumentID:  c.DocumentID,
+		Title:       c.Title,
+		Text:        c.Text,
+		Embedded:    c.Embedded,
 	}
 }
 
@@ -44,12 +61,14 @@ func searchResultToDomain(c queries.SearchUserChunksRow) *domain.SearchResult {
 	}
 }
 
-func (p *postgres) CreateChunk(ctx context.Context, userID, documentID int64, title, text string) (*domain.Chunk, error) {
+func (p *postgres) CreateChunk(ctx context.Context, userID, workspaceID, documentID int64, version int32, title, text string) (*domain.Chunk, error) {
 	c, err := p.q.CreateChunk(ctx, queries.CreateChunkParams{
-		UserID:     userID,
-		DocumentID: documentID,
-		Title:      title,
-		Text:       text,
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		DocumentID:  documentID,
+		Version:     version,
+		Title:       title,
+		Text:        text,
 	})
 	if err != nil {
 		return nil, err
@@ -57,6 +76,94 @@ func (p *postgres) CreateChunk(ctx context.Context, userID, documentID int64, ti
 	return chunkRowToDomain(c), nil
 }
 
+func (p *postgres) CreateChunks(ctx context.Context, userID, workspaceID, documentID int64, version int32, title string, texts []domain.ChunkText) ([]domain.Chunk, error) {
+	params := queries.CreateChunksParams{
+		UserID:       userID,
+		WorkspaceID:  workspaceID,
+		DocumentID:   documentID,
+		Version:      version,
+		Title:        title,
+		Texts:        make([]string, len(texts)),
+		OverlapBytes: make([]int32, len(texts)),
+	}
+	for i, t := range texts {
+		params.Texts[i] = t.Text
+		params.OverlapBytes[i] = t.OverlapBytes
+	}
+
+	rows, err := p.q.CreateChunks(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != len(texts) {
+		return nil, fmt.Errorf("created %d chunks out of %d", len(rows), len(texts))
+	}
+
+	// Порядок строк RETURNING не гарантирован, поэтому строки сопоставляются с входными чанками
+	// по тексту и перекрытию. Одинаковые чанки неотличимы, и любое их сопоставление верно.
+	pending := make(map[domain.ChunkText][]int, len(texts))
+	for i, t := range texts {
+		pending[t] = append(pending[t], i)
+	}
+
+	chunks := make([]domain.Chunk, len(texts))
+	for _, r := range rows {
+		key := domain.ChunkText{Text: r.Text, OverlapBytes: r.OverlapBytes.Int32}
+		indexes := pending[key]
+		if len(indexes) == 0 {
+			return nil, fmt.Errorf("created chunk %d does not match any input chunk", r.ID)
+		}
+		i := indexes[0]
+		pending[key] = indexes[1:]
+
+		chunks[i] = domain.Chunk{
+			ID:           r.ID,
+			UserID:       &userID,
+			WorkspaceID:  workspaceID,
+			DocumentID:   documentID,
+			Title:        title,
+			Text:         r.Text,
+			OverlapBytes: int4Ptr(r.OverlapBytes),
+			Embedded:     r.Embedded,
+		}
+	}
+
+	return chunks, nil
+}
+
+func (p *postgres) CopyVersionChunks(ctx context.Context, userID, documentID int64, fromVersion, toVersion int32) ([]domain.Chunk, error) {
+	rows, err := p.q.CopyVersionChunks(ctx, queries.CopyVersionChunksParams{
+		UserID:      userID,
+		DocumentID:  documentID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]domain.Chunk, len(rows))
+	for i, r := range rows {
+		chunks[i] = domain.Chunk{ID: r.ID, UserID: &userID, DocumentID: documentID, Embedded: r.Embedded}
+	}
+
+	return chunks, nil
+}
+
+func (p *postgres) GetVersionChunkTexts(ctx context.Context, documentID int64, version int32) ([]string, error) {
+	return p.q.GetVersionChunkTexts(ctx, queries.GetVersionChunkTextsParams{
+		DocumentID: documentID,
+		Version:    version,
+	})
+}
+
+func (p *postgres) GetUnembeddedVersionChunkIDs(ctx context.Context, documentID int64, version int32) ([]int64, error) {
+	return p.q.GetUnembeddedVersionChunkIDs(ctx, queries.GetUnembeddedVersionChunkIDsParams{
+		DocumentID: documentID,
+		Version:    version,
+	})
+}
+
 func (p *postgres) GetChunksByDocumentID(ctx context.Context, documentID, userID int64) ([]domain.Chunk, error) {
 	chunks, err := p.q.GetChunksByDocumentID(ctx, queries.GetChunksByDocumentIDParams{
 		DocumentID: documentID,
@@ -74,12 +181,41 @@ func (p *postgres) GetChunksByDocumentID(ctx context.Context, documentID, userID
 	return domainChunks, nil
 }
 
-func (p *postgres) SearchUserChunks(ctx context.Context, userID int64, embedding []float32, limit int32) ([]domain.SearchResult, error) {
+func (p *postgres) GetChunksByDocumentIDPage(ctx context.Context, documentID, userID, page, size int64) ([]domain.Chunk, error) {
+	offset, limit := calcOffsetLimit(page, size)
+	chunks, err := p.q.GetChunksByDocumentIDPage(ctx, queries.GetChunksByDocumentIDPageParams{
+		DocumentID:  documentID,
+		UserID:      userID,
+		OffsetCount: offset,
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainChunks := make([]domain.Chunk, len(chunks))
+	for i, c := range chunks {
+		domainChunks[i] = *chunkToDomain(queries.GetChunksByDocumentIDRow(c))
+	}
+
+	return domainChunks, nil
+}
+
+func (p *postgres) SearchUserChunks(ctx context.Context, userID int64, workspaceID *int64, folderIDs []int64, filter domain.SearchFilter, embedding []float32, limit int32) ([]domain.SearchResult, error) {
+	metadata, err := optionalMetadata(filter.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	queryVector := pgvector.NewVector(embedding)
 	results, err := p.q.SearchUserChunks(ctx, queries.SearchUserChunksParams{
-		UserID:    userID,
-		Embedding: queryVector,
-		Limit:     limit,
+		UserID:      userID,
+		WorkspaceID: optionalInt8(workspaceID),
+		Tags:        filter.Tags,
+		Metadata:    metadata,
+		FolderIds:   folderIDs,
+		Embedding:   queryVector,
+		LimitCount:  limit,
 	})
 	if err != nil {
 		return nil, err
@@ -127,3 +263,50 @@ func (p *postgres) SearchChunksInDocument(ctx context.Context, userID, documentI
 
 	return domainResults, nil
 }
+
+func (p *postgres) GetDocumentChunks(ctx context.Context, documentID int64) ([]domain.Chunk, error) {
+	chunks, err := p.q.GetDocumentChunks(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainChunks := make([]domain.Chunk, len(chunks))
+	for i, c := range chunks {
+		domainChunks[i] = *chunkToDomain(queries.GetChunksByDocumentIDRow(c))
+	}
+
+	return domainChunks, nil
+}
+
+func (p *postgres) SearchDocumentChunks(ctx context.Context, documentID int64, embedding []float32, limit int32) ([]domain.SearchResult, error) {
+	queryVector := pgvector.NewVector(embedding)
+	results, err := p.q.SearchDocumentChunks(ctx, queries.SearchDocumentChunksParams{
+		Embedding:  queryVector,
+		DocumentID: documentID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainResults := make([]domain.SearchResult, len(results))
+	for i, r := range results {
+		distance, ok := r.Distance.(float64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for distance: %T", r.Distance)
+		}
+
+		domainResults[i] = domain.SearchResult{
+			ID:         r.ID,
+			DocumentID: r.DocumentID,
+			Text:       r.Text,
+			Distance:   distance,
+		}
+	}
+
+	return domainResults, nil
+}
+
+func (p *postgres) DequeueChunkEmbeddings(ctx context.Context, chunkIDs []int64) error {
+	return p.q.DequeueChunkEmbeddings(ctx, chunkIDs)
+}
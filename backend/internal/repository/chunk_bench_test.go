@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// Бенчмарки сохранения чанков работают с настоящей базой с примененными миграциями.
+// Строка подключения берется из TEST_DATABASE_URL, без нее бенчмарки пропускаются:
+//
+//	TEST_DATABASE_URL="host=localhost port=5433 user=postgres password=postgres dbname=postgres sslmode=disable" \
+//	  go test ./internal/repository -run '^$' -bench Chunk -benchmem
+//
+// Все данные создаются внутри транзакции, которая в конце откатывается.
+
+var errBenchmarkRollback = errors.New("benchmark rollback")
+
+var benchmarkChunkCounts = []int{10, 100, 1000}
+
+func BenchmarkCreateChunkOneByOne(b *testing.B) {
+	benchmarkChunkInsert(b, func(ctx context.Context, repo Repository, doc benchmarkDocument, version int32, texts []string) error {
+		for _, text := range texts {
+			if _, err := repo.CreateChunk(ctx, doc.userID, doc.workspaceID, doc.id, version, doc.title, text); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func BenchmarkCreateChunks(b *testing.B) {
+	benchmarkChunkInsert(b, func(ctx context.Context, repo Repository, doc benchmarkDocument, version int32, texts []string) error {
+		chunks := make([]domain.ChunkText, len(texts))
+		for i, text := range texts {
+			chunks[i] = domain.ChunkText{Text: text}
+		}
+		_, err := repo.CreateChunks(ctx, doc.userID, doc.workspaceID, doc.id, version, doc.title, chunks)
+		return err
+	})
+}
+
+type benchmarkDocument struct {
+	id, userID, workspaceID int64
+	title                   string
+}
+
+type chunkInsertFunc func(ctx context.Context, repo Repository, doc benchmarkDocument, version int32, texts []string) error
+
+func benchmarkChunkInsert(b *testing.B, insert chunkInsertFunc) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		b.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer pool.Close()
+
+	log := zerolog.Nop()
+	repo := NewPostgres(pool, &log)
+
+	err = repo.WithTransaction(ctx, func(repo Repository) error {
+		doc, err := createBenchmarkDocument(ctx, repo)
+		if err != nil {
+			return err
+		}
+
+		version := int32(0)
+		for _, count := range benchmarkChunkCounts {
+			b.Run(fmt.Sprintf("chunks=%d", count), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					version++
+					texts := benchmarkChunkTexts(version, count)
+					if err := insert(ctx, repo, doc, version, texts); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+		return errBenchmarkRollback
+	})
+	if err != nil && !errors.Is(err, errBenchmarkRollback) {
+		b.Fatal(err)
+	}
+}
+
+func createBenchmarkDocument(ctx context.Context, repo Repository) (benchmarkDocument, error) {
+	user, err := repo.CreateUser(ctx, fmt.Sprintf("bench-%d@example.com", time.Now().UnixNano()), "")
+	if err != nil {
+		return benchmarkDocument{}, err
+	}
+	workspace, err := repo.CreateWorkspace(ctx, "benchmark", user.ID)
+	if err != nil {
+		return benchmarkDocument{}, err
+	}
+	doc, err := repo.CreateDocument(ctx, user.ID, workspace.ID, "benchmark.txt", 0, nil, "")
+	if err != nil {
+		return benchmarkDocument{}, err
+	}
+	return benchmarkDocument{id: doc.ID, userID: user.ID, workspaceID: workspace.ID, title: doc.Filename}, nil
+}
+
+// benchmarkChunkTexts возвращает уникальные тексты размером с обычный чанк,
+// чтобы поиск готового эмбеддинга не находил совпадений.
+func benchmarkChunkTexts(version int32, count int) []string {
+	filler := strings.Repeat("lorem ipsum dolor sit amet ", 36)
+	texts := make([]string, count)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("%d-%d %s", version, i, filler)
+	}
+	return texts
+}
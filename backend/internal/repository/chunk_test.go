@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"context"
+	"testing"
+)
+
+func TestCreateChunksReusesEmbeddingsOnlyWithinWorkspace(t *testing.T) {
+	repo, tx := testRepository(t)
+	ctx := context.Background()
+
+	const text = "квартальный отчет: выручка выросла на 12%"
+
+	// Чанк с уже посчитанным эмбеддингом в чужом рабочем пространстве.
+	owner := createTestDocument(t, ctx, repo, "report.txt")
+	source, err := repo.CreateChunk(ctx, owner.userID, owner.workspaceID, owner.id, 1, owner.title, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ctx, "UPDATE chunks SET embedding = array_fill(0.1, ARRAY[768])::vector WHERE id = $1", source.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	outsider := createTestDocument(t, ctx, repo, "report.txt")
+
+	tests := []struct {
+		name string
+		doc  testDocument
+		want bool
+	}{
+		{name: "same workspace", doc: owner, want: true},
+		{name: "other workspace", doc: outsider, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk, err := repo.CreateChunk(ctx, tt.doc.userID, tt.doc.workspaceID, tt.doc.id, 2, tt.doc.title, text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if chunk.Embedded != tt.want {
+				t.Errorf("CreateChunk embedded = %v, want %v", chunk.Embedded, tt.want)
+			}
+
+			chunks, err := repo.CreateChunks(ctx, tt.doc.userID, tt.doc.workspaceID, tt.doc.id, 3, tt.doc.title, []domain.ChunkText{{Text: text}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if chunks[0].Embedded != tt.want {
+				t.Errorf("CreateChunks embedded = %v, want %v", chunks[0].Embedded, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateChunksStoresOverlaps(t *testing.T) {
+	repo, _ := testRepository(t)
+	ctx := context.Background()
+
+	doc := createTestDocument(t, ctx, repo, "notes.txt")
+	texts := []domain.ChunkText{
+		{Text: "первый абзац. ", OverlapBytes: 0},
+		{Text: "абзац. второй абзац", OverlapBytes: int32(len("абзац. "))},
+	}
+	if _, err := repo.CreateChunks(ctx, doc.userID, doc.workspaceID, doc.id, 1, doc.title, texts); err != nil {
+		t.Fatal(err)
+	}
+
+	chunks, err := repo.GetDocumentChunks(ctx, doc.id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) != len(texts) {
+		t.Fatalf("got %d chunks, want %d", len(chunks), len(texts))
+	}
+	for i, chunk := range chunks {
+		if chunk.Text != texts[i].Text {
+			t.Errorf("chunk %d text = %q, want %q", i, chunk.Text, texts[i].Text)
+		}
+		if chunk.OverlapBytes == nil || *chunk.OverlapBytes != texts[i].OverlapBytes {
+			t.Errorf("chunk %d overlap = %v, want %d", i, chunk.OverlapBytes, texts[i].OverlapBytes)
+		}
+	}
+}
+
+func TestCreateChunksReturnsChunksInInputOrder(t *testing.T) {
+	repo, _ := testRepository(t)
+	ctx := context.Background()
+
+	doc := createTestDocument(t, ctx, repo, "list.txt")
+	texts := []domain.ChunkText{
+		{Text: "a"}, {Text: "b"}, {Text: "a"}, {Text: "a", OverlapBytes: 1}, {Text: "c"},
+	}
+	chunks, err := repo.CreateChunks(ctx, doc.userID, doc.workspaceID, doc.id, 1, doc.title, texts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[int64]bool, len(chunks))
+	for i, chunk := range chunks {
+		if chunk.Text != texts[i].Text || chunk.OverlapBytes == nil || *chunk.OverlapBytes != texts[i].OverlapBytes {
+			t.Errorf("chunk %d = (%q, %v), want (%q, %d)", i, chunk.Text, chunk.OverlapBytes, texts[i].Text, texts[i].OverlapBytes)
+		}
+		if ids[chunk.ID] {
+			t.Errorf("chunk ID %d returned twice", chunk.ID)
+		}
+		ids[chunk.ID] = true
+	}
+}
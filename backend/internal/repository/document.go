@@ -4,28 +4,82 @@ import (
 	"backend/internal/domain"
 	"backend/internal/repository/queries"
 	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type DocumentRepository interface {
-	CreateDocument(ctx context.Context, userID int64, filename string) (*domain.Document, error)
-	GetUserDocuments(ctx context.Context, userID int64) ([]domain.Document, error)
+	CreateDocument(ctx context.Context, userID, workspaceID int64, filename string, sizeBytes int64, blobID *int64, contentSHA256 string) (*domain.Document, error)
+	GetUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter, sort string, descending bool, after *domain.DocumentPosition, limit int32) ([]domain.Document, error)
+	CountUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter) (int64, error)
 	GetUserDocumentByID(ctx context.Context, id, userID int64) (*domain.Document, error)
-	DeleteUserDocument(ctx context.Context, id, userID int64) error
+	GetDocumentByID(ctx context.Context, id int64) (*domain.Document, error)
+	TrashUserDocument(ctx context.Context, id, userID int64) (bool, error)
+	RestoreUserDocument(ctx context.Context, id, userID int64) (bool, error)
+	GetDocumentQuotaUserIDs(ctx context.Context, id int64) ([]int64, error)
+	GetUserTrashedDocuments(ctx context.Context, userID int64, workspaceID *int64) ([]domain.TrashedDocument, error)
+	GetUserTrashedDocumentIDs(ctx context.Context, userID int64, workspaceID *int64) ([]int64, error)
+	GetExpiredTrashedDocumentIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]int64, error)
+	DeleteTrashedDocuments(ctx context.Context, ids []int64) ([]int64, error)
+	GetWorkspaceDocumentIDByContentHash(ctx context.Context, workspaceID int64, contentSHA256 string) (int64, error)
+	UpdateDocumentMetadata(ctx context.Context, id int64, update domain.DocumentMetadataUpdate) (bool, error)
+}
+
+// metadataToDomain разбирает пользовательские поля документа из jsonb.
+func metadataToDomain(raw []byte) map[string]string {
+	metadata := map[string]string{}
+	_ = json.Unmarshal(raw, &metadata)
+	return metadata
+}
+
+// optionalMetadata кодирует пользовательские поля в jsonb; nil превращается в NULL.
+func optionalMetadata(metadata map[string]string) ([]byte, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+	return json.Marshal(metadata)
 }
 
 func documentToDomain(d queries.Document) *domain.Document {
 	return &domain.Document{
-		ID:       d.ID,
-		UserID:   d.UserID,
-		Filename: d.Filename,
+		ID:             d.ID,
+		UserID:         int8Ptr(d.UserID),
+		WorkspaceID:    d.WorkspaceID,
+		Filename:       d.Filename,
+		SizeBytes:      d.SizeBytes,
+		BlobID:         int8Ptr(d.BlobID),
+		ContentSHA256:  d.ContentSha256.String,
+		CurrentVersion: d.CurrentVersion,
+		FolderID:       int8Ptr(d.FolderID),
+		Description:    d.Description,
+		Tags:           d.Tags,
+		Metadata:       metadataToDomain(d.Metadata),
+		CreatedAt:      d.CreatedAt.Time,
+		UpdatedAt:      d.UpdatedAt.Time,
 	}
 }
 
 func documentRowToDomain(d queries.GetUserDocumentByIDRow) *domain.Document {
 	return &domain.Document{
 		ID:              d.ID,
-		UserID:          d.UserID,
+		UserID:          int8Ptr(d.UserID),
+		WorkspaceID:     d.WorkspaceID,
+		WorkspaceRole:   d.WorkspaceRole,
+		SharePermission: d.SharePermission,
+		SharedWithMe:    d.WorkspaceRole == "",
 		Filename:        d.Filename,
+		SizeBytes:       d.SizeBytes,
+		BlobID:          int8Ptr(d.BlobID),
+		ContentSHA256:   d.ContentSha256.String,
+		CurrentVersion:  d.CurrentVersion,
+		FolderID:        int8Ptr(d.FolderID),
+		Description:     d.Description,
+		Tags:            d.Tags,
+		Metadata:        metadataToDomain(d.Metadata),
+		CreatedAt:       d.CreatedAt.Time,
+		UpdatedAt:       d.UpdatedAt.Time,
 		NullEmbeddings:  d.NullEmbeddingsCount,
 		TotalEmbeddings: d.TotalEmbeddingsCount,
 	}
@@ -34,17 +88,35 @@ func documentRowToDomain(d queries.GetUserDocumentByIDRow) *domain.Document {
 func documentsSearchRowToDomain(d queries.GetUserDocumentsRow) *domain.Document {
 	return &domain.Document{
 		ID:              d.ID,
-		UserID:          d.UserID,
+		UserID:          int8Ptr(d.UserID),
+		WorkspaceID:     d.WorkspaceID,
+		WorkspaceRole:   d.WorkspaceRole,
+		SharePermission: d.SharePermission,
+		SharedWithMe:    d.WorkspaceRole == "",
 		Filename:        d.Filename,
+		SizeBytes:       d.SizeBytes,
+		BlobID:          int8Ptr(d.BlobID),
+		ContentSHA256:   d.ContentSha256.String,
+		CurrentVersion:  d.CurrentVersion,
+		FolderID:        int8Ptr(d.FolderID),
+		Description:     d.Description,
+		Tags:            d.Tags,
+		Metadata:        metadataToDomain(d.Metadata),
+		CreatedAt:       d.CreatedAt.Time,
+		UpdatedAt:       d.UpdatedAt.Time,
 		NullEmbeddings:  d.NullEmbeddingsCount,
 		TotalEmbeddings: d.TotalEmbeddingsCount,
 	}
 }
 
-func (p *postgres) CreateDocument(ctx context.Context, userID int64, filename string) (*domain.Document, error) {
+func (p *postgres) CreateDocument(ctx context.Context, userID, workspaceID int64, filename string, sizeBytes int64, blobID *int64, contentSHA256 string) (*domain.Document, error) {
 	d, err := p.q.CreateDocument(ctx, queries.CreateDocumentParams{
-		UserID:   userID,
-		Filename: filename,
+		UserID:        userID,
+		WorkspaceID:   workspaceID,
+		Filename:      filename,
+		SizeBytes:     sizeBytes,
+		BlobID:        optionalInt8(blobID),
+		ContentSha256: pgtype.Text{String: contentSHA256, Valid: contentSHA256 != ""},
 	})
 	if err != nil {
 		return nil, err
@@ -52,8 +124,26 @@ func (p *postgres) CreateDocument(ctx context.Context, userID int64, filename st
 	return documentToDomain(d), nil
 }
 
-func (p *postgres) GetUserDocuments(ctx context.Context, userID int64) ([]domain.Document, error) {
-	docs, err := p.q.GetUserDocuments(ctx, userID)
+func (p *postgres) GetUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter, sort string, descending bool, after *domain.DocumentPosition, limit int32) ([]domain.Document, error) {
+	params := queries.GetUserDocumentsParams{
+		Sort:        sort,
+		Descending:  descending,
+		UserID:      userID,
+		WorkspaceID: optionalInt8(filter.WorkspaceID),
+		FolderIds:   filter.FolderIDs,
+		Filename:    optionalText(filter.Filename),
+		Tags:        filter.Tags,
+		Status:      optionalText(filter.Status),
+		LimitCount:  pgtype.Int4{Int32: limit, Valid: limit > 0},
+	}
+	if after != nil {
+		params.AfterID = pgtype.Int8{Int64: after.ID, Valid: true}
+		params.AfterName = pgtype.Text{String: after.Name, Valid: true}
+		params.AfterSize = pgtype.Int8{Int64: after.SizeBytes, Valid: true}
+		params.AfterCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+	}
+
+	docs, err := p.q.GetUserDocuments(ctx, params)
 	if err != nil {
 		p.log.Error().Err(err).Int64("userID", userID).Msg("DATABASE ERROR: Ошибка при получении документов")
 		return nil, err
@@ -67,6 +157,17 @@ func (p *postgres) GetUserDocuments(ctx context.Context, userID int64) ([]domain
 	return domainDocs, nil
 }
 
+func (p *postgres) CountUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter) (int64, error) {
+	return p.q.CountUserDocuments(ctx, queries.CountUserDocumentsParams{
+		UserID:      userID,
+		WorkspaceID: optionalInt8(filter.WorkspaceID),
+		FolderIds:   filter.FolderIDs,
+		Filename:    optionalText(filter.Filename),
+		Tags:        filter.Tags,
+		Status:      optionalText(filter.Status),
+	})
+}
+
 func (p *postgres) GetUserDocumentByID(ctx context.Context, id, userID int64) (*domain.Document, error) {
 	d, err := p.q.GetUserDocumentByID(ctx, queries.GetUserDocumentByIDParams{
 		ID:     id,
@@ -78,9 +179,141 @@ func (p *postgres) GetUserDocumentByID(ctx context.Context, id, userID int64) (*
 	return documentRowToDomain(d), nil
 }
 
-func (p *postgres) DeleteUserDocument(ctx context.Context, id, userID int64) error {
-	return p.q.DeleteUserDocument(ctx, queries.DeleteUserDocumentParams{
+func (p *postgres) GetDocumentByID(ctx context.Context, id int64) (*domain.Document, error) {
+	d, err := p.q.GetDocumentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Document{
+		ID:              d.ID,
+		UserID:          int8Ptr(d.UserID),
+		WorkspaceID:     d.WorkspaceID,
+		Filename:        d.Filename,
+		SizeBytes:       d.SizeBytes,
+		BlobID:          int8Ptr(d.BlobID),
+		ContentSHA256:   d.ContentSha256.String,
+		CurrentVersion:  d.CurrentVersion,
+		FolderID:        int8Ptr(d.FolderID),
+		Description:     d.Description,
+		Tags:            d.Tags,
+		Metadata:        metadataToDomain(d.Metadata),
+		CreatedAt:       d.CreatedAt.Time,
+		UpdatedAt:       d.UpdatedAt.Time,
+		NullEmbeddings:  d.NullEmbeddingsCount,
+		TotalEmbeddings: d.TotalEmbeddingsCount,
+	}, nil
+}
+
+func (p *postgres) TrashUserDocument(ctx context.Context, id, userID int64) (bool, error) {
+	rows, err := p.q.TrashUserDocument(ctx, queries.TrashUserDocumentParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) RestoreUserDocument(ctx context.Context, id, userID int64) (bool, error) {
+	rows, err := p.q.RestoreUserDocument(ctx, queries.RestoreUserDocumentParams{
 		ID:     id,
 		UserID: userID,
 	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) GetDocumentQuotaUserIDs(ctx context.Context, id int64) ([]int64, error) {
+	return p.q.GetDocumentQuotaUserIDs(ctx, id)
+}
+
+func (p *postgres) GetUserTrashedDocuments(ctx context.Context, userID int64, workspaceID *int64) ([]domain.TrashedDocument, error) {
+	docs, err := p.q.GetUserTrashedDocuments(ctx, queries.GetUserTrashedDocumentsParams{
+		UserID:      userID,
+		WorkspaceID: optionalInt8(workspaceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainDocs := make([]domain.TrashedDocument, len(docs))
+	for i, d := range docs {
+		domainDocs[i] = domain.TrashedDocument{
+			Document: domain.Document{
+				ID:             d.ID,
+				UserID:         int8Ptr(d.UserID),
+				WorkspaceID:    d.WorkspaceID,
+				WorkspaceRole:  d.WorkspaceRole,
+				Filename:       d.Filename,
+				SizeBytes:      d.SizeBytes,
+				BlobID:         int8Ptr(d.BlobID),
+				ContentSHA256:  d.ContentSha256.String,
+				CurrentVersion: d.CurrentVersion,
+				FolderID:       int8Ptr(d.FolderID),
+				Description:    d.Description,
+				Tags:           d.Tags,
+				Metadata:       metadataToDomain(d.Metadata),
+				CreatedAt:      d.CreatedAt.Time,
+				UpdatedAt:      d.UpdatedAt.Time,
+			},
+			DeletedAt: d.DeletedAt.Time,
+			DeletedBy: int8Ptr(d.DeletedBy),
+		}
+	}
+
+	return domainDocs, nil
+}
+
+func (p *postgres) GetUserTrashedDocumentIDs(ctx context.Context, userID int64, workspaceID *int64) ([]int64, error) {
+	return p.q.GetUserTrashedDocumentIDs(ctx, queries.GetUserTrashedDocumentIDsParams{
+		UserID:      userID,
+		WorkspaceID: optionalInt8(workspaceID),
+	})
+}
+
+func (p *postgres) GetExpiredTrashedDocumentIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]int64, error) {
+	return p.q.GetExpiredTrashedDocumentIDs(ctx, queries.GetExpiredTrashedDocumentIDsParams{
+		DeletedBefore: pgtype.Timestamptz{Time: deletedBefore, Valid: true},
+		LimitCount:    limit,
+	})
+}
+
+func (p *postgres) DeleteTrashedDocuments(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return p.q.DeleteTrashedDocuments(ctx, ids)
+}
+
+func (p *postgres) GetWorkspaceDocumentIDByContentHash(ctx context.Context, workspaceID int64, contentSHA256 string) (int64, error) {
+	return p.q.GetWorkspaceDocumentIDByContentHash(ctx, queries.GetWorkspaceDocumentIDByContentHashParams{
+		WorkspaceID:   workspaceID,
+		ContentSha256: pgtype.Text{String: contentSHA256, Valid: true},
+	})
+}
+
+func (p *postgres) UpdateDocumentMetadata(ctx context.Context, id int64, update domain.DocumentMetadataUpdate) (bool, error) {
+	metadata, err := optionalMetadata(update.Metadata)
+	if err != nil {
+		return false, err
+	}
+
+	var description pgtype.Text
+	if update.Description != nil {
+		description = pgtype.Text{String: *update.Description, Valid: true}
+	}
+
+	rows, err := p.q.UpdateDocumentMetadata(ctx, queries.UpdateDocumentMetadataParams{
+		ID:          id,
+		Description: description,
+		Tags:        update.Tags,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
 }
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"context"
+	"strconv"
+)
+
+// documentChangesChannel — канал LISTEN/NOTIFY, в который триггеры пишут ID изменившихся документов.
+const documentChangesChannel = "document_changes"
+
+type DocumentEventRepository interface {
+	ListenDocumentChanges(ctx context.Context, handle func(documentID int64)) error
+	GetDocumentProgress(ctx context.Context, documentID int64) (*domain.DocumentEvent, error)
+	GetDocumentAudience(ctx context.Context, documentID int64) ([]int64, error)
+}
+
+// ListenDocumentChanges подписывается на изменения документов и вызывает handle для каждого уведомления,
+// пока не отменят ctx или не оборвется соединение. Для подписки занимается отдельное соединение,
+// которое после выхода закрывается, а не возвращается в пул.
+func (p *postgres) ListenDocumentChanges(ctx context.Context, handle func(documentID int64)) error {
+	pooled, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+documentChangesChannel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		documentID, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			p.log.Warn().Str("payload", notification.Payload).Msg("Некорректное уведомление об изменении документа")
+			continue
+		}
+		handle(documentID)
+	}
+}
+
+func (p *postgres) GetDocumentProgress(ctx context.Context, documentID int64) (*domain.DocumentEvent, error) {
+	d, err := p.q.GetDocumentProgress(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &domain.DocumentEvent{
+		Type:            domain.DocumentEventProgress,
+		DocumentID:      d.ID,
+		WorkspaceID:     d.WorkspaceID,
+		CurrentVersion:  d.CurrentVersion,
+		NullEmbeddings:  d.NullEmbeddingsCount,
+		TotalEmbeddings: d.TotalEmbeddingsCount,
+	}
+	if d.Deleted {
+		event.Type = domain.DocumentEventDeleted
+	}
+	return event, nil
+}
+
+func (p *postgres) GetDocumentAudience(ctx context.Context, documentID int64) ([]int64, error) {
+	return p.q.GetDocumentAudience(ctx, documentID)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DocumentShareRepository interface {
+	UpsertDocumentShare(ctx context.Context, documentID, userID int64, permission string, grantedBy int64) error
+	GetDocumentShares(ctx context.Context, documentID int64) ([]domain.DocumentShare, error)
+	DeleteDocumentShare(ctx context.Context, documentID, userID int64) (bool, error)
+}
+
+func (p *postgres) UpsertDocumentShare(ctx context.Context, documentID, userID int64, permission string, grantedBy int64) error {
+	return p.q.UpsertDocumentShare(ctx, queries.UpsertDocumentShareParams{
+		DocumentID: documentID,
+		UserID:     userID,
+		Permission: permission,
+		GrantedBy:  pgtype.Int8{Int64: grantedBy, Valid: true},
+	})
+}
+
+func (p *postgres) GetDocumentShares(ctx context.Context, documentID int64) ([]domain.DocumentShare, error) {
+	shares, err := p.q.GetDocumentShares(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainShares := make([]domain.DocumentShare, len(shares))
+	for i, s := range shares {
+		domainShares[i] = domain.DocumentShare{
+			UserID:     s.UserID,
+			Email:      s.Email,
+			Permission: s.Permission,
+			CreatedAt:  s.CreatedAt.Time,
+		}
+	}
+
+	return domainShares, nil
+}
+
+func (p *postgres) DeleteDocumentShare(ctx context.Context, documentID, userID int64) (bool, error) {
+	rows, err := p.q.DeleteDocumentShare(ctx, queries.DeleteDocumentShareParams{
+		DocumentID: documentID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DocumentVersionRepository interface {
+	CreateDocumentVersion(ctx context.Context, version domain.DocumentVersion) (*domain.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, documentID int64) ([]domain.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, documentID int64, version int32) (*domain.DocumentVersion, error)
+	LockDocumentCurrentVersion(ctx context.Context, documentID int64) (int32, error)
+	SetDocumentCurrentVersion(ctx context.Context, version domain.DocumentVersion) (*domain.Document, error)
+	LockDocumentVersionsBatch(ctx context.Context, afterID int64, limit int32) ([]int64, error)
+	ReconcileVersionChunkCounters(ctx context.Context, ids []int64) ([]int64, error)
+}
+
+// documentVersionToDomain принимает строку GetDocumentVersion; строки GetDocumentVersions имеют ту же форму.
+func documentVersionToDomain(v queries.GetDocumentVersionRow) *domain.DocumentVersion {
+	return &domain.DocumentVersion{
+		ID:            v.ID,
+		DocumentID:    v.DocumentID,
+		Version:       v.Version,
+		SizeBytes:     v.SizeBytes,
+		BlobID:        int8Ptr(v.BlobID),
+		ContentSHA256: v.ContentSha256.String,
+		CreatedBy:     int8Ptr(v.CreatedBy),
+		CreatedAt:     v.CreatedAt.Time,
+		Chunks:        v.ChunksCount,
+	}
+}
+
+func (p *postgres) CreateDocumentVersion(ctx context.Context, version domain.DocumentVersion) (*domain.DocumentVersion, error) {
+	v, err := p.q.CreateDocumentVersion(ctx, queries.CreateDocumentVersionParams{
+		DocumentID:    version.DocumentID,
+		Version:       version.Version,
+		SizeBytes:     version.SizeBytes,
+		BlobID:        optionalInt8(version.BlobID),
+		ContentSha256: pgtype.Text{String: version.ContentSHA256, Valid: version.ContentSHA256 != ""},
+		CreatedBy:     optionalInt8(version.CreatedBy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.DocumentVersion{
+		ID:            v.ID,
+		DocumentID:    v.DocumentID,
+		Version:       v.Version,
+		SizeBytes:     v.SizeBytes,
+		BlobID:        int8Ptr(v.BlobID),
+		ContentSHA256: v.ContentSha256.String,
+		CreatedBy:     int8Ptr(v.CreatedBy),
+		CreatedAt:     v.CreatedAt.Time,
+		Chunks:        version.Chunks,
+	}, nil
+}
+
+func (p *postgres) GetDocumentVersions(ctx context.Context, documentID int64) ([]domain.DocumentVersion, error) {
+	versions, err := p.q.GetDocumentVersions(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainVersions := make([]domain.DocumentVersion, len(versions))
+	for i, v := range versions {
+		domainVersions[i] = *documentVersionToDomain(queries.GetDocumentVersionRow(v))
+	}
+
+	return domainVersions, nil
+}
+
+func (p *postgres) GetDocumentVersion(ctx context.Context, documentID int64, version int32) (*domain.DocumentVersion, error) {
+	v, err := p.q.GetDocumentVersion(ctx, queries.GetDocumentVersionParams{
+		DocumentID: documentID,
+		Version:    version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return documentVersionToDomain(v), nil
+}
+
+func (p *postgres) LockDocumentCurrentVersion(ctx context.Context, documentID int64) (int32, error) {
+	return p.q.LockDocumentCurrentVersion(ctx, documentID)
+}
+
+func (p *postgres) SetDocumentCurrentVersion(ctx context.Context, version domain.DocumentVersion) (*domain.Document, error) {
+	d, err := p.q.SetDocumentCurrentVersion(ctx, queries.SetDocumentCurrentVersionParams{
+		ID:            version.DocumentID,
+		Version:       version.Version,
+		SizeBytes:     version.SizeBytes,
+		BlobID:        optionalInt8(version.BlobID),
+		ContentSha256: pgtype.Text{String: version.ContentSHA256, Valid: version.ContentSHA256 != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return documentToDomain(d), nil
+}
+
+func (p *postgres) LockDocumentVersionsBatch(ctx context.Context, afterID int64, limit int32) ([]int64, error) {
+	return p.q.LockDocumentVersionsBatch(ctx, queries.LockDocumentVersionsBatchParams{
+		AfterID:    afterID,
+		LimitCount: limit,
+	})
+}
+
+func (p *postgres) ReconcileVersionChunkCounters(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return p.q.ReconcileVersionChunkCounters(ctx, ids)
+}
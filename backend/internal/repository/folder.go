@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type FolderRepository interface {
+	CreateFolder(ctx context.Context, workspaceID int64, parentID *int64, name string, createdBy int64) (*domain.Folder, error)
+	GetUserFolders(ctx context.Context, userID int64, workspaceID *int64) ([]domain.Folder, error)
+	GetUserFolderByID(ctx context.Context, id, userID int64) (*domain.Folder, error)
+	FolderNameExists(ctx context.Context, workspaceID int64, parentID *int64, name string, excludeID int64) (bool, error)
+	LockWorkspaceFolders(ctx context.Context, workspaceID int64) error
+	RenameFolder(ctx context.Context, id int64, name string) error
+	MoveFolder(ctx context.Context, id int64, parentID *int64) error
+	GetFolderSubtreeIDs(ctx context.Context, id int64) ([]int64, error)
+	DeleteFolder(ctx context.Context, id int64) error
+	TrashFolderDocuments(ctx context.Context, folderIDs []int64, userID int64) ([]int64, error)
+	SetDocumentFolder(ctx context.Context, documentID int64, folderID *int64) (bool, error)
+}
+
+func folderRowToDomain(f queries.GetUserFolderByIDRow) *domain.Folder {
+	return &domain.Folder{
+		ID:            f.ID,
+		WorkspaceID:   f.WorkspaceID,
+		ParentID:      int8Ptr(f.ParentID),
+		Name:          f.Name,
+		CreatedBy:     int8Ptr(f.CreatedBy),
+		WorkspaceRole: f.WorkspaceRole,
+		Documents:     f.DocumentsCount,
+		CreatedAt:     f.CreatedAt.Time,
+		UpdatedAt:     f.UpdatedAt.Time,
+	}
+}
+
+func (p *postgres) CreateFolder(ctx context.Context, workspaceID int64, parentID *int64, name string, createdBy int64) (*domain.Folder, error) {
+	f, err := p.q.CreateFolder(ctx, queries.CreateFolderParams{
+		WorkspaceID: workspaceID,
+		ParentID:    optionalInt8(parentID),
+		Name:        name,
+		CreatedBy:   pgtype.Int8{Int64: createdBy, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Folder{
+		ID:          f.ID,
+		WorkspaceID: f.WorkspaceID,
+		ParentID:    int8Ptr(f.ParentID),
+		Name:        f.Name,
+		CreatedBy:   int8Ptr(f.CreatedBy),
+		CreatedAt:   f.CreatedAt.Time,
+		UpdatedAt:   f.UpdatedAt.Time,
+	}, nil
+}
+
+func (p *postgres) GetUserFolders(ctx context.Context, userID int64, workspaceID *int64) ([]domain.Folder, error) {
+	folders, err := p.q.GetUserFolders(ctx, queries.GetUserFoldersParams{
+		UserID:      userID,
+		WorkspaceID: optionalInt8(workspaceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainFolders := make([]domain.Folder, len(folders))
+	for i, f := range folders {
+		domainFolders[i] = *folderRowToDomain(queries.GetUserFolderByIDRow(f))
+	}
+
+	return domainFolders, nil
+}
+
+func (p *postgres) GetUserFolderByID(ctx context.Context, id, userID int64) (*domain.Folder, error) {
+	f, err := p.q.GetUserFolderByID(ctx, queries.GetUserFolderByIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return folderRowToDomain(f), nil
+}
+
+func (p *postgres) FolderNameExists(ctx context.Context, workspaceID int64, parentID *int64, name string, excludeID int64) (bool, error) {
+	return p.q.FolderNameExists(ctx, queries.FolderNameExistsParams{
+		WorkspaceID: workspaceID,
+		ParentID:    optionalInt8(parentID),
+		Name:        name,
+		ExcludeID:   excludeID,
+	})
+}
+
+func (p *postgres) LockWorkspaceFolders(ctx context.Context, workspaceID int64) error {
+	return p.q.LockWorkspaceFolders(ctx, workspaceID)
+}
+
+func (p *postgres) RenameFolder(ctx context.Context, id int64, name string) error {
+	return p.q.RenameFolder(ctx, queries.RenameFolderParams{
+		ID:   id,
+		Name: name,
+	})
+}
+
+func (p *postgres) MoveFolder(ctx context.Context, id int64, parentID *int64) error {
+	return p.q.MoveFolder(ctx, queries.MoveFolderParams{
+		ID:       id,
+		ParentID: optionalInt8(parentID),
+	})
+}
+
+func (p *postgres) GetFolderSubtreeIDs(ctx context.Context, id int64) ([]int64, error) {
+	return p.q.GetFolderSubtreeIDs(ctx, id)
+}
+
+func (p *postgres) DeleteFolder(ctx context.Context, id int64) error {
+	return p.q.DeleteFolder(ctx, id)
+}
+
+func (p *postgres) TrashFolderDocuments(ctx context.Context, folderIDs []int64, userID int64) ([]int64, error) {
+	return p.q.TrashFolderDocuments(ctx, queries.TrashFolderDocumentsParams{
+		FolderIds: folderIDs,
+		UserID:    pgtype.Int8{Int64: userID, Valid: true},
+	})
+}
+
+func (p *postgres) SetDocumentFolder(ctx context.Context, documentID int64, folderID *int64) (bool, error) {
+	rows, err := p.q.SetDocumentFolder(ctx, queries.SetDocumentFolderParams{
+		ID:       documentID,
+		FolderID: optionalInt8(folderID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
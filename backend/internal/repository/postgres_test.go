@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// Тесты репозитория работают с настоящей базой с примененными миграциями.
+// Строка подключения берется из TEST_DATABASE_URL, без нее тесты пропускаются.
+// Каждый тест выполняется в своей транзакции, которая в конце откатывается.
+
+// testRepository возвращает репозиторий поверх транзакции, которая откатывается по завершении теста.
+func testRepository(t *testing.T) (*postgres, pgx.Tx) {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	log := zerolog.Nop()
+	repo := NewPostgres(pool, &log).(*postgres)
+	return repo.withTx(tx), tx
+}
+
+type testDocument struct {
+	id, userID, workspaceID int64
+	title                   string
+}
+
+// createTestDocument создает пользователя с личным рабочим пространством и пустой документ в нем.
+func createTestDocument(t *testing.T, ctx context.Context, repo Repository, filename string) testDocument {
+	t.Helper()
+
+	user, err := repo.CreateUser(ctx, fmt.Sprintf("test-%d@example.com", time.Now().UnixNano()), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	workspace, err := repo.CreateWorkspace(ctx, "test", user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := repo.CreateDocument(ctx, user.ID, workspace.ID, filename, 0, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testDocument{id: doc.ID, userID: user.ID, workspaceID: workspace.ID, title: doc.Filename}
+}
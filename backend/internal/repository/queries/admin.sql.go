@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: admin.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUsers = `-- name: CountUsers :one
+SELECT COUNT(*)
+FROM users
+`
+
+// Возвращает общее количество пользователей.
+func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const disableUser = `-- name: DisableUser :execrows
+UPDATE users
+SET disabled_at = NOW()
+WHERE id = $1 AND disabled_at IS NULL
+`
+
+// Блокирует пользователя.
+func (q *Queries) DisableUser(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, disableUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enableUser = `-- name: EnableUser :execrows
+UPDATE users
+SET disabled_at = NULL
+WHERE id = $1 AND disabled_at IS NOT NULL
+`
+
+// Снимает блокировку с пользователя.
+func (q *Queries) EnableUser(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, enableUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getUserWithStats = `-- name: GetUserWithStats :one
+SELECT
+  u.id,
+  u.email,
+  u.email_verified,
+  u.role,
+  u.disabled_at,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.user_id = u.id
+  ) AS documents_count,
+  (
+    SELECT COUNT(*) FROM chunks c WHERE c.user_id = u.id
+  ) AS chunks_count
+FROM users u
+WHERE u.id = $1
+LIMIT 1
+`
+
+type GetUserWithStatsRow struct {
+	ID             int64
+	Email          string
+	EmailVerified  bool
+	Role           string
+	DisabledAt     pgtype.Timestamptz
+	DocumentsCount int64
+	ChunksCount    int64
+}
+
+// Возвращает пользователя с количеством документов и чанков (для администратора).
+func (q *Queries) GetUserWithStats(ctx context.Context, id int64) (GetUserWithStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserWithStats, id)
+	var i GetUserWithStatsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.EmailVerified,
+		&i.Role,
+		&i.DisabledAt,
+		&i.DocumentsCount,
+		&i.ChunksCount,
+	)
+	return i, err
+}
+
+const listUsersWithStats = `-- name: ListUsersWithStats :many
+SELECT
+  u.id,
+  u.email,
+  u.email_verified,
+  u.role,
+  u.disabled_at,
+  (
+    SELECT COUNT(*) FROM documents d WHERE d.user_id = u.id
+  ) AS documents_count,
+  (
+    SELECT COUNT(*) FROM chunks c WHERE c.user_id = u.id
+  ) AS chunks_count
+FROM users u
+ORDER BY u.id
+LIMIT $1 OFFSET $2
+`
+
+type ListUsersWithStatsParams struct {
+	Limit  int32
+	Offset int32
+}
+
+type ListUsersWithStatsRow struct {
+	ID             int64
+	Email          string
+	EmailVerified  bool
+	Role           string
+	DisabledAt     pgtype.Timestamptz
+	DocumentsCount int64
+	ChunksCount    int64
+}
+
+// Возвращает страницу пользователей с количеством документов и чанков (для администратора).
+func (q *Queries) ListUsersWithStats(ctx context.Context, arg ListUsersWithStatsParams) ([]ListUsersWithStatsRow, error) {
+	rows, err := q.db.Query(ctx, listUsersWithStats, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersWithStatsRow
+	for rows.Next() {
+		var i ListUsersWithStatsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.EmailVerified,
+			&i.Role,
+			&i.DisabledAt,
+			&i.DocumentsCount,
+			&i.ChunksCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserRoleByEmail = `-- name: SetUserRoleByEmail :execrows
+UPDATE users
+SET role = $2
+WHERE email = $1 AND role <> $2
+`
+
+type SetUserRoleByEmailParams struct {
+	Email string
+	Role  string
+}
+
+// Назначает роль пользователю по email.
+func (q *Queries) SetUserRoleByEmail(ctx context.Context, arg SetUserRoleByEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserRoleByEmail, arg.Email, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
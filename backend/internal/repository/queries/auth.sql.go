@@ -11,6 +11,35 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const consumeUserToken = `-- name: ConsumeUserToken :one
+UPDATE user_tokens
+SET used_at = NOW()
+WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING id, user_id, purpose, token_hash, expires_at, used_at, created_at
+`
+
+type ConsumeUserTokenParams struct {
+	TokenHash string
+	Purpose   string
+}
+
+// Помечает одноразовый токен использованным и возвращает его.
+// Истекшие и уже использованные токены не находятся.
+func (q *Queries) ConsumeUserToken(ctx context.Context, arg ConsumeUserTokenParams) (UserToken, error) {
+	row := q.db.QueryRow(ctx, consumeUserToken, arg.TokenHash, arg.Purpose)
+	var i UserToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Purpose,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createRefreshToken = `-- name: CreateRefreshToken :exec
 INSERT INTO refresh_tokens (user_id, token_hash, expires_at)
 VALUES ($1, $2, $3)
@@ -28,6 +57,29 @@ func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshToken
 	return err
 }
 
+const createUserToken = `-- name: CreateUserToken :exec
+INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateUserTokenParams struct {
+	UserID    int64
+	Purpose   string
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+}
+
+// Сохраняет хеш одноразового токена (подтверждение email или сброс пароля).
+func (q *Queries) CreateUserToken(ctx context.Context, arg CreateUserTokenParams) error {
+	_, err := q.db.Exec(ctx, createUserToken,
+		arg.UserID,
+		arg.Purpose,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const deleteAllUserRefreshTokens = `-- name: DeleteAllUserRefreshTokens :exec
 DELETE FROM refresh_tokens
 WHERE user_id = $1
@@ -50,6 +102,22 @@ func (q *Queries) DeleteRefreshToken(ctx context.Context, tokenHash string) erro
 	return err
 }
 
+const deleteUserTokens = `-- name: DeleteUserTokens :exec
+DELETE FROM user_tokens
+WHERE user_id = $1 AND purpose = $2
+`
+
+type DeleteUserTokensParams struct {
+	UserID  int64
+	Purpose string
+}
+
+// Удаляет все одноразовые токены пользователя с указанным назначением.
+func (q *Queries) DeleteUserTokens(ctx context.Context, arg DeleteUserTokensParams) error {
+	_, err := q.db.Exec(ctx, deleteUserTokens, arg.UserID, arg.Purpose)
+	return err
+}
+
 const getRefreshByTokenHash = `-- name: GetRefreshByTokenHash :one
 SELECT id, user_id, token_hash, expires_at
 FROM refresh_tokens
@@ -8,36 +8,111 @@ package queries
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pgvector/pgvector-go"
 )
 
+const copyVersionChunks = `-- name: CopyVersionChunks :many
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, overlap_bytes, embedding)
+SELECT $1::bigint, c.workspace_id, c.document_id, $2, c.title, c.text, c.overlap_bytes, c.embedding
+FROM chunks c
+WHERE c.document_id = $3 AND c.version = $4
+ORDER BY c.id
+RETURNING id, (embedding IS NOT NULL)::bool AS embedded
+`
+
+type CopyVersionChunksParams struct {
+	UserID      int64
+	ToVersion   int32
+	DocumentID  int64
+	FromVersion int32
+}
+
+type CopyVersionChunksRow struct {
+	ID       int64
+	Embedded bool
+}
+
+// Копирует чанки одной версии документа в новую версию вместе с эмбеддингами (используется при восстановлении версии).
+// Возвращает ID новых чанков и признак того, что эмбеддинг скопирован.
+func (q *Queries) CopyVersionChunks(ctx context.Context, arg CopyVersionChunksParams) ([]CopyVersionChunksRow, error) {
+	rows, err := q.db.Query(ctx, copyVersionChunks,
+		arg.UserID,
+		arg.ToVersion,
+		arg.DocumentID,
+		arg.FromVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CopyVersionChunksRow
+	for rows.Next() {
+		var i CopyVersionChunksRow
+		if err := rows.Scan(&i.ID, &i.Embedded); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createChunk = `-- name: CreateChunk :one
-INSERT INTO chunks (user_id, document_id, title, text)
-VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, document_id, title, text
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, embedding)
+VALUES (
+  $1::bigint,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  (
+    SELECT e.embedding
+    FROM chunks e
+    WHERE e.workspace_id = $2
+      AND md5(e.title || ' ' || e.text) = md5($5::text || ' ' || $6::text)
+      AND e.title = $5
+      AND e.text = $6
+      AND e.embedding IS NOT NULL
+    LIMIT 1
+  )
+)
+RETURNING id, user_id, workspace_id, document_id, title, text, (embedding IS NOT NULL)::bool AS embedded
 `
 
 type CreateChunkParams struct {
-	UserID     int64
-	DocumentID int64
-	Title      string
-	Text       string
+	UserID      int64
+	WorkspaceID int64
+	DocumentID  int64
+	Version     int32
+	Title       string
+	Text        string
 }
 
 type CreateChunkRow struct {
-	ID         int64
-	UserID     int64
-	DocumentID int64
-	Title      string
-	Text       string
+	ID          int64
+	UserID      pgtype.Int8
+	WorkspaceID int64
+	DocumentID  int64
+	Title       string
+	Text        string
+	Embedded    bool
 }
 
-// Создает один чанк для документа.
-// Поле 'embedding' здесь не передается, оно будет NULL при первичной вставке.
+// Создает один чанк для версии документа.
+// Если в том же рабочем пространстве уже есть чанк с тем же заголовком и текстом, его эмбеддинг копируется,
+// иначе поле 'embedding' остается NULL до обработки векторизатором.
+// ВАЖНО: поиск не выходит за пределы рабочего пространства, иначе по числу скопированных эмбеддингов
+// можно было бы узнать, есть ли такой текст у других пользователей.
 func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) (CreateChunkRow, error) {
 	row := q.db.QueryRow(ctx, createChunk,
 		arg.UserID,
+		arg.WorkspaceID,
 		arg.DocumentID,
+		arg.Version,
 		arg.Title,
 		arg.Text,
 	)
@@ -45,18 +120,124 @@ func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) (Creat
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorkspaceID,
 		&i.DocumentID,
 		&i.Title,
 		&i.Text,
+		&i.Embedded,
 	)
 	return i, err
 }
 
+const createChunks = `-- name: CreateChunks :many
+INSERT INTO chunks (user_id, workspace_id, document_id, version, title, text, overlap_bytes, embedding)
+SELECT
+  $1::bigint,
+  $2::bigint,
+  $3::bigint,
+  $4::int,
+  $5::text,
+  t.text,
+  o.overlap_bytes,
+  (
+    SELECT e.embedding
+    FROM chunks e
+    WHERE e.workspace_id = $2::bigint
+      AND md5(e.title || ' ' || e.text) = md5($5::text || ' ' || t.text)
+      AND e.title = $5::text
+      AND e.text = t.text
+      AND e.embedding IS NOT NULL
+    LIMIT 1
+  )
+FROM unnest($6::text[]) WITH ORDINALITY AS t(text, ord)
+JOIN unnest($7::int[]) WITH ORDINALITY AS o(overlap_bytes, ord) ON o.ord = t.ord
+ORDER BY t.ord
+RETURNING id, text, overlap_bytes, (embedding IS NOT NULL)::bool AS embedded
+`
+
+type CreateChunksParams struct {
+	UserID       int64
+	WorkspaceID  int64
+	DocumentID   int64
+	Version      int32
+	Title        string
+	Texts        []string
+	OverlapBytes []int32
+}
+
+type CreateChunksRow struct {
+	ID           int64
+	Text         string
+	OverlapBytes pgtype.Int4
+	Embedded     bool
+}
+
+// Создает чанки версии документа одним запросом; тексты и длины их перекрытий с предыдущим чанком
+// передаются массивами в порядке следования в документе.
+// Эмбеддинги идентичных чанков того же рабочего пространства копируются так же, как в CreateChunk.
+// Возвращает ID новых чанков, их текст и перекрытие, по которым строки сопоставляются с входными
+// (порядок строк RETURNING не гарантирован), и признак того, что эмбеддинг скопирован.
+func (q *Queries) CreateChunks(ctx context.Context, arg CreateChunksParams) ([]CreateChunksRow, error) {
+	rows, err := q.db.Query(ctx, createChunks,
+		arg.UserID,
+		arg.WorkspaceID,
+		arg.DocumentID,
+		arg.Version,
+		arg.Title,
+		arg.Texts,
+		arg.OverlapBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreateChunksRow
+	for rows.Next() {
+		var i CreateChunksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Text,
+			&i.OverlapBytes,
+			&i.Embedded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dequeueChunkEmbeddings = `-- name: DequeueChunkEmbeddings :exec
+SELECT dequeue_chunk_embeddings($1::bigint[])
+`
+
+// Убирает из очереди векторизатора чанки, которым эмбеддинг достался от идентичного чанка.
+func (q *Queries) DequeueChunkEmbeddings(ctx context.Context, chunkIds []int64) error {
+	_, err := q.db.Exec(ctx, dequeueChunkEmbeddings, chunkIds)
+	return err
+}
+
 const getChunksByDocumentID = `-- name: GetChunksByDocumentID :many
-SELECT id, user_id, document_id, title, text, embedding
-FROM chunks
-WHERE document_id = $1 AND user_id = $2
-ORDER BY id
+SELECT
+  c.id,
+  c.user_id,
+  c.workspace_id,
+  c.document_id,
+  c.title,
+  c.text,
+  c.overlap_bytes,
+  (c.embedding IS NOT NULL)::bool AS embedded
+FROM chunks c
+WHERE c.document_id = $1
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = $1 AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = $2)
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = $2)
+  )
+ORDER BY c.id
 `
 
 type GetChunksByDocumentIDParams struct {
@@ -64,24 +245,37 @@ type GetChunksByDocumentIDParams struct {
 	UserID     int64
 }
 
-// Возвращает все чанки для конкретного документа (для отображения или сборки полного текста).
-// ВАЖНО: также проверяет user_id для безопасности.
-func (q *Queries) GetChunksByDocumentID(ctx context.Context, arg GetChunksByDocumentIDParams) ([]Chunk, error) {
+type GetChunksByDocumentIDRow struct {
+	ID           int64
+	UserID       pgtype.Int8
+	WorkspaceID  int64
+	DocumentID   int64
+	Title        string
+	Text         string
+	OverlapBytes pgtype.Int4
+	Embedded     bool
+}
+
+// Возвращает все чанки текущей версии документа (для отображения или сборки полного текста).
+// ВАЖНО: также проверяет членство пользователя в рабочем пространстве или выданный ему доступ к документу.
+func (q *Queries) GetChunksByDocumentID(ctx context.Context, arg GetChunksByDocumentIDParams) ([]GetChunksByDocumentIDRow, error) {
 	rows, err := q.db.Query(ctx, getChunksByDocumentID, arg.DocumentID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Chunk
+	var items []GetChunksByDocumentIDRow
 	for rows.Next() {
-		var i Chunk
+		var i GetChunksByDocumentIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
+			&i.WorkspaceID,
 			&i.DocumentID,
 			&i.Title,
 			&i.Text,
-			&i.Embedding,
+			&i.OverlapBytes,
+			&i.Embedded,
 		); err != nil {
 			return nil, err
 		}
@@ -93,15 +287,220 @@ func (q *Queries) GetChunksByDocumentID(ctx context.Context, arg GetChunksByDocu
 	return items, nil
 }
 
-const searchChunksInDocument = `-- name: SearchChunksInDocument :many
+const getChunksByDocumentIDPage = `-- name: GetChunksByDocumentIDPage :many
 
 SELECT
-    id,
-    document_id,
-    text,
-    embedding <=> $1 AS distance
+  c.id,
+  c.user_id,
+  c.workspace_id,
+  c.document_id,
+  c.title,
+  c.text,
+  c.overlap_bytes,
+  (c.embedding IS NOT NULL)::bool AS embedded
+FROM chunks c
+WHERE c.document_id = $1
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = $1 AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = $2)
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = $2)
+  )
+ORDER BY c.id
+LIMIT $4 OFFSET $3
+`
+
+type GetChunksByDocumentIDPageParams struct {
+	DocumentID  int64
+	UserID      int64
+	OffsetCount int32
+	LimitCount  int32
+}
+
+type GetChunksByDocumentIDPageRow struct {
+	ID           int64
+	UserID       pgtype.Int8
+	WorkspaceID  int64
+	DocumentID   int64
+	Title        string
+	Text         string
+	OverlapBytes pgtype.Int4
+	Embedded     bool
+}
+
+// Сортировка по ID, чтобы чанки шли в порядке их создания
+// Возвращает страницу чанков текущей версии документа в порядке их создания вместе со статусом эмбеддинга.
+// ВАЖНО: также проверяет членство пользователя в рабочем пространстве или выданный ему доступ к документу.
+func (q *Queries) GetChunksByDocumentIDPage(ctx context.Context, arg GetChunksByDocumentIDPageParams) ([]GetChunksByDocumentIDPageRow, error) {
+	rows, err := q.db.Query(ctx, getChunksByDocumentIDPage,
+		arg.DocumentID,
+		arg.UserID,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChunksByDocumentIDPageRow
+	for rows.Next() {
+		var i GetChunksByDocumentIDPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.DocumentID,
+			&i.Title,
+			&i.Text,
+			&i.OverlapBytes,
+			&i.Embedded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDocumentChunks = `-- name: GetDocumentChunks :many
+SELECT
+  id,
+  user_id,
+  workspace_id,
+  document_id,
+  title,
+  text,
+  overlap_bytes,
+  (embedding IS NOT NULL)::bool AS embedded
+FROM chunks
+WHERE document_id = $1
+  AND version = (SELECT d.current_version FROM documents d WHERE d.id = $1 AND d.deleted_at IS NULL)
+ORDER BY id
+`
+
+type GetDocumentChunksRow struct {
+	ID           int64
+	UserID       pgtype.Int8
+	WorkspaceID  int64
+	DocumentID   int64
+	Title        string
+	Text         string
+	OverlapBytes pgtype.Int4
+	Embedded     bool
+}
+
+// Возвращает все чанки текущей версии документа БЕЗ проверки доступа.
+// ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
+func (q *Queries) GetDocumentChunks(ctx context.Context, documentID int64) ([]GetDocumentChunksRow, error) {
+	rows, err := q.db.Query(ctx, getDocumentChunks, documentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDocumentChunksRow
+	for rows.Next() {
+		var i GetDocumentChunksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.DocumentID,
+			&i.Title,
+			&i.Text,
+			&i.OverlapBytes,
+			&i.Embedded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnembeddedVersionChunkIDs = `-- name: GetUnembeddedVersionChunkIDs :many
+SELECT id
 FROM chunks
-WHERE user_id = $2 AND document_id = $3
+WHERE document_id = $1 AND version = $2 AND embedding IS NULL
+`
+
+type GetUnembeddedVersionChunkIDsParams struct {
+	DocumentID int64
+	Version    int32
+}
+
+// Возвращает ID чанков версии документа, эмбеддинг которых еще не посчитан.
+func (q *Queries) GetUnembeddedVersionChunkIDs(ctx context.Context, arg GetUnembeddedVersionChunkIDsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getUnembeddedVersionChunkIDs, arg.DocumentID, arg.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVersionChunkTexts = `-- name: GetVersionChunkTexts :many
+SELECT text
+FROM chunks
+WHERE document_id = $1 AND version = $2
+ORDER BY id
+`
+
+type GetVersionChunkTextsParams struct {
+	DocumentID int64
+	Version    int32
+}
+
+// Возвращает тексты чанков версии документа в порядке их создания (для сравнения версий).
+func (q *Queries) GetVersionChunkTexts(ctx context.Context, arg GetVersionChunkTextsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getVersionChunkTexts, arg.DocumentID, arg.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var text string
+		if err := rows.Scan(&text); err != nil {
+			return nil, err
+		}
+		items = append(items, text)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchChunksInDocument = `-- name: SearchChunksInDocument :many
+
+SELECT
+    c.id,
+    c.document_id,
+    c.text,
+    c.embedding <=> $1 AS distance
+FROM chunks c
+WHERE c.document_id = $3
+  AND c.version = (SELECT d.current_version FROM documents d WHERE d.id = $3 AND d.deleted_at IS NULL)
+  AND (
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = $2)
+    OR c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = $2)
+  )
 ORDER BY distance ASC
 LIMIT $4
 `
@@ -121,7 +520,7 @@ type SearchChunksInDocumentRow struct {
 }
 
 // Ограничиваем количество результатов
-// Выполняет семантический поиск по чанкам ОДНОГО документа.
+// Выполняет семантический поиск по чанкам текущей версии ОДНОГО документа.
 func (q *Queries) SearchChunksInDocument(ctx context.Context, arg SearchChunksInDocumentParams) ([]SearchChunksInDocumentRow, error) {
 	rows, err := q.db.Query(ctx, searchChunksInDocument,
 		arg.Embedding,
@@ -152,24 +551,91 @@ func (q *Queries) SearchChunksInDocument(ctx context.Context, arg SearchChunksIn
 	return items, nil
 }
 
-const searchUserChunks = `-- name: SearchUserChunks :many
-
+const searchDocumentChunks = `-- name: SearchDocumentChunks :many
 SELECT
     id,
     document_id,
-    title,
     text,
-    embedding <=> $1 AS distance -- Рассчитываем косинусное расстояние до вектора-запроса
+    embedding <=> $1 AS distance
 FROM chunks
-WHERE user_id = $2 -- ВАЖНО: строгая фильтрация по пользователю
-ORDER BY distance ASC -- Сортируем по возрастанию расстояния (самые похожие - в начале)
+WHERE document_id = $2
+  AND version = (SELECT d.current_version FROM documents d WHERE d.id = $2 AND d.deleted_at IS NULL)
+ORDER BY distance ASC
 LIMIT $3
 `
 
+type SearchDocumentChunksParams struct {
+	Embedding  pgvector.Vector
+	DocumentID int64
+	Limit      int32
+}
+
+type SearchDocumentChunksRow struct {
+	ID         int64
+	DocumentID int64
+	Text       string
+	Distance   interface{}
+}
+
+// Выполняет семантический поиск по чанкам текущей версии документа БЕЗ проверки доступа.
+// ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
+func (q *Queries) SearchDocumentChunks(ctx context.Context, arg SearchDocumentChunksParams) ([]SearchDocumentChunksRow, error) {
+	rows, err := q.db.Query(ctx, searchDocumentChunks, arg.Embedding, arg.DocumentID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchDocumentChunksRow
+	for rows.Next() {
+		var i SearchDocumentChunksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentID,
+			&i.Text,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUserChunks = `-- name: SearchUserChunks :many
+SELECT
+    c.id,
+    c.document_id,
+    c.title,
+    c.text,
+    c.embedding <=> $1 AS distance -- Рассчитываем косинусное расстояние до вектора-запроса
+FROM chunks c
+JOIN documents d ON d.id = c.document_id AND d.current_version = c.version AND d.deleted_at IS NULL
+WHERE ( -- ВАЖНО: строгая фильтрация по доступным пользователю пространствам и документам
+    c.workspace_id IN (SELECT m.workspace_id FROM workspace_members m WHERE m.user_id = $2)
+    OR (
+      $3::bigint IS NULL
+      AND c.document_id IN (SELECT s.document_id FROM document_shares s WHERE s.user_id = $2)
+    )
+  )
+  AND ($3::bigint IS NULL OR c.workspace_id = $3)
+  AND ($4::text[] IS NULL OR d.tags @> $4::text[])
+  AND ($5::jsonb IS NULL OR d.metadata @> $5::jsonb)
+  AND ($6::bigint[] IS NULL OR d.folder_id = ANY($6::bigint[]))
+ORDER BY distance ASC -- Сортируем по возрастанию расстояния (самые похожие - в начале)
+LIMIT $7
+`
+
 type SearchUserChunksParams struct {
-	Embedding pgvector.Vector
-	UserID    int64
-	Limit     int32
+	Embedding   pgvector.Vector
+	UserID      int64
+	WorkspaceID pgtype.Int8
+	Tags        []string
+	Metadata    []byte
+	FolderIds   []int64
+	LimitCount  int32
 }
 
 type SearchUserChunksRow struct {
@@ -180,11 +646,21 @@ type SearchUserChunksRow struct {
 	Distance   interface{}
 }
 
-// Сортировка по ID, чтобы чанки шли в порядке их создания
 // Самый важный запрос: выполняет семантический поиск по чанкам.
-// Находит N самых похожих чанков для заданного вектора-запроса, но только среди документов конкретного пользователя.
+// Находит N самых похожих чанков для заданного вектора-запроса, но только среди рабочих пространств, в которых состоит пользователь,
+// и документов, которыми с ним поделились.
+// Если передан workspace_id, поиск ограничивается этим пространством. Ищет только по текущим версиям документов не из корзины.
+// Если переданы теги или пользовательские поля, документ должен содержать их все. Если переданы folder_ids, ищет только в документах из этих папок.
 func (q *Queries) SearchUserChunks(ctx context.Context, arg SearchUserChunksParams) ([]SearchUserChunksRow, error) {
-	rows, err := q.db.Query(ctx, searchUserChunks, arg.Embedding, arg.UserID, arg.Limit)
+	rows, err := q.db.Query(ctx, searchUserChunks,
+		arg.Embedding,
+		arg.UserID,
+		arg.WorkspaceID,
+		arg.Tags,
+		arg.Metadata,
+		arg.FolderIds,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
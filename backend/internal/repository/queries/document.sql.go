@@ -7,83 +7,350 @@ package queries
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countUserDocuments = `-- name: CountUserDocuments :one
+SELECT COUNT(*)
+FROM documents d
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = $1
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = $1
+WHERE (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
+  AND ($2::bigint IS NULL OR d.workspace_id = $2)
+  AND ($3::bigint[] IS NULL OR d.folder_id = ANY($3::bigint[]))
+  AND ($4::text IS NULL OR strpos(lower(d.filename), lower($4::text)) > 0)
+  AND ($5::text[] IS NULL OR d.tags @> $5::text[])
+  AND (
+    $6::text IS NULL
+    OR ($6::text = 'processing') = (coalesce(v.null_embeddings_count, 0) > 0)
+  )
+`
+
+type CountUserDocumentsParams struct {
+	UserID      int64
+	WorkspaceID pgtype.Int8
+	FolderIds   []int64
+	Filename    pgtype.Text
+	Tags        []string
+	Status      pgtype.Text
+}
+
+// Возвращает количество документов, доступных пользователю, с теми же фильтрами, что и GetUserDocuments.
+func (q *Queries) CountUserDocuments(ctx context.Context, arg CountUserDocumentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserDocuments,
+		arg.UserID,
+		arg.WorkspaceID,
+		arg.FolderIds,
+		arg.Filename,
+		arg.Tags,
+		arg.Status,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createDocument = `-- name: CreateDocument :one
-INSERT INTO documents (user_id, filename)
-VALUES ($1, $2)
-RETURNING id, user_id, filename
+INSERT INTO documents (user_id, workspace_id, filename, size_bytes, blob_id, content_sha256)
+VALUES (
+  $1::bigint,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+RETURNING id, user_id, filename, workspace_id, size_bytes, blob_id, content_sha256, current_version, deleted_at, deleted_by, created_at, updated_at, description, tags, metadata, folder_id
 `
 
 type CreateDocumentParams struct {
-	UserID   int64
-	Filename string
+	UserID        int64
+	WorkspaceID   int64
+	Filename      string
+	SizeBytes     int64
+	BlobID        pgtype.Int8
+	ContentSha256 pgtype.Text
 }
 
-// Создает запись о новом документе для указанного пользователя.
+// Создает запись о новом документе в рабочем пространстве.
 // Возвращает полную запись о новом документе.
 func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams) (Document, error) {
-	row := q.db.QueryRow(ctx, createDocument, arg.UserID, arg.Filename)
+	row := q.db.QueryRow(ctx, createDocument,
+		arg.UserID,
+		arg.WorkspaceID,
+		arg.Filename,
+		arg.SizeBytes,
+		arg.BlobID,
+		arg.ContentSha256,
+	)
 	var i Document
-	err := row.Scan(&i.ID, &i.UserID, &i.Filename)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Filename,
+		&i.WorkspaceID,
+		&i.SizeBytes,
+		&i.BlobID,
+		&i.ContentSha256,
+		&i.CurrentVersion,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.FolderID,
+	)
 	return i, err
 }
 
-const deleteUserDocument = `-- name: DeleteUserDocument :exec
+const deleteTrashedDocuments = `-- name: DeleteTrashedDocuments :many
 DELETE FROM documents
-WHERE id = $1 AND user_id = $2
+WHERE id = ANY($1::bigint[])
+  AND deleted_at IS NOT NULL
+RETURNING id
 `
 
-type DeleteUserDocumentParams struct {
-	ID     int64
-	UserID int64
+// Окончательно удаляет документы из корзины вместе с версиями и чанками.
+// Документы, которые успели восстановить, не удаляются. Возвращает ID удаленных документов.
+func (q *Queries) DeleteTrashedDocuments(ctx context.Context, ids []int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, deleteTrashedDocuments, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDocumentByID = `-- name: GetDocumentByID :one
+SELECT
+  d.id,
+  d.user_id,
+  d.workspace_id,
+  d.filename,
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
+FROM documents d
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+WHERE d.id = $1 AND d.deleted_at IS NULL
+LIMIT 1
+`
+
+type GetDocumentByIDRow struct {
+	ID                   int64
+	UserID               pgtype.Int8
+	WorkspaceID          int64
+	Filename             string
+	SizeBytes            int64
+	BlobID               pgtype.Int8
+	ContentSha256        pgtype.Text
+	CurrentVersion       int32
+	FolderID             pgtype.Int8
+	Description          string
+	Tags                 []string
+	Metadata             []byte
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	NullEmbeddingsCount  int64
+	TotalEmbeddingsCount int64
+}
+
+// Находит документ по его ID БЕЗ проверки доступа.
+// ВАЖНО: использовать только после проверки доступа вызывающим кодом (например, по публичной ссылке).
+func (q *Queries) GetDocumentByID(ctx context.Context, id int64) (GetDocumentByIDRow, error) {
+	row := q.db.QueryRow(ctx, getDocumentByID, id)
+	var i GetDocumentByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WorkspaceID,
+		&i.Filename,
+		&i.SizeBytes,
+		&i.BlobID,
+		&i.ContentSha256,
+		&i.CurrentVersion,
+		&i.FolderID,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NullEmbeddingsCount,
+		&i.TotalEmbeddingsCount,
+	)
+	return i, err
 }
 
-// Удаляет документ по его ID.
-// ВАЖНО: также проверяет user_id для безопасности.
-func (q *Queries) DeleteUserDocument(ctx context.Context, arg DeleteUserDocumentParams) error {
-	_, err := q.db.Exec(ctx, deleteUserDocument, arg.ID, arg.UserID)
-	return err
+const getDocumentQuotaUserIDs = `-- name: GetDocumentQuotaUserIDs :many
+SELECT d.user_id::bigint AS user_id
+FROM documents d
+WHERE d.id = $1 AND d.user_id IS NOT NULL
+UNION
+SELECT v.created_by::bigint
+FROM document_versions v
+WHERE v.document_id = $1 AND v.created_by IS NOT NULL
+ORDER BY user_id
+`
+
+// Возвращает пользователей, квоты которых занимает документ: загрузившего его и авторов версий.
+func (q *Queries) GetDocumentQuotaUserIDs(ctx context.Context, id int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getDocumentQuotaUserIDs, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredTrashedDocumentIDs = `-- name: GetExpiredTrashedDocumentIDs :many
+SELECT id
+FROM documents
+WHERE deleted_at < $1
+ORDER BY deleted_at
+LIMIT $2
+`
+
+type GetExpiredTrashedDocumentIDsParams struct {
+	DeletedBefore pgtype.Timestamptz
+	LimitCount    int32
+}
+
+// Возвращает ID документов, пролежавших в корзине дольше срока хранения, начиная с самых старых.
+func (q *Queries) GetExpiredTrashedDocumentIDs(ctx context.Context, arg GetExpiredTrashedDocumentIDsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getExpiredTrashedDocumentIDs, arg.DeletedBefore, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getUserDocumentByID = `-- name: GetUserDocumentByID :one
 SELECT
   d.id,
   d.user_id,
+  d.workspace_id,
   d.filename,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id AND c.embedding IS NULL
-  ) AS null_embeddings_count,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id
-  ) AS total_embeddings_count
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(m.role, '')::text AS workspace_role,
+  coalesce(s.permission, '')::text AS share_permission,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
 FROM documents d
-WHERE d.id = $1 AND d.user_id = $2
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = $1
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = $1
+WHERE d.id = $2
+  AND (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
 LIMIT 1
 `
 
 type GetUserDocumentByIDParams struct {
-	ID     int64
 	UserID int64
+	ID     int64
 }
 
 type GetUserDocumentByIDRow struct {
 	ID                   int64
-	UserID               int64
+	UserID               pgtype.Int8
+	WorkspaceID          int64
 	Filename             string
+	SizeBytes            int64
+	BlobID               pgtype.Int8
+	ContentSha256        pgtype.Text
+	CurrentVersion       int32
+	FolderID             pgtype.Int8
+	Description          string
+	Tags                 []string
+	Metadata             []byte
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	WorkspaceRole        string
+	SharePermission      string
 	NullEmbeddingsCount  int64
 	TotalEmbeddingsCount int64
 }
 
 // Находит конкретный документ по его ID.
-// ВАЖНО: также проверяет user_id для безопасности, чтобы пользователь не мог получить чужой документ.
+// ВАЖНО: также проверяет членство пользователя в рабочем пространстве документа или выданный ему доступ,
+// чтобы нельзя было получить чужой документ.
 func (q *Queries) GetUserDocumentByID(ctx context.Context, arg GetUserDocumentByIDParams) (GetUserDocumentByIDRow, error) {
-	row := q.db.QueryRow(ctx, getUserDocumentByID, arg.ID, arg.UserID)
+	row := q.db.QueryRow(ctx, getUserDocumentByID, arg.UserID, arg.ID)
 	var i GetUserDocumentByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorkspaceID,
 		&i.Filename,
+		&i.SizeBytes,
+		&i.BlobID,
+		&i.ContentSha256,
+		&i.CurrentVersion,
+		&i.FolderID,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WorkspaceRole,
+		&i.SharePermission,
 		&i.NullEmbeddingsCount,
 		&i.TotalEmbeddingsCount,
 	)
@@ -94,28 +361,123 @@ const getUserDocuments = `-- name: GetUserDocuments :many
 SELECT
   d.id,
   d.user_id,
+  d.workspace_id,
   d.filename,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id AND c.embedding IS NULL
-  ) AS null_embeddings_count,
-  (
-    SELECT COUNT(*) FROM chunks c WHERE c.document_id = d.id
-  ) AS total_embeddings_count
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  coalesce(m.role, '')::text AS workspace_role,
+  coalesce(s.permission, '')::text AS share_permission,
+  coalesce(v.null_embeddings_count, 0)::bigint AS null_embeddings_count,
+  coalesce(v.chunks_count, 0)::bigint AS total_embeddings_count
 FROM documents d
-WHERE d.user_id = $1
+LEFT JOIN document_versions v ON v.document_id = d.id AND v.version = d.current_version
+LEFT JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = $1
+LEFT JOIN document_shares s ON s.document_id = d.id AND s.user_id = $1
+WHERE (m.user_id IS NOT NULL OR s.user_id IS NOT NULL)
+  AND d.deleted_at IS NULL
+  AND ($2::bigint IS NULL OR d.workspace_id = $2)
+  AND ($3::bigint[] IS NULL OR d.folder_id = ANY($3::bigint[]))
+  AND ($4::text IS NULL OR strpos(lower(d.filename), lower($4::text)) > 0)
+  AND ($5::text[] IS NULL OR d.tags @> $5::text[])
+  AND (
+    $6::text IS NULL
+    OR ($6::text = 'processing') = (coalesce(v.null_embeddings_count, 0) > 0)
+  )
+  AND (
+    $7::bigint IS NULL
+    OR CASE
+      WHEN $8::text = 'name' AND NOT $9::bool
+        THEN (lower(d.filename), d.id) > (lower($10::text), $7::bigint)
+      WHEN $8::text = 'name'
+        THEN (lower(d.filename), d.id) < (lower($10::text), $7::bigint)
+      WHEN $8::text = 'size' AND NOT $9::bool
+        THEN (d.size_bytes, d.id) > ($11::bigint, $7::bigint)
+      WHEN $8::text = 'size'
+        THEN (d.size_bytes, d.id) < ($11::bigint, $7::bigint)
+      WHEN NOT $9::bool
+        THEN (d.created_at, d.id) > ($12::timestamptz, $7::bigint)
+      ELSE (d.created_at, d.id) < ($12::timestamptz, $7::bigint)
+    END
+  )
+ORDER BY
+  CASE WHEN $8::text = 'name' AND NOT $9::bool THEN lower(d.filename) END ASC,
+  CASE WHEN $8::text = 'name' AND $9::bool THEN lower(d.filename) END DESC,
+  CASE WHEN $8::text = 'size' AND NOT $9::bool THEN d.size_bytes END ASC,
+  CASE WHEN $8::text = 'size' AND $9::bool THEN d.size_bytes END DESC,
+  CASE WHEN $8::text NOT IN ('name', 'size') AND NOT $9::bool THEN d.created_at END ASC,
+  CASE WHEN $8::text NOT IN ('name', 'size') AND $9::bool THEN d.created_at END DESC,
+  CASE WHEN NOT $9::bool THEN d.id END ASC,
+  CASE WHEN $9::bool THEN d.id END DESC
+LIMIT $13::int
 `
 
+type GetUserDocumentsParams struct {
+	UserID         int64
+	WorkspaceID    pgtype.Int8
+	FolderIds      []int64
+	Filename       pgtype.Text
+	Tags           []string
+	Status         pgtype.Text
+	AfterID        pgtype.Int8
+	Sort           string
+	Descending     bool
+	AfterName      pgtype.Text
+	AfterSize      pgtype.Int8
+	AfterCreatedAt pgtype.Timestamptz
+	LimitCount     pgtype.Int4
+}
+
 type GetUserDocumentsRow struct {
 	ID                   int64
-	UserID               int64
+	UserID               pgtype.Int8
+	WorkspaceID          int64
 	Filename             string
+	SizeBytes            int64
+	BlobID               pgtype.Int8
+	ContentSha256        pgtype.Text
+	CurrentVersion       int32
+	FolderID             pgtype.Int8
+	Description          string
+	Tags                 []string
+	Metadata             []byte
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	WorkspaceRole        string
+	SharePermission      string
 	NullEmbeddingsCount  int64
 	TotalEmbeddingsCount int64
 }
 
-// Возвращает список всех документов для конкретного пользователя, включая количество необработанных и общее количество эмбеддингов.
-func (q *Queries) GetUserDocuments(ctx context.Context, userID int64) ([]GetUserDocumentsRow, error) {
-	rows, err := q.db.Query(ctx, getUserDocuments, userID)
+// Возвращает страницу документов из рабочих пространств, в которых состоит пользователь,
+// а также документов, которыми с ним поделились, включая количество необработанных и общее количество эмбеддингов текущей версии.
+// Если передан workspace_id, список ограничивается этим пространством, если folder_ids — документами из этих папок.
+// filename ищется как подстрока без учета регистра, tags должны быть у документа все, status: processing или ready.
+// Сортировка по sort (name, createdAt или size) с ID для однозначности; страница начинается после документа из after_*.
+// Без limit_count возвращаются все подходящие документы.
+func (q *Queries) GetUserDocuments(ctx context.Context, arg GetUserDocumentsParams) ([]GetUserDocumentsRow, error) {
+	rows, err := q.db.Query(ctx, getUserDocuments,
+		arg.UserID,
+		arg.WorkspaceID,
+		arg.FolderIds,
+		arg.Filename,
+		arg.Tags,
+		arg.Status,
+		arg.AfterID,
+		arg.Sort,
+		arg.Descending,
+		arg.AfterName,
+		arg.AfterSize,
+		arg.AfterCreatedAt,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +488,20 @@ func (q *Queries) GetUserDocuments(ctx context.Context, userID int64) ([]GetUser
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
+			&i.WorkspaceID,
 			&i.Filename,
+			&i.SizeBytes,
+			&i.BlobID,
+			&i.ContentSha256,
+			&i.CurrentVersion,
+			&i.FolderID,
+			&i.Description,
+			&i.Tags,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceRole,
+			&i.SharePermission,
 			&i.NullEmbeddingsCount,
 			&i.TotalEmbeddingsCount,
 		); err != nil {
@@ -139,3 +514,299 @@ func (q *Queries) GetUserDocuments(ctx context.Context, userID int64) ([]GetUser
 	}
 	return items, nil
 }
+
+const getUserTrashedDocumentIDs = `-- name: GetUserTrashedDocumentIDs :many
+SELECT d.id
+FROM documents d
+JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = $1
+WHERE d.deleted_at IS NOT NULL
+  AND m.role IN ('owner', 'editor')
+  AND ($2::bigint IS NULL OR d.workspace_id = $2)
+`
+
+type GetUserTrashedDocumentIDsParams struct {
+	UserID      int64
+	WorkspaceID pgtype.Int8
+}
+
+// Возвращает ID документов в корзине, которые пользователь может удалить окончательно.
+func (q *Queries) GetUserTrashedDocumentIDs(ctx context.Context, arg GetUserTrashedDocumentIDsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getUserTrashedDocumentIDs, arg.UserID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserTrashedDocuments = `-- name: GetUserTrashedDocuments :many
+SELECT
+  d.id,
+  d.user_id,
+  d.workspace_id,
+  d.filename,
+  d.size_bytes,
+  d.blob_id,
+  d.content_sha256,
+  d.current_version,
+  d.folder_id,
+  d.description,
+  d.tags,
+  d.metadata,
+  d.created_at,
+  d.updated_at,
+  m.role AS workspace_role,
+  d.deleted_at::timestamptz AS deleted_at,
+  d.deleted_by
+FROM documents d
+JOIN workspace_members m ON m.workspace_id = d.workspace_id AND m.user_id = $1
+WHERE d.deleted_at IS NOT NULL
+  AND m.role IN ('owner', 'editor')
+  AND ($2::bigint IS NULL OR d.workspace_id = $2)
+ORDER BY d.deleted_at DESC
+`
+
+type GetUserTrashedDocumentsParams struct {
+	UserID      int64
+	WorkspaceID pgtype.Int8
+}
+
+type GetUserTrashedDocumentsRow struct {
+	ID             int64
+	UserID         pgtype.Int8
+	WorkspaceID    int64
+	Filename       string
+	SizeBytes      int64
+	BlobID         pgtype.Int8
+	ContentSha256  pgtype.Text
+	CurrentVersion int32
+	FolderID       pgtype.Int8
+	Description    string
+	Tags           []string
+	Metadata       []byte
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	WorkspaceRole  string
+	DeletedAt      pgtype.Timestamptz
+	DeletedBy      pgtype.Int8
+}
+
+// Возвращает документы в корзине из рабочих пространств, где пользователь владелец или редактор.
+// Если передан workspace_id, список ограничивается этим пространством.
+func (q *Queries) GetUserTrashedDocuments(ctx context.Context, arg GetUserTrashedDocumentsParams) ([]GetUserTrashedDocumentsRow, error) {
+	rows, err := q.db.Query(ctx, getUserTrashedDocuments, arg.UserID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserTrashedDocumentsRow
+	for rows.Next() {
+		var i GetUserTrashedDocumentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorkspaceID,
+			&i.Filename,
+			&i.SizeBytes,
+			&i.BlobID,
+			&i.ContentSha256,
+			&i.CurrentVersion,
+			&i.FolderID,
+			&i.Description,
+			&i.Tags,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceRole,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceDocumentIDByContentHash = `-- name: GetWorkspaceDocumentIDByContentHash :one
+SELECT id
+FROM documents
+WHERE workspace_id = $1 AND content_sha256 = $2 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1
+`
+
+type GetWorkspaceDocumentIDByContentHashParams struct {
+	WorkspaceID   int64
+	ContentSha256 pgtype.Text
+}
+
+// Ищет в рабочем пространстве документ с таким же хешем нормализованного содержимого.
+func (q *Queries) GetWorkspaceDocumentIDByContentHash(ctx context.Context, arg GetWorkspaceDocumentIDByContentHashParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceDocumentIDByContentHash, arg.WorkspaceID, arg.ContentSha256)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const lockDocumentCurrentVersion = `-- name: LockDocumentCurrentVersion :one
+SELECT current_version
+FROM documents
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+// Блокирует документ до конца транзакции и возвращает номер его текущей версии.
+// Нужна, чтобы параллельные загрузки новых версий не получили одинаковый номер.
+func (q *Queries) LockDocumentCurrentVersion(ctx context.Context, id int64) (int32, error) {
+	row := q.db.QueryRow(ctx, lockDocumentCurrentVersion, id)
+	var current_version int32
+	err := row.Scan(&current_version)
+	return current_version, err
+}
+
+const restoreUserDocument = `-- name: RestoreUserDocument :execrows
+UPDATE documents d
+SET deleted_at = NULL, deleted_by = NULL
+FROM workspace_members m
+WHERE d.id = $1
+  AND d.deleted_at IS NOT NULL
+  AND m.workspace_id = d.workspace_id
+  AND m.user_id = $2
+  AND m.role IN ('owner', 'editor')
+`
+
+type RestoreUserDocumentParams struct {
+	ID     int64
+	UserID int64
+}
+
+// Возвращает документ из корзины.
+// ВАЖНО: восстанавливать могут только владельцы и редакторы рабочего пространства документа.
+func (q *Queries) RestoreUserDocument(ctx context.Context, arg RestoreUserDocumentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreUserDocument, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setDocumentCurrentVersion = `-- name: SetDocumentCurrentVersion :one
+UPDATE documents
+SET current_version = $1,
+    size_bytes = $2,
+    blob_id = $3,
+    content_sha256 = $4,
+    updated_at = now()
+WHERE id = $5
+RETURNING id, user_id, filename, workspace_id, size_bytes, blob_id, content_sha256, current_version, deleted_at, deleted_by, created_at, updated_at, description, tags, metadata, folder_id
+`
+
+type SetDocumentCurrentVersionParams struct {
+	Version       int32
+	SizeBytes     int64
+	BlobID        pgtype.Int8
+	ContentSha256 pgtype.Text
+	ID            int64
+}
+
+// Делает версию текущей: переносит в документ ее размер, файл и хеш содержимого.
+func (q *Queries) SetDocumentCurrentVersion(ctx context.Context, arg SetDocumentCurrentVersionParams) (Document, error) {
+	row := q.db.QueryRow(ctx, setDocumentCurrentVersion,
+		arg.Version,
+		arg.SizeBytes,
+		arg.BlobID,
+		arg.ContentSha256,
+		arg.ID,
+	)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Filename,
+		&i.WorkspaceID,
+		&i.SizeBytes,
+		&i.BlobID,
+		&i.ContentSha256,
+		&i.CurrentVersion,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.FolderID,
+	)
+	return i, err
+}
+
+const trashUserDocument = `-- name: TrashUserDocument :execrows
+UPDATE documents d
+SET deleted_at = now(), deleted_by = m.user_id
+FROM workspace_members m
+WHERE d.id = $1
+  AND d.deleted_at IS NULL
+  AND m.workspace_id = d.workspace_id
+  AND m.user_id = $2
+  AND m.role IN ('owner', 'editor')
+`
+
+type TrashUserDocumentParams struct {
+	ID     int64
+	UserID int64
+}
+
+// Перемещает документ в корзину: он пропадает из списков и поиска, но чанки и эмбеддинги сохраняются до очистки.
+// ВАЖНО: удалять могут только владельцы и редакторы рабочего пространства документа.
+func (q *Queries) TrashUserDocument(ctx context.Context, arg TrashUserDocumentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, trashUserDocument, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateDocumentMetadata = `-- name: UpdateDocumentMetadata :execrows
+UPDATE documents
+SET description = coalesce($1, description),
+    tags = coalesce($2::text[], tags),
+    metadata = coalesce($3::jsonb, metadata),
+    updated_at = now()
+WHERE id = $4 AND deleted_at IS NULL
+`
+
+type UpdateDocumentMetadataParams struct {
+	Description pgtype.Text
+	Tags        []string
+	Metadata    []byte
+	ID          int64
+}
+
+// Изменяет описание, теги и пользовательские поля документа. Поля, переданные как NULL, не меняются.
+func (q *Queries) UpdateDocumentMetadata(ctx context.Context, arg UpdateDocumentMetadataParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateDocumentMetadata,
+		arg.Description,
+		arg.Tags,
+		arg.Metadata,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
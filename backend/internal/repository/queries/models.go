@@ -9,30 +9,235 @@ import (
 	"github.com/pgvector/pgvector-go"
 )
 
+type AuditLog struct {
+	ID        int64
+	UserID    pgtype.Int8
+	Action    string
+	Details   []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type Blob struct {
+	ID          int64
+	StorageKey  string
+	Sha256      string
+	SizeBytes   int64
+	ContentType string
+	CreatedAt   pgtype.Timestamptz
+}
+
 type Chunk struct {
-	ID         int64
+	ID           int64
+	UserID       pgtype.Int8
+	DocumentID   int64
+	Title        string
+	Text         string
+	Embedding    pgvector.Vector
+	WorkspaceID  int64
+	Version      int32
+	OverlapBytes pgtype.Int4
+}
+
+type Document struct {
+	ID             int64
+	UserID         pgtype.Int8
+	Filename       string
+	WorkspaceID    int64
+	SizeBytes      int64
+	BlobID         pgtype.Int8
+	ContentSha256  pgtype.Text
+	CurrentVersion int32
+	DeletedAt      pgtype.Timestamptz
+	DeletedBy      pgtype.Int8
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	Description    string
+	Tags           []string
+	Metadata       []byte
+	FolderID       pgtype.Int8
+}
+
+type DocumentShare struct {
+	DocumentID int64
 	UserID     int64
+	Permission string
+	GrantedBy  pgtype.Int8
+	CreatedAt  pgtype.Timestamptz
+}
+
+type DocumentVersion struct {
+	ID                  int64
+	DocumentID          int64
+	Version             int32
+	SizeBytes           int64
+	BlobID              pgtype.Int8
+	ContentSha256       pgtype.Text
+	CreatedBy           pgtype.Int8
+	CreatedAt           pgtype.Timestamptz
+	ChunksCount         int64
+	NullEmbeddingsCount int64
+	ReadyAt             pgtype.Timestamptz
+	ProcessingFailedAt  pgtype.Timestamptz
+}
+
+type Folder struct {
+	ID          int64
+	WorkspaceID int64
+	ParentID    pgtype.Int8
+	Name        string
+	CreatedBy   pgtype.Int8
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type LoginChallenge struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	Attempts  int32
+	ExpiresAt pgtype.Timestamptz
+}
+
+type RateLimit struct {
+	Key       string
+	Count     int64
+	ExpiresAt pgtype.Timestamptz
+}
+
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+}
+
+type SearchUsage struct {
+	UserID int64
+	Day    pgtype.Date
+	Count  int32
+}
+
+type ShareLink struct {
+	ID         int64
 	DocumentID int64
-	Title      string
-	Text       string
-	Embedding  pgvector.Vector
+	CreatedBy  pgtype.Int8
+	TokenHash  string
+	ExpiresAt  pgtype.Timestamptz
+	RevokedAt  pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
 }
 
-type Document struct {
+type Upload struct {
+	ID           string
+	UserID       int64
+	WorkspaceID  int64
+	Filename     string
+	ContentType  string
+	UploadLength int64
+	UploadOffset int64
+	DocumentID   pgtype.Int8
+	ExpiresAt    pgtype.Timestamptz
+	LockToken    pgtype.Text
+	LockedUntil  pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type UploadPart struct {
+	ID         int64
+	UploadID   string
+	PartOffset int64
+	SizeBytes  int64
+	StorageKey string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type User struct {
+	ID            int64
+	Email         string
+	PasswordHash  pgtype.Text
+	EmailVerified bool
+	Role          string
+	DisabledAt    pgtype.Timestamptz
+}
+
+type UserIdentity struct {
+	ID        int64
+	UserID    int64
+	Issuer    string
+	Subject   string
+	Email     pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+type UserRecoveryCode struct {
 	ID       int64
 	UserID   int64
-	Filename string
+	CodeHash string
+	UsedAt   pgtype.Timestamptz
 }
 
-type RefreshToken struct {
+type UserToken struct {
 	ID        int64
 	UserID    int64
+	Purpose   string
 	TokenHash string
 	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
 }
 
-type User struct {
-	ID           int64
-	Email        string
-	PasswordHash string
+type UserTotp struct {
+	UserID          int64
+	SecretEncrypted string
+	EnabledAt       pgtype.Timestamptz
+	LastUsedStep    int64
+	CreatedAt       pgtype.Timestamptz
+}
+
+type Webhook struct {
+	ID              int64
+	WorkspaceID     int64
+	Url             string
+	SecretEncrypted string
+	Events          []string
+	Enabled         bool
+	CreatedBy       pgtype.Int8
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastAttemptAt  pgtype.Timestamptz
+	ResponseStatus pgtype.Int4
+	ResponseBody   pgtype.Text
+	Error          pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookEvent struct {
+	ID          int64
+	WorkspaceID int64
+	Type        string
+	Data        []byte
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Workspace struct {
+	ID             int64
+	Name           string
+	PersonalUserID pgtype.Int8
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WorkspaceMember struct {
+	WorkspaceID int64
+	UserID      int64
+	Role        string
+	CreatedAt   pgtype.Timestamptz
 }
@@ -7,29 +7,72 @@ package queries
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (email, password_hash)
 VALUES ($1, $2)
-RETURNING id, email, password_hash
+RETURNING id, email, password_hash, email_verified, role, disabled_at
 `
 
 type CreateUserParams struct {
 	Email        string
-	PasswordHash string
+	PasswordHash pgtype.Text
 }
 
 // Создает нового пользователя.
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.PasswordHash)
 	var i User
-	err := row.Scan(&i.ID, &i.Email, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.Role,
+		&i.DisabledAt,
+	)
 	return i, err
 }
 
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (user_id, issuer, subject, email)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateUserIdentityParams struct {
+	UserID  int64
+	Issuer  string
+	Subject string
+	Email   pgtype.Text
+}
+
+// Привязывает внешнюю учетную запись OIDC-провайдера к пользователю.
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Issuer,
+		arg.Subject,
+		arg.Email,
+	)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1
+`
+
+// Удаляет пользователя. Документы, чанки и сессии удаляются каскадно.
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
+	return err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash
+SELECT id, email, password_hash, email_verified, role, disabled_at
 FROM users
 WHERE email = $1
 LIMIT 1
@@ -39,12 +82,19 @@ LIMIT 1
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
 	row := q.db.QueryRow(ctx, getUserByEmail, email)
 	var i User
-	err := row.Scan(&i.ID, &i.Email, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.Role,
+		&i.DisabledAt,
+	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash
+SELECT id, email, password_hash, email_verified, role, disabled_at
 FROM users
 WHERE id = $1
 LIMIT 1
@@ -54,6 +104,70 @@ LIMIT 1
 func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 	row := q.db.QueryRow(ctx, getUserByID, id)
 	var i User
-	err := row.Scan(&i.ID, &i.Email, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.Role,
+		&i.DisabledAt,
+	)
 	return i, err
 }
+
+const getUserByIdentity = `-- name: GetUserByIdentity :one
+SELECT u.id, u.email, u.password_hash, u.email_verified, u.role, u.disabled_at
+FROM users u
+JOIN user_identities i ON i.user_id = u.id
+WHERE i.issuer = $1 AND i.subject = $2
+LIMIT 1
+`
+
+type GetUserByIdentityParams struct {
+	Issuer  string
+	Subject string
+}
+
+// Находит пользователя по внешней учетной записи OIDC-провайдера.
+func (q *Queries) GetUserByIdentity(ctx context.Context, arg GetUserByIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByIdentity, arg.Issuer, arg.Subject)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
+const setUserEmailVerified = `-- name: SetUserEmailVerified :exec
+UPDATE users
+SET email_verified = TRUE
+WHERE id = $1
+`
+
+// Отмечает email пользователя как подтвержденный.
+func (q *Queries) SetUserEmailVerified(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, setUserEmailVerified, id)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           int64
+	PasswordHash pgtype.Text
+}
+
+// Обновляет хеш пароля пользователя.
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
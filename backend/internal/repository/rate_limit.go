@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type RateLimitRepository interface {
+	IncrRateLimit(ctx context.Context, key string, expiresAt time.Time) (*domain.RateLimitCounter, error)
+	GetRateLimit(ctx context.Context, key string) (*domain.RateLimitCounter, error)
+	DeleteRateLimit(ctx context.Context, key string) error
+	DeleteExpiredRateLimits(ctx context.Context) error
+}
+
+func (p *postgres) IncrRateLimit(ctx context.Context, key string, expiresAt time.Time) (*domain.RateLimitCounter, error) {
+	r, err := p.q.IncrRateLimit(ctx, queries.IncrRateLimitParams{
+		Key:       key,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RateLimitCounter{Count: r.Count, ExpiresAt: r.ExpiresAt.Time}, nil
+}
+
+func (p *postgres) GetRateLimit(ctx context.Context, key string) (*domain.RateLimitCounter, error) {
+	r, err := p.q.GetRateLimit(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RateLimitCounter{Count: r.Count, ExpiresAt: r.ExpiresAt.Time}, nil
+}
+
+func (p *postgres) DeleteRateLimit(ctx context.Context, key string) error {
+	return p.q.DeleteRateLimit(ctx, key)
+}
+
+func (p *postgres) DeleteExpiredRateLimits(ctx context.Context) error {
+	return p.q.DeleteExpiredRateLimits(ctx)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 )
@@ -18,6 +19,20 @@ type Repository interface {
 	UserRepository
 	DocumentRepository
 	ChunkRepository
+	RateLimitRepository
+	TwoFactorRepository
+	AuditRepository
+	AdminRepository
+	WorkspaceRepository
+	DocumentShareRepository
+	ShareLinkRepository
+	UsageRepository
+	BlobRepository
+	UploadRepository
+	DocumentVersionRepository
+	FolderRepository
+	DocumentEventRepository
+	WebhookRepository
 }
 
 type postgres struct {
@@ -62,3 +77,28 @@ func (p *postgres) rollbackTx(tx pgx.Tx) {
 func calcOffsetLimit(page, size int64) (int32, int32) {
 	return int32((page - 1) * size), int32(size)
 }
+
+func optionalInt8(v *int64) pgtype.Int8 {
+	if v == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *v, Valid: true}
+}
+
+func optionalText(v string) pgtype.Text {
+	return pgtype.Text{String: v, Valid: v != ""}
+}
+
+func int8Ptr(v pgtype.Int8) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+func int4Ptr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int32
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ShareLinkRepository interface {
+	CreateShareLink(ctx context.Context, documentID, createdBy int64, tokenHash string, expiresAt *time.Time) (*domain.ShareLink, error)
+	GetDocumentShareLinks(ctx context.Context, documentID int64) ([]domain.ShareLink, error)
+	GetActiveShareLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id, documentID int64) (bool, error)
+}
+
+func shareLinkToDomain(l queries.ShareLink) *domain.ShareLink {
+	link := &domain.ShareLink{
+		ID:         l.ID,
+		DocumentID: l.DocumentID,
+		CreatedBy:  l.CreatedBy.Int64,
+		CreatedAt:  l.CreatedAt.Time,
+	}
+	if l.ExpiresAt.Valid {
+		link.ExpiresAt = &l.ExpiresAt.Time
+	}
+	if l.RevokedAt.Valid {
+		link.RevokedAt = &l.RevokedAt.Time
+	}
+	return link
+}
+
+func (p *postgres) CreateShareLink(ctx context.Context, documentID, createdBy int64, tokenHash string, expiresAt *time.Time) (*domain.ShareLink, error) {
+	params := queries.CreateShareLinkParams{
+		DocumentID: documentID,
+		CreatedBy:  pgtype.Int8{Int64: createdBy, Valid: true},
+		TokenHash:  tokenHash,
+	}
+	if expiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
+	}
+
+	l, err := p.q.CreateShareLink(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return shareLinkToDomain(l), nil
+}
+
+func (p *postgres) GetDocumentShareLinks(ctx context.Context, documentID int64) ([]domain.ShareLink, error) {
+	links, err := p.q.GetDocumentShareLinks(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainLinks := make([]domain.ShareLink, len(links))
+	for i, l := range links {
+		domainLinks[i] = *shareLinkToDomain(l)
+	}
+
+	return domainLinks, nil
+}
+
+func (p *postgres) GetActiveShareLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.ShareLink, error) {
+	l, err := p.q.GetActiveShareLinkByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	return shareLinkToDomain(l), nil
+}
+
+func (p *postgres) RevokeShareLink(ctx context.Context, id, documentID int64) (bool, error) {
+	rows, err := p.q.RevokeShareLink(ctx, queries.RevokeShareLinkParams{
+		ID:         id,
+		DocumentID: documentID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TwoFactorRepository interface {
+	UpsertPendingUserTOTP(ctx context.Context, userID int64, secretEncrypted string) (bool, error)
+	GetUserTOTP(ctx context.Context, userID int64) (*domain.UserTOTP, error)
+	EnableUserTOTP(ctx context.Context, userID int64) error
+	UseUserTOTPStep(ctx context.Context, userID, step int64) (bool, error)
+	DeleteUserTOTP(ctx context.Context, userID int64) error
+
+	CreateRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error)
+	DeleteUserRecoveryCodes(ctx context.Context, userID int64) error
+
+	CreateLoginChallenge(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	IncrLoginChallengeAttempts(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, id int64) error
+	DeleteExpiredLoginChallenges(ctx context.Context, userID int64) error
+}
+
+func userTOTPToDomain(t queries.UserTotp) *domain.UserTOTP {
+	return &domain.UserTOTP{
+		UserID:          t.UserID,
+		SecretEncrypted: t.SecretEncrypted,
+		Enabled:         t.EnabledAt.Valid,
+		LastUsedStep:    t.LastUsedStep,
+	}
+}
+
+func loginChallengeToDomain(c queries.LoginChallenge) *domain.LoginChallenge {
+	return &domain.LoginChallenge{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Attempts:  c.Attempts,
+		ExpiresAt: c.ExpiresAt.Time,
+	}
+}
+
+func (p *postgres) UpsertPendingUserTOTP(ctx context.Context, userID int64, secretEncrypted string) (bool, error) {
+	rows, err := p.q.UpsertPendingUserTOTP(ctx, queries.UpsertPendingUserTOTPParams{
+		UserID:          userID,
+		SecretEncrypted: secretEncrypted,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) GetUserTOTP(ctx context.Context, userID int64) (*domain.UserTOTP, error) {
+	t, err := p.q.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return userTOTPToDomain(t), nil
+}
+
+func (p *postgres) EnableUserTOTP(ctx context.Context, userID int64) error {
+	return p.q.EnableUserTOTP(ctx, userID)
+}
+
+func (p *postgres) UseUserTOTPStep(ctx context.Context, userID, step int64) (bool, error) {
+	rows, err := p.q.UseUserTOTPStep(ctx, queries.UseUserTOTPStepParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) DeleteUserTOTP(ctx context.Context, userID int64) error {
+	return p.q.DeleteUserTOTP(ctx, userID)
+}
+
+func (p *postgres) CreateRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	return p.q.CreateRecoveryCode(ctx, queries.CreateRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+}
+
+func (p *postgres) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	rows, err := p.q.UseRecoveryCode(ctx, queries.UseRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) DeleteUserRecoveryCodes(ctx context.Context, userID int64) error {
+	return p.q.DeleteUserRecoveryCodes(ctx, userID)
+}
+
+func (p *postgres) CreateLoginChallenge(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	return p.q.CreateLoginChallenge(ctx, queries.CreateLoginChallengeParams{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+}
+
+func (p *postgres) IncrLoginChallengeAttempts(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	c, err := p.q.IncrLoginChallengeAttempts(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	return loginChallengeToDomain(c), nil
+}
+
+func (p *postgres) DeleteLoginChallenge(ctx context.Context, id int64) error {
+	return p.q.DeleteLoginChallenge(ctx, id)
+}
+
+func (p *postgres) DeleteExpiredLoginChallenges(ctx context.Context, userID int64) error {
+	return p.q.DeleteExpiredLoginChallenges(ctx, userID)
+}
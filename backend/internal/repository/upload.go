@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UploadRepository interface {
+	CreateUpload(ctx context.Context, upload domain.Upload) (*domain.Upload, error)
+	GetUserUpload(ctx context.Context, id string, userID int64) (*domain.Upload, error)
+	LockUserUpload(ctx context.Context, id string, userID int64, lockToken string, lockedUntil time.Time) (*domain.Upload, error)
+	ExtendUploadLock(ctx context.Context, id, lockToken string, lockedUntil time.Time) (bool, error)
+	UnlockUpload(ctx context.Context, id, lockToken string) error
+	AdvanceUploadOffset(ctx context.Context, id, lockToken string, oldOffset, newOffset int64) (bool, error)
+	CreateUploadPart(ctx context.Context, part domain.UploadPart) error
+	GetUploadParts(ctx context.Context, uploadID string) ([]domain.UploadPart, error)
+	DeleteUploadParts(ctx context.Context, uploadID string) ([]string, error)
+	DeleteOrphanUploadParts(ctx context.Context) ([]string, error)
+	CompleteUpload(ctx context.Context, id string, documentID int64) error
+	DeleteUserUpload(ctx context.Context, id string, userID int64) (bool, error)
+	DeleteExpiredUploads(ctx context.Context) (int64, error)
+}
+
+func uploadToDomain(u queries.Upload) *domain.Upload {
+	return &domain.Upload{
+		ID:          u.ID,
+		UserID:      u.UserID,
+		WorkspaceID: u.WorkspaceID,
+		Filename:    u.Filename,
+		ContentType: u.ContentType,
+		Length:      u.UploadLength,
+		Offset:      u.UploadOffset,
+		DocumentID:  int8Ptr(u.DocumentID),
+		ExpiresAt:   u.ExpiresAt.Time,
+		CreatedAt:   u.CreatedAt.Time,
+	}
+}
+
+func (p *postgres) CreateUpload(ctx context.Context, upload domain.Upload) (*domain.Upload, error) {
+	u, err := p.q.CreateUpload(ctx, queries.CreateUploadParams{
+		ID:           upload.ID,
+		UserID:       upload.UserID,
+		WorkspaceID:  upload.WorkspaceID,
+		Filename:     upload.Filename,
+		ContentType:  upload.ContentType,
+		UploadLength: upload.Length,
+		ExpiresAt:    pgtype.Timestamptz{Time: upload.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uploadToDomain(u), nil
+}
+
+func (p *postgres) GetUserUpload(ctx context.Context, id string, userID int64) (*domain.Upload, error) {
+	u, err := p.q.GetUserUpload(ctx, queries.GetUserUploadParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uploadToDomain(u), nil
+}
+
+func (p *postgres) LockUserUpload(ctx context.Context, id string, userID int64, lockToken string, lockedUntil time.Time) (*domain.Upload, error) {
+	u, err := p.q.LockUserUpload(ctx, queries.LockUserUploadParams{
+		LockToken:   lockToken,
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+		ID:          id,
+		UserID:      userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uploadToDomain(u), nil
+}
+
+func (p *postgres) ExtendUploadLock(ctx context.Context, id, lockToken string, lockedUntil time.Time) (bool, error) {
+	rows, err := p.q.ExtendUploadLock(ctx, queries.ExtendUploadLockParams{
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+		ID:          id,
+		LockToken:   lockToken,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) UnlockUpload(ctx context.Context, id, lockToken string) error {
+	return p.q.UnlockUpload(ctx, queries.UnlockUploadParams{
+		ID:        id,
+		LockToken: lockToken,
+	})
+}
+
+func (p *postgres) AdvanceUploadOffset(ctx context.Context, id, lockToken string, oldOffset, newOffset int64) (bool, error) {
+	rows, err := p.q.AdvanceUploadOffset(ctx, queries.AdvanceUploadOffsetParams{
+		ID:        id,
+		LockToken: lockToken,
+		OldOffset: oldOffset,
+		NewOffset: newOffset,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) CreateUploadPart(ctx context.Context, part domain.UploadPart) error {
+	return p.q.CreateUploadPart(ctx, queries.CreateUploadPartParams{
+		UploadID:   part.UploadID,
+		PartOffset: part.Offset,
+		SizeBytes:  part.SizeBytes,
+		StorageKey: part.StorageKey,
+	})
+}
+
+func (p *postgres) GetUploadParts(ctx context.Context, uploadID string) ([]domain.UploadPart, error) {
+	rows, err := p.q.GetUploadParts(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]domain.UploadPart, len(rows))
+	for i, r := range rows {
+		parts[i] = domain.UploadPart{
+			UploadID:   r.UploadID,
+			Offset:     r.PartOffset,
+			SizeBytes:  r.SizeBytes,
+			StorageKey: r.StorageKey,
+		}
+	}
+
+	return parts, nil
+}
+
+func (p *postgres) DeleteUploadParts(ctx context.Context, uploadID string) ([]string, error) {
+	return p.q.DeleteUploadParts(ctx, uploadID)
+}
+
+func (p *postgres) DeleteOrphanUploadParts(ctx context.Context) ([]string, error) {
+	return p.q.DeleteOrphanUploadParts(ctx)
+}
+
+func (p *postgres) CompleteUpload(ctx context.Context, id string, documentID int64) error {
+	return p.q.CompleteUpload(ctx, queries.CompleteUploadParams{
+		ID:         id,
+		DocumentID: pgtype.Int8{Int64: documentID, Valid: true},
+	})
+}
+
+func (p *postgres) DeleteUserUpload(ctx context.Context, id string, userID int64) (bool, error) {
+	rows, err := p.q.DeleteUserUpload(ctx, queries.DeleteUserUploadParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) DeleteExpiredUploads(ctx context.Context) (int64, error) {
+	return p.q.DeleteExpiredUploads(ctx)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UsageRepository interface {
+	GetUserUsage(ctx context.Context, userID int64, day time.Time) (*domain.Usage, error)
+	IncrSearchUsage(ctx context.Context, userID int64, day time.Time) (int64, error)
+	LockUserQuota(ctx context.Context, userID int64) error
+}
+
+func (p *postgres) GetUserUsage(ctx context.Context, userID int64, day time.Time) (*domain.Usage, error) {
+	u, err := p.q.GetUserUsage(ctx, queries.GetUserUsageParams{
+		UserID: userID,
+		Day:    pgtype.Date{Time: day, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Usage{
+		SizeBytes:     u.SizeBytes,
+		Documents:     u.DocumentsCount,
+		Chunks:        u.ChunksCount,
+		SearchesToday: u.SearchesCount,
+	}, nil
+}
+
+func (p *postgres) IncrSearchUsage(ctx context.Context, userID int64, day time.Time) (int64, error) {
+	count, err := p.q.IncrSearchUsage(ctx, queries.IncrSearchUsageParams{
+		UserID: userID,
+		Day:    pgtype.Date{Time: day, Valid: true},
+	})
+	return int64(count), err
+}
+
+func (p *postgres) LockUserQuota(ctx context.Context, userID int64) error {
+	return p.q.LockUserQuota(ctx, userID)
+}
@@ -4,25 +4,35 @@ import (
 	"backend/internal/domain"
 	"backend/internal/repository/queries"
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, email, passwordHash string) (*domain.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, string, error)
 	GetUserById(ctx context.Context, id int64) (*domain.User, string, error)
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error)
+	CreateUserIdentity(ctx context.Context, userID int64, issuer, subject, email string) error
+	SetUserEmailVerified(ctx context.Context, id int64) error
+	UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error
+	DeleteUser(ctx context.Context, id int64) error
 }
 
 func userToDomain(u queries.User) *domain.User {
 	return &domain.User{
-		ID:    u.ID,
-		Email: u.Email,
+		ID:            u.ID,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		Role:          u.Role,
+		Disabled:      u.DisabledAt.Valid,
 	}
 }
 
 func (p *postgres) CreateUser(ctx context.Context, email, passwordHash string) (*domain.User, error) {
 	u, err := p.q.CreateUser(ctx, queries.CreateUserParams{
 		Email:        email,
-		PasswordHash: passwordHash,
+		PasswordHash: pgtype.Text{String: passwordHash, Valid: passwordHash != ""},
 	})
 	if err != nil {
 		return nil, err
@@ -35,7 +45,7 @@ func (p *postgres) GetUserByEmail(ctx context.Context, email string) (*domain.Us
 	if err != nil {
 		return nil, "", err
 	}
-	return userToDomain(u), u.PasswordHash, nil
+	return userToDomain(u), u.PasswordHash.String, nil
 }
 
 func (p *postgres) GetUserById(ctx context.Context, id int64) (*domain.User, string, error) {
@@ -43,5 +53,40 @@ func (p *postgres) GetUserById(ctx context.Context, id int64) (*domain.User, str
 	if err != nil {
 		return nil, "", err
 	}
-	return userToDomain(u), u.PasswordHash, nil
+	return userToDomain(u), u.PasswordHash.String, nil
+}
+
+func (p *postgres) GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error) {
+	u, err := p.q.GetUserByIdentity(ctx, queries.GetUserByIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userToDomain(u), nil
+}
+
+func (p *postgres) CreateUserIdentity(ctx context.Context, userID int64, issuer, subject, email string) error {
+	return p.q.CreateUserIdentity(ctx, queries.CreateUserIdentityParams{
+		UserID:  userID,
+		Issuer:  issuer,
+		Subject: subject,
+		Email:   pgtype.Text{String: email, Valid: email != ""},
+	})
+}
+
+func (p *postgres) SetUserEmailVerified(ctx context.Context, id int64) error {
+	return p.q.SetUserEmailVerified(ctx, id)
+}
+
+func (p *postgres) UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error {
+	return p.q.UpdateUserPassword(ctx, queries.UpdateUserPasswordParams{
+		ID:           id,
+		PasswordHash: pgtype.Text{String: passwordHash, Valid: passwordHash != ""},
+	})
+}
+
+func (p *postgres) DeleteUser(ctx context.Context, id int64) error {
+	return p.q.DeleteUser(ctx, id)
 }
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook domain.Webhook) (*domain.Webhook, error)
+	GetWorkspaceWebhooks(ctx context.Context, workspaceID int64) ([]domain.Webhook, error)
+	GetWorkspaceWebhook(ctx context.Context, id, workspaceID int64) (*domain.Webhook, error)
+	CountWorkspaceWebhooks(ctx context.Context, workspaceID int64) (int64, error)
+	UpdateWebhook(ctx context.Context, id, workspaceID int64, update domain.WebhookUpdate) (*domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, id, workspaceID int64) (bool, error)
+	GetWebhookDeliveries(ctx context.Context, webhookID, page, size int64) ([]domain.WebhookDelivery, error)
+	RedeliverWebhookEvent(ctx context.Context, deliveryID, webhookID int64) (*domain.WebhookDelivery, error)
+	ClaimWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]domain.PendingWebhookDelivery, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt domain.WebhookAttempt) error
+	DeleteOldWebhookEvents(ctx context.Context, createdBefore time.Time) (int64, error)
+	FailStalledDocumentVersions(ctx context.Context, createdBefore time.Time) ([]int64, error)
+}
+
+func webhookToDomain(w queries.Webhook) *domain.Webhook {
+	return &domain.Webhook{
+		ID:              w.ID,
+		WorkspaceID:     w.WorkspaceID,
+		URL:             w.Url,
+		SecretEncrypted: w.SecretEncrypted,
+		Events:          w.Events,
+		Enabled:         w.Enabled,
+		CreatedBy:       int8Ptr(w.CreatedBy),
+		CreatedAt:       w.CreatedAt.Time,
+		UpdatedAt:       w.UpdatedAt.Time,
+	}
+}
+
+// webhookDeliveryToDomain принимает строку GetWebhookDeliveries; строка RedeliverWebhookEvent имеет ту же форму.
+func webhookDeliveryToDomain(d queries.GetWebhookDeliveriesRow) *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:           d.ID,
+		WebhookID:    d.WebhookID,
+		EventID:      d.EventID,
+		EventType:    d.EventType,
+		Status:       d.Status,
+		Attempts:     d.Attempts,
+		ResponseBody: d.ResponseBody.String,
+		Error:        d.Error.String,
+		CreatedAt:    d.CreatedAt.Time,
+	}
+	if d.Status == domain.WebhookDeliveryPending {
+		delivery.NextAttemptAt = &d.NextAttemptAt.Time
+	}
+	if d.LastAttemptAt.Valid {
+		delivery.LastAttemptAt = &d.LastAttemptAt.Time
+	}
+	if d.ResponseStatus.Valid {
+		delivery.ResponseStatus = &d.ResponseStatus.Int32
+	}
+	return delivery
+}
+
+func (p *postgres) CreateWebhook(ctx context.Context, webhook domain.Webhook) (*domain.Webhook, error) {
+	w, err := p.q.CreateWebhook(ctx, queries.CreateWebhookParams{
+		WorkspaceID:     webhook.WorkspaceID,
+		Url:             webhook.URL,
+		SecretEncrypted: webhook.SecretEncrypted,
+		Events:          webhook.Events,
+		CreatedBy:       optionalInt8(webhook.CreatedBy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhookToDomain(w), nil
+}
+
+func (p *postgres) GetWorkspaceWebhooks(ctx context.Context, workspaceID int64) ([]domain.Webhook, error) {
+	webhooks, err := p.q.GetWorkspaceWebhooks(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainWebhooks := make([]domain.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		domainWebhooks[i] = *webhookToDomain(w)
+	}
+
+	return domainWebhooks, nil
+}
+
+func (p *postgres) GetWorkspaceWebhook(ctx context.Context, id, workspaceID int64) (*domain.Webhook, error) {
+	w, err := p.q.GetWorkspaceWebhook(ctx, queries.GetWorkspaceWebhookParams{
+		ID:          id,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhookToDomain(w), nil
+}
+
+func (p *postgres) CountWorkspaceWebhooks(ctx context.Context, workspaceID int64) (int64, error) {
+	return p.q.CountWorkspaceWebhooks(ctx, workspaceID)
+}
+
+func (p *postgres) UpdateWebhook(ctx context.Context, id, workspaceID int64, update domain.WebhookUpdate) (*domain.Webhook, error) {
+	params := queries.UpdateWebhookParams{
+		Events:      update.Events,
+		ID:          id,
+		WorkspaceID: workspaceID,
+	}
+	if update.URL != nil {
+		params.Url = pgtype.Text{String: *update.URL, Valid: true}
+	}
+	if update.SecretEncrypted != nil {
+		params.SecretEncrypted = pgtype.Text{String: *update.SecretEncrypted, Valid: true}
+	}
+	if update.Enabled != nil {
+		params.Enabled = pgtype.Bool{Bool: *update.Enabled, Valid: true}
+	}
+
+	w, err := p.q.UpdateWebhook(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return webhookToDomain(w), nil
+}
+
+func (p *postgres) DeleteWebhook(ctx context.Context, id, workspaceID int64) (bool, error) {
+	rows, err := p.q.DeleteWebhook(ctx, queries.DeleteWebhookParams{
+		ID:          id,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) GetWebhookDeliveries(ctx context.Context, webhookID, page, size int64) ([]domain.WebhookDelivery, error) {
+	offset, limit := calcOffsetLimit(page, size)
+	deliveries, err := p.q.GetWebhookDeliveries(ctx, queries.GetWebhookDeliveriesParams{
+		WebhookID:   webhookID,
+		OffsetCount: offset,
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainDeliveries := make([]domain.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		domainDeliveries[i] = *webhookDeliveryToDomain(d)
+	}
+
+	return domainDeliveries, nil
+}
+
+func (p *postgres) RedeliverWebhookEvent(ctx context.Context, deliveryID, webhookID int64) (*domain.WebhookDelivery, error) {
+	d, err := p.q.RedeliverWebhookEvent(ctx, queries.RedeliverWebhookEventParams{
+		DeliveryID: deliveryID,
+		WebhookID:  webhookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhookDeliveryToDomain(queries.GetWebhookDeliveriesRow(d)), nil
+}
+
+func (p *postgres) ClaimWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]domain.PendingWebhookDelivery, error) {
+	rows, err := p.q.ClaimWebhookDeliveries(ctx, queries.ClaimWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.PendingWebhookDelivery, len(rows))
+	for i, r := range rows {
+		deliveries[i] = domain.PendingWebhookDelivery{
+			ID:              r.ID,
+			WebhookID:       r.WebhookID,
+			Attempts:        r.Attempts,
+			URL:             r.Url,
+			SecretEncrypted: r.SecretEncrypted,
+			WorkspaceID:     r.WorkspaceID,
+			Event: domain.WebhookEvent{
+				ID:        r.EventID,
+				Type:      r.EventType,
+				Data:      r.EventData,
+				CreatedAt: r.EventCreatedAt.Time,
+			},
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (p *postgres) RecordWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt domain.WebhookAttempt) error {
+	params := queries.RecordWebhookDeliveryAttemptParams{
+		Status:        attempt.Status,
+		NextAttemptAt: pgtype.Timestamptz{Time: attempt.NextAttemptAt, Valid: true},
+		ResponseBody:  optionalText(attempt.ResponseBody),
+		Error:         optionalText(attempt.Error),
+		ID:            deliveryID,
+	}
+	if attempt.ResponseStatus != nil {
+		params.ResponseStatus = pgtype.Int4{Int32: *attempt.ResponseStatus, Valid: true}
+	}
+	return p.q.RecordWebhookDeliveryAttempt(ctx, params)
+}
+
+func (p *postgres) DeleteOldWebhookEvents(ctx context.Context, createdBefore time.Time) (int64, error) {
+	return p.q.DeleteOldWebhookEvents(ctx, pgtype.Timestamptz{Time: createdBefore, Valid: true})
+}
+
+func (p *postgres) FailStalledDocumentVersions(ctx context.Context, createdBefore time.Time) ([]int64, error) {
+	return p.q.FailStalledDocumentVersions(ctx, pgtype.Timestamptz{Time: createdBefore, Valid: true})
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository/queries"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type WorkspaceRepository interface {
+	CreateWorkspace(ctx context.Context, name string, personalUserID int64) (*domain.Workspace, error)
+	GetPersonalWorkspace(ctx context.Context, userID int64) (*domain.Workspace, error)
+	GetUserWorkspaces(ctx context.Context, userID int64) ([]domain.Workspace, error)
+	GetUserWorkspaceByID(ctx context.Context, id, userID int64) (*domain.Workspace, error)
+	RenameWorkspace(ctx context.Context, id int64, name string) error
+	DeleteWorkspace(ctx context.Context, id int64) error
+
+	UpsertWorkspaceMember(ctx context.Context, workspaceID, userID int64, role string) error
+	GetWorkspaceMembers(ctx context.Context, workspaceID int64) ([]domain.WorkspaceMember, error)
+	DeleteWorkspaceMember(ctx context.Context, workspaceID, userID int64) (bool, error)
+	CountWorkspaceOwners(ctx context.Context, workspaceID int64) (int64, error)
+	LockWorkspace(ctx context.Context, workspaceID int64) error
+	LockOwnedSharedWorkspaces(ctx context.Context, userID int64) ([]int64, error)
+	CountSoleOwnedWorkspaces(ctx context.Context, userID int64) (int64, error)
+}
+
+func workspaceToDomain(w queries.Workspace) *domain.Workspace {
+	return &domain.Workspace{
+		ID:        w.ID,
+		Name:      w.Name,
+		Personal:  w.PersonalUserID.Valid,
+		CreatedAt: w.CreatedAt.Time,
+	}
+}
+
+func userWorkspaceRowToDomain(w queries.GetUserWorkspaceByIDRow) *domain.Workspace {
+	return &domain.Workspace{
+		ID:        w.ID,
+		Name:      w.Name,
+		Personal:  w.PersonalUserID.Valid,
+		Role:      w.Role,
+		CreatedAt: w.CreatedAt.Time,
+	}
+}
+
+func (p *postgres) CreateWorkspace(ctx context.Context, name string, personalUserID int64) (*domain.Workspace, error) {
+	w, err := p.q.CreateWorkspace(ctx, queries.CreateWorkspaceParams{
+		Name:           name,
+		PersonalUserID: pgtype.Int8{Int64: personalUserID, Valid: personalUserID != 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return workspaceToDomain(w), nil
+}
+
+func (p *postgres) GetPersonalWorkspace(ctx context.Context, userID int64) (*domain.Workspace, error) {
+	w, err := p.q.GetPersonalWorkspace(ctx, pgtype.Int8{Int64: userID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	workspace := workspaceToDomain(w)
+	workspace.Role = domain.WorkspaceRoleOwner
+	return workspace, nil
+}
+
+func (p *postgres) GetUserWorkspaces(ctx context.Context, userID int64) ([]domain.Workspace, error) {
+	workspaces, err := p.q.GetUserWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainWorkspaces := make([]domain.Workspace, len(workspaces))
+	for i, w := range workspaces {
+		domainWorkspaces[i] = *userWorkspaceRowToDomain(queries.GetUserWorkspaceByIDRow(w))
+	}
+
+	return domainWorkspaces, nil
+}
+
+func (p *postgres) GetUserWorkspaceByID(ctx context.Context, id, userID int64) (*domain.Workspace, error) {
+	w, err := p.q.GetUserWorkspaceByID(ctx, queries.GetUserWorkspaceByIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userWorkspaceRowToDomain(w), nil
+}
+
+func (p *postgres) RenameWorkspace(ctx context.Context, id int64, name string) error {
+	return p.q.RenameWorkspace(ctx, queries.RenameWorkspaceParams{
+		ID:   id,
+		Name: name,
+	})
+}
+
+func (p *postgres) DeleteWorkspace(ctx context.Context, id int64) error {
+	return p.q.DeleteWorkspace(ctx, id)
+}
+
+func (p *postgres) UpsertWorkspaceMember(ctx context.Context, workspaceID, userID int64, role string) error {
+	return p.q.UpsertWorkspaceMember(ctx, queries.UpsertWorkspaceMemberParams{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		Role:        role,
+	})
+}
+
+func (p *postgres) GetWorkspaceMembers(ctx context.Context, workspaceID int64) ([]domain.WorkspaceMember, error) {
+	members, err := p.q.GetWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainMembers := make([]domain.WorkspaceMember, len(members))
+	for i, m := range members {
+		domainMembers[i] = domain.WorkspaceMember{
+			UserID:    m.UserID,
+			Email:     m.Email,
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt.Time,
+		}
+	}
+
+	return domainMembers, nil
+}
+
+func (p *postgres) DeleteWorkspaceMember(ctx context.Context, workspaceID, userID int64) (bool, error) {
+	rows, err := p.q.DeleteWorkspaceMember(ctx, queries.DeleteWorkspaceMemberParams{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *postgres) CountWorkspaceOwners(ctx context.Context, workspaceID int64) (int64, error) {
+	return p.q.CountWorkspaceOwners(ctx, workspaceID)
+}
+
+func (p *postgres) LockWorkspace(ctx context.Context, workspaceID int64) error {
+	return p.q.LockWorkspace(ctx, workspaceID)
+}
+
+func (p *postgres) LockOwnedSharedWorkspaces(ctx context.Context, userID int64) ([]int64, error) {
+	return p.q.LockOwnedSharedWorkspaces(ctx, userID)
+}
+
+func (p *postgres) CountSoleOwnedWorkspaces(ctx context.Context, userID int64) (int64, error) {
+	return p.q.CountSoleOwnedWorkspaces(ctx, userID)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type AdminService interface {
+	ListUsers(ctx context.Context, page, size int64) ([]domain.UserWithStats, int64, error)
+	GetUserStats(ctx context.Context, userID int64) (*domain.UserWithStats, error)
+	SetUserDisabled(ctx context.Context, adminID, userID int64, disabled bool) error
+	BootstrapAdmins(ctx context.Context, emails []string) error
+}
+
+var (
+	ErrCannotDisableSelf = errors.New("administrator cannot disable own account")
+)
+
+func (s *service) ListUsers(ctx context.Context, page, size int64) ([]domain.UserWithStats, int64, error) {
+	users, err := s.repo.ListUsersWithStats(ctx, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountUsers(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (s *service) GetUserStats(ctx context.Context, userID int64) (*domain.UserWithStats, error) {
+	user, err := s.repo.GetUserWithStats(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *service) SetUserDisabled(ctx context.Context, adminID, userID int64, disabled bool) error {
+	if disabled && adminID == userID {
+		return ErrCannotDisableSelf
+	}
+
+	return s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if _, _, err := repo.GetUserById(ctx, userID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		var action string
+		var changed bool
+		var err error
+		if disabled {
+			action = domain.AuditUserDisabled
+			changed, err = repo.DisableUser(ctx, userID)
+		} else {
+			action = domain.AuditUserEnabled
+			changed, err = repo.EnableUser(ctx, userID)
+		}
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		// Отключенный пользователь теряет все сессии; access token доживает не дольше своего TTL.
+		if disabled {
+			if err := repo.DeleteAllUserRefreshTokens(ctx, userID); err != nil {
+				return err
+			}
+		}
+
+		s.log.Info().Int64("admin_id", adminID).Int64("user_id", userID).Str("action", action).Msg("Admin changed user status")
+
+		return repo.CreateAuditEvent(ctx, domain.AuditEvent{
+			UserID:  userID,
+			Action:  action,
+			Details: map[string]any{"admin_id": adminID},
+		})
+	})
+}
+
+func (s *service) BootstrapAdmins(ctx context.Context, emails []string) error {
+	for _, email := range emails {
+		promoted, err := s.repo.SetUserRoleByEmail(ctx, email, domain.RoleAdmin)
+		if err != nil {
+			return err
+		}
+		if promoted {
+			s.log.Info().Str("email", email).Msg("User promoted to admin from config")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// adminRepository хранит статус пользователей, их refresh-токены и записанные события аудита.
+type adminRepository struct {
+	fakeRepository
+	disabled      map[int64]bool
+	refreshTokens map[int64]int
+	audit         []domain.AuditEvent
+}
+
+func (r *adminRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *adminRepository) GetUserById(ctx context.Context, id int64) (*domain.User, string, error) {
+	disabled, ok := r.disabled[id]
+	if !ok {
+		return nil, "", pgx.ErrNoRows
+	}
+	return &domain.User{ID: id, Role: domain.RoleUser, Disabled: disabled}, "", nil
+}
+
+func (r *adminRepository) DisableUser(ctx context.Context, id int64) (bool, error) {
+	if r.disabled[id] {
+		return false, nil
+	}
+	r.disabled[id] = true
+	return true, nil
+}
+
+func (r *adminRepository) EnableUser(ctx context.Context, id int64) (bool, error) {
+	if !r.disabled[id] {
+		return false, nil
+	}
+	r.disabled[id] = false
+	return true, nil
+}
+
+func (r *adminRepository) DeleteAllUserRefreshTokens(ctx context.Context, userID int64) error {
+	delete(r.refreshTokens, userID)
+	return nil
+}
+
+func (r *adminRepository) CreateAuditEvent(ctx context.Context, event domain.AuditEvent) error {
+	r.audit = append(r.audit, event)
+	return nil
+}
+
+func TestSetUserDisabled(t *testing.T) {
+	const (
+		admin  int64 = 1
+		active int64 = 2
+		banned int64 = 3
+	)
+
+	tests := []struct {
+		name       string
+		adminID    int64
+		userID     int64
+		disabled   bool
+		wantErr    error
+		wantStatus bool
+		// wantAudit — действие единственного события аудита; пустая строка — событий нет.
+		wantAudit      string
+		wantTokensGone bool
+	}{
+		{
+			name:       "admin cannot disable self",
+			adminID:    admin,
+			userID:     admin,
+			disabled:   true,
+			wantErr:    ErrCannotDisableSelf,
+			wantStatus: false,
+		},
+		{
+			name:           "disable revokes sessions",
+			adminID:        admin,
+			userID:         active,
+			disabled:       true,
+			wantStatus:     true,
+			wantAudit:      domain.AuditUserDisabled,
+			wantTokensGone: true,
+		},
+		{
+			name:       "disable disabled user changes nothing",
+			adminID:    admin,
+			userID:     banned,
+			disabled:   true,
+			wantStatus: true,
+		},
+		{
+			name:       "enable disabled user",
+			adminID:    admin,
+			userID:     banned,
+			disabled:   false,
+			wantStatus: false,
+			wantAudit:  domain.AuditUserEnabled,
+		},
+		{
+			name:       "enable active user changes nothing",
+			adminID:    admin,
+			userID:     active,
+			disabled:   false,
+			wantStatus: false,
+		},
+		{
+			name:     "unknown user",
+			adminID:  admin,
+			userID:   42,
+			disabled: true,
+			wantErr:  ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &adminRepository{
+				disabled:      map[int64]bool{admin: false, active: false, banned: true},
+				refreshTokens: map[int64]int{admin: 1, active: 2, banned: 1},
+			}
+			s := newTestService(repo)
+
+			err := s.SetUserDisabled(context.Background(), tt.adminID, tt.userID, tt.disabled)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetUserDisabled error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(repo.audit) != 0 {
+					t.Errorf("audit events = %d, want 0", len(repo.audit))
+				}
+				return
+			}
+
+			if got := repo.disabled[tt.userID]; got != tt.wantStatus {
+				t.Errorf("disabled = %v, want %v", got, tt.wantStatus)
+			}
+			if _, ok := repo.refreshTokens[tt.userID]; ok == tt.wantTokensGone {
+				t.Errorf("refresh tokens kept = %v, want %v", ok, !tt.wantTokensGone)
+			}
+
+			if tt.wantAudit == "" {
+				if len(repo.audit) != 0 {
+					t.Errorf("audit events = %d, want 0", len(repo.audit))
+				}
+				return
+			}
+			if len(repo.audit) != 1 {
+				t.Fatalf("audit events = %d, want 1", len(repo.audit))
+			}
+			event := repo.audit[0]
+			if event.UserID != tt.userID || event.Action != tt.wantAudit || event.Details["admin_id"] != tt.adminID {
+				t.Errorf("audit event = %+v, want user %d, action %q, admin %d", event, tt.userID, tt.wantAudit, tt.adminID)
+			}
+		})
+	}
+}
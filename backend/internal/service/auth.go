@@ -2,12 +2,17 @@ package service
 
 import (
 	"backend/internal/domain"
+	"backend/internal/mailer"
+	"backend/internal/ratelimit"
 	"backend/internal/repository"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/jwtauth/v5"
@@ -17,21 +22,36 @@ import (
 
 type AuthService interface {
 	Register(ctx context.Context, email, password string) (*domain.User, string, string, error)
-	Login(ctx context.Context, email, password string) (accessToken, refreshToken string, err error)
+	Login(ctx context.Context, email, password string) (*domain.LoginResult, error)
 	Refresh(ctx context.Context, token string) (accessToken, refreshToken string, err error)
 	Logout(ctx context.Context, token string) error
 	FullLogout(ctx context.Context, userID int64) error
+	RequestEmailVerification(ctx context.Context, userID int64) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
 }
 
 const (
-	accessTokenTTL  = 15 * time.Minute
-	refreshTokenTTL = 7 * 24 * time.Hour
+	accessTokenTTL            = 15 * time.Minute
+	refreshTokenTTL           = 7 * 24 * time.Hour
+	emailVerificationTokenTTL = 24 * time.Hour
+	passwordResetTokenTTL     = time.Hour
+	// oidcReauthMaxAge — насколько давним может быть вход через провайдера,
+	// чтобы подтвердить им опасное действие в аккаунте без пароля.
+	oidcReauthMaxAge = 10 * time.Minute
 )
 
+// OIDCAuthTimeClaim — claim токена доступа со временем входа у OIDC-провайдера (unix-время).
+const OIDCAuthTimeClaim = "oidc_auth_time"
+
 var (
 	ErrUserExists           = errors.New("user with this email already exists")
 	ErrInvalidCredentials   = errors.New("invalid email or password")
 	ErrRefreshTokenNotFound = errors.New("refresh token not found or expired")
+	ErrInvalidUserToken     = errors.New("token is invalid, expired or already used")
+	ErrAccountDisabled      = errors.New("account is disabled")
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
 )
 
 func (s *service) Register(ctx context.Context, email, password string) (*domain.User, string, string, error) {
@@ -52,7 +72,7 @@ func (s *service) Register(ctx context.Context, email, password string) (*domain
 			return err
 		}
 
-		createdUser, err := repo.CreateUser(ctx, email, string(passwordHash))
+		createdUser, err := s.createUser(ctx, repo, email, string(passwordHash))
 		if err != nil {
 			return err
 		}
@@ -67,12 +87,26 @@ func (s *service) Register(ctx context.Context, email, password string) (*domain
 
 		return nil
 	})
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if err := s.RequestEmailVerification(ctx, user.ID); err != nil {
+		s.log.Err(err).Int64("user_id", user.ID).Msg("failed to send email verification")
+	}
 
-	return user, accessToken, refreshToken, err
+	return user, accessToken, refreshToken, nil
 }
 
-func (s *service) Login(ctx context.Context, email, password string) (string, string, error) {
-	var accessToken, refreshToken string
+func (s *service) Login(ctx context.Context, email, password string) (*domain.LoginResult, error) {
+	if err := s.limiter.CheckLockout(ctx, email); err != nil {
+		return nil, err
+	}
+	if err := s.limiter.Allow(ctx, ratelimit.AccountKey("login", email), s.limiter.Config().Account); err != nil {
+		return nil, err
+	}
+
+	var result *domain.LoginResult
 
 	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
 		user, passwordHash, err := repo.GetUserByEmail(ctx, email)
@@ -86,18 +120,44 @@ func (s *service) Login(ctx context.Context, email, password string) (string, st
 		if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)); err != nil {
 			return ErrInvalidCredentials
 		}
+		if user.Disabled {
+			return ErrAccountDisabled
+		}
+
+		twoFactor, err := s.userHasTwoFactor(ctx, repo, user.ID)
+		if err != nil {
+			return err
+		}
+		if twoFactor {
+			result, err = s.createLoginChallenge(ctx, repo, user.ID)
+			return err
+		}
 
 		newAccessToken, newRefreshToken, err := s.generateTokenPair(ctx, repo, user)
 		if err != nil {
 			return err
 		}
 
-		accessToken = newAccessToken
-		refreshToken = newRefreshToken
+		result = &domain.LoginResult{
+			AccessToken:  newAccessToken,
+			RefreshToken: newRefreshToken,
+		}
 		return nil
 	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			if lockErr := s.limiter.RegisterLoginFailure(ctx, email); lockErr != nil {
+				s.log.Err(lockErr).Msg("failed to register login failure")
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.limiter.ResetLoginFailures(ctx, email); err != nil {
+		s.log.Err(err).Msg("failed to reset login failures")
+	}
 
-	return accessToken, refreshToken, err
+	return result, nil
 }
 
 func (s *service) Refresh(ctx context.Context, token string) (string, string, error) {
@@ -118,7 +178,17 @@ func (s *service) Refresh(ctx context.Context, token string) (string, string, er
 			return err
 		}
 
-		user := &domain.User{ID: refreshToken.UserID}
+		user, _, err := repo.GetUserById(ctx, refreshToken.UserID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrRefreshTokenNotFound
+			}
+			return err
+		}
+		if user.Disabled {
+			return ErrAccountDisabled
+		}
+
 		newAccessToken, generatedRefreshToken, err := s.generateTokenPair(ctx, repo, user)
 		if err != nil {
 			return err
@@ -145,12 +215,163 @@ func (s *service) FullLogout(ctx context.Context, userID int64) error {
 	})
 }
 
+func (s *service) RequestEmailVerification(ctx context.Context, userID int64) error {
+	if err := s.limiter.Allow(ctx, ratelimit.AccountKey("verify_email", strconv.FormatInt(userID, 10)), s.limiter.Config().Mail); err != nil {
+		return err
+	}
+
+	var user *domain.User
+	var rawToken string
+
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		u, _, err := repo.GetUserById(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+		if u.EmailVerified {
+			return ErrEmailAlreadyVerified
+		}
+		user = u
+
+		rawToken, err = s.issueUserToken(ctx, repo, user.ID, domain.UserTokenEmailVerification, emailVerificationTokenTTL)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf("Чтобы подтвердить email, перейдите по ссылке:\n\n%s/verify-email?token=%s\n\nСсылка действительна %s.\n",
+			s.mailCfg.BaseURL, url.QueryEscape(rawToken), emailVerificationTokenTTL),
+	})
+}
+
+func (s *service) VerifyEmail(ctx context.Context, token string) error {
+	return s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		userToken, err := repo.ConsumeUserToken(ctx, hashToken(token), domain.UserTokenEmailVerification)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrInvalidUserToken
+			}
+			return err
+		}
+
+		if err := repo.SetUserEmailVerified(ctx, userToken.UserID); err != nil {
+			return err
+		}
+		return repo.DeleteUserTokens(ctx, userToken.UserID, domain.UserTokenEmailVerification)
+	})
+}
+
+func (s *service) RequestPasswordReset(ctx context.Context, email string) error {
+	// Лимит считается по адресу независимо от того, зарегистрирован ли он, поэтому ничего не раскрывает.
+	// Ответ при этом не меняется, а письмо просто не отправляется повторно.
+	if err := s.limiter.Allow(ctx, ratelimit.AccountKey("password_reset", email), s.limiter.Config().Mail); err != nil {
+		var limitErr *ratelimit.LimitError
+		if errors.As(err, &limitErr) {
+			s.log.Warn().Msg("Password reset email rate limit exceeded")
+			return nil
+		}
+		return err
+	}
+
+	var user *domain.User
+	var rawToken string
+
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		u, _, err := repo.GetUserByEmail(ctx, email)
+		if err != nil {
+			return err
+		}
+		user = u
+
+		rawToken, err = s.issueUserToken(ctx, repo, user.ID, domain.UserTokenPasswordReset, passwordResetTokenTTL)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Не раскрываем, зарегистрирован ли email.
+			s.log.Info().Msg("Password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Чтобы задать новый пароль, перейдите по ссылке:\n\n%s/reset-password?token=%s\n\nСсылка действительна %s. Если вы не запрашивали сброс, просто проигнорируйте это письмо.\n",
+			s.mailCfg.BaseURL, url.QueryEscape(rawToken), passwordResetTokenTTL),
+	})
+}
+
+func (s *service) ResetPassword(ctx context.Context, token, newPassword string) error {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		userToken, err := repo.ConsumeUserToken(ctx, hashToken(token), domain.UserTokenPasswordReset)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrInvalidUserToken
+			}
+			return err
+		}
+
+		if err := repo.UpdateUserPassword(ctx, userToken.UserID, string(passwordHash)); err != nil {
+			return err
+		}
+		// Владение почтовым ящиком подтверждено самим фактом получения ссылки.
+		if err := repo.SetUserEmailVerified(ctx, userToken.UserID); err != nil {
+			return err
+		}
+		if err := repo.DeleteUserTokens(ctx, userToken.UserID, domain.UserTokenPasswordReset); err != nil {
+			return err
+		}
+		return repo.DeleteAllUserRefreshTokens(ctx, userToken.UserID)
+	})
+}
+
+func (s *service) issueUserToken(ctx context.Context, repo repository.Repository, userID int64, purpose domain.UserTokenPurpose, ttl time.Duration) (string, error) {
+	if err := repo.DeleteUserTokens(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	rawToken, err := generateSecureRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err := repo.CreateUserToken(ctx, userID, purpose, hashToken(rawToken), time.Now().Add(ttl)); err != nil {
+		return "", err
+	}
+
+	return rawToken, nil
+}
+
 func (s *service) generateTokenPair(ctx context.Context, repo repository.Repository, user *domain.User) (string, string, error) {
+	return s.generateTokenPairWithClaims(ctx, repo, user, nil)
+}
+
+// generateTokenPairWithClaims выпускает пару токенов, добавляя extraClaims в токен доступа.
+// Обновленные через refresh токены дополнительных claims не получают.
+func (s *service) generateTokenPairWithClaims(ctx context.Context, repo repository.Repository, user *domain.User, extraClaims map[string]interface{}) (string, string, error) {
 	claims := map[string]interface{}{
 		"user_id": user.ID,
+		"role":    user.Role,
 		"exp":     jwtauth.ExpireIn(accessTokenTTL),
 		"iat":     time.Now().Unix(),
 	}
+	for key, value := range extraClaims {
+		claims[key] = value
+	}
 	_, accessToken, err := s.tokenAuth.Encode(claims)
 	if err != nil {
 		return "", "", err
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/mailer"
+	"backend/internal/ratelimit"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type storedUser struct {
+	domain.User
+	passwordHash  string
+	refreshTokens int
+}
+
+type storedUserToken struct {
+	domain.UserToken
+	used bool
+}
+
+// userTokenRepository хранит пользователей и одноразовые токены в памяти.
+// ConsumeUserToken ищет токен по хэшу так же, как запрос в базе: использованные и истекшие не находятся.
+type userTokenRepository struct {
+	fakeRepository
+	users  map[int64]*storedUser
+	tokens []*storedUserToken
+}
+
+func (r *userTokenRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *userTokenRepository) GetUserById(ctx context.Context, id int64) (*domain.User, string, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, "", pgx.ErrNoRows
+	}
+	found := user.User
+	return &found, user.passwordHash, nil
+}
+
+func (r *userTokenRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, string, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			found := user.User
+			return &found, user.passwordHash, nil
+		}
+	}
+	return nil, "", pgx.ErrNoRows
+}
+
+func (r *userTokenRepository) CreateUserToken(ctx context.Context, userID int64, purpose domain.UserTokenPurpose, tokenHash string, expiresAt time.Time) error {
+	r.tokens = append(r.tokens, &storedUserToken{UserToken: domain.UserToken{
+		ID:        int64(len(r.tokens) + 1),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}})
+	return nil
+}
+
+func (r *userTokenRepository) ConsumeUserToken(ctx context.Context, tokenHash string, purpose domain.UserTokenPurpose) (*domain.UserToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash && token.Purpose == purpose && !token.used && token.ExpiresAt.After(time.Now()) {
+			token.used = true
+			found := token.UserToken
+			return &found, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *userTokenRepository) DeleteUserTokens(ctx context.Context, userID int64, purpose domain.UserTokenPurpose) error {
+	kept := r.tokens[:0]
+	for _, token := range r.tokens {
+		if token.UserID != userID || token.Purpose != purpose {
+			kept = append(kept, token)
+		}
+	}
+	r.tokens = kept
+	return nil
+}
+
+func (r *userTokenRepository) SetUserEmailVerified(ctx context.Context, id int64) error {
+	r.users[id].EmailVerified = true
+	return nil
+}
+
+func (r *userTokenRepository) UpdateUserPassword(ctx context.Context, id int64, passwordHash string) error {
+	r.users[id].passwordHash = passwordHash
+	return nil
+}
+
+func (r *userTokenRepository) DeleteAllUserRefreshTokens(ctx context.Context, userID int64) error {
+	r.users[userID].refreshTokens = 0
+	return nil
+}
+
+// memoryMailer запоминает отправленные письма.
+type memoryMailer struct {
+	sent []mailer.Message
+}
+
+func (m *memoryMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+var mailTokenPattern = regexp.MustCompile(`token=(\S+)`)
+
+// mailedToken достает токен из ссылки в последнем отправленном письме.
+func mailedToken(t *testing.T, m *memoryMailer) string {
+	t.Helper()
+
+	if len(m.sent) == 0 {
+		t.Fatal("no email is sent")
+	}
+	match := mailTokenPattern.FindStringSubmatch(m.sent[len(m.sent)-1].Body)
+	if match == nil {
+		t.Fatal("email has no token link")
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func newUserTokenService(repo *userTokenRepository, m *memoryMailer) *service {
+	log := zerolog.Nop()
+	s := newTestService(repo)
+	s.mailer = m
+	s.mailCfg = &config.MailConfig{BaseURL: "https://docs.example.com"}
+	s.limiter = ratelimit.New(ratelimit.NewMemoryStore(), &config.RateLimitConfig{}, &log)
+	return s
+}
+
+// expireUserTokens переносит срок действия всех выданных токенов в прошлое.
+func expireUserTokens(repo *userTokenRepository) {
+	for _, token := range repo.tokens {
+		token.ExpiresAt = time.Now().Add(-time.Minute)
+	}
+}
+
+func TestEmailVerification(t *testing.T) {
+	const userID int64 = 1
+
+	tests := []struct {
+		name string
+		// prepare вызывается после отправки письма и возвращает токен, с которым подтверждается email.
+		prepare func(t *testing.T, s *service, repo *userTokenRepository, token string) string
+		wantErr error
+	}{
+		{
+			name:    "token from email",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string { return token },
+		},
+		{
+			name: "token is single-use",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				if err := s.VerifyEmail(context.Background(), token); err != nil {
+					t.Fatal(err)
+				}
+				repo.users[userID].EmailVerified = false
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "expired token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				expireUserTokens(repo)
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "new request replaces the previous token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				if err := s.RequestEmailVerification(context.Background(), userID); err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "stored hash is not a token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				return repo.tokens[0].TokenHash
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "password reset token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				if err := s.RequestPasswordReset(context.Background(), "user@example.com"); err != nil {
+					t.Fatal(err)
+				}
+				return mailedToken(t, s.mailer.(*memoryMailer))
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &userTokenRepository{users: map[int64]*storedUser{
+				userID: {User: domain.User{ID: userID, Email: "user@example.com"}},
+			}}
+			m := &memoryMailer{}
+			s := newUserTokenService(repo, m)
+
+			if err := s.RequestEmailVerification(context.Background(), userID); err != nil {
+				t.Fatal(err)
+			}
+			token := mailedToken(t, m)
+			if m.sent[0].To != "user@example.com" {
+				t.Errorf("email is sent to %q", m.sent[0].To)
+			}
+			// В базе хранится только хэш токена.
+			if len(repo.tokens) != 1 || repo.tokens[0].TokenHash != hashToken(token) {
+				t.Fatalf("stored tokens = %+v, want one with the hash of the mailed token", repo.tokens)
+			}
+
+			err := s.VerifyEmail(context.Background(), tt.prepare(t, s, repo, token))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyEmail error = %v, want %v", err, tt.wantErr)
+			}
+			if verified := repo.users[userID].EmailVerified; verified != (err == nil) {
+				t.Errorf("email verified = %v, want %v", verified, err == nil)
+			}
+		})
+	}
+}
+
+func TestRequestEmailVerificationWhenVerified(t *testing.T) {
+	const userID int64 = 1
+
+	repo := &userTokenRepository{users: map[int64]*storedUser{
+		userID: {User: domain.User{ID: userID, Email: "user@example.com", EmailVerified: true}},
+	}}
+	m := &memoryMailer{}
+	s := newUserTokenService(repo, m)
+
+	if err := s.RequestEmailVerification(context.Background(), userID); !errors.Is(err, ErrEmailAlreadyVerified) {
+		t.Fatalf("RequestEmailVerification error = %v, want %v", err, ErrEmailAlreadyVerified)
+	}
+	if len(m.sent) != 0 || len(repo.tokens) != 0 {
+		t.Errorf("emails = %d, tokens = %d, want none", len(m.sent), len(repo.tokens))
+	}
+}
+
+func TestPasswordReset(t *testing.T) {
+	const (
+		userID      int64 = 1
+		oldPassword       = "old-password"
+		newPassword       = "new-password"
+	)
+
+	oldHash, err := bcrypt.GenerateFromPassword([]byte(oldPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// prepare вызывается после отправки письма и возвращает токен, с которым сбрасывается пароль.
+		prepare func(t *testing.T, s *service, repo *userTokenRepository, token string) string
+		wantErr error
+	}{
+		{
+			name:    "token from email",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string { return token },
+		},
+		{
+			name: "token is single-use",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				if err := s.ResetPassword(context.Background(), token, "intermediate-password"); err != nil {
+					t.Fatal(err)
+				}
+				repo.users[userID].passwordHash = string(oldHash)
+				repo.users[userID].EmailVerified = false
+				repo.users[userID].refreshTokens = 2
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "expired token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				expireUserTokens(repo)
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "stored hash is not a token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				return repo.tokens[0].TokenHash
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name: "email verification token",
+			prepare: func(t *testing.T, s *service, repo *userTokenRepository, token string) string {
+				if err := s.RequestEmailVerification(context.Background(), userID); err != nil {
+					t.Fatal(err)
+				}
+				return mailedToken(t, s.mailer.(*memoryMailer))
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &userTokenRepository{users: map[int64]*storedUser{
+				userID: {User: domain.User{ID: userID, Email: "user@example.com"}, passwordHash: string(oldHash), refreshTokens: 2},
+			}}
+			m := &memoryMailer{}
+			s := newUserTokenService(repo, m)
+
+			if err := s.RequestPasswordReset(context.Background(), "user@example.com"); err != nil {
+				t.Fatal(err)
+			}
+			token := mailedToken(t, m)
+			if len(repo.tokens) != 1 || repo.tokens[0].TokenHash != hashToken(token) {
+				t.Fatalf("stored tokens = %+v, want one with the hash of the mailed token", repo.tokens)
+			}
+
+			err := s.ResetPassword(context.Background(), tt.prepare(t, s, repo, token), newPassword)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResetPassword error = %v, want %v", err, tt.wantErr)
+			}
+
+			user := repo.users[userID]
+			wantPassword := oldPassword
+			if err == nil {
+				wantPassword = newPassword
+			}
+			if bcrypt.CompareHashAndPassword([]byte(user.passwordHash), []byte(wantPassword)) != nil {
+				t.Errorf("password is not %q", wantPassword)
+			}
+			// Успешный сброс подтверждает email и завершает все сессии.
+			if user.EmailVerified != (err == nil) || (user.refreshTokens == 0) != (err == nil) {
+				t.Errorf("email verified = %v, refresh tokens = %d after reset error %v", user.EmailVerified, user.refreshTokens, err)
+			}
+		})
+	}
+}
+
+func TestRequestPasswordResetForUnknownEmail(t *testing.T) {
+	repo := &userTokenRepository{users: map[int64]*storedUser{}}
+	m := &memoryMailer{}
+	s := newUserTokenService(repo, m)
+
+	// Ответ не раскрывает, зарегистрирован ли адрес.
+	if err := s.RequestPasswordReset(context.Background(), "nobody@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset error = %v, want nil", err)
+	}
+	if len(m.sent) != 0 || len(repo.tokens) != 0 {
+		t.Errorf("emails = %d, tokens = %d, want none", len(m.sent), len(repo.tokens))
+	}
+}
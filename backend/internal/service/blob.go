@@ -0,0 +1,65 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+)
+
+const blobKeyRandomBytes = 16
+
+// storeBlob сохраняет оригинальный файл в хранилище блобов и возвращает его метаданные для записи в БД.
+// SHA-256 считается по ходу передачи, поэтому файл читается один раз.
+func (s *service) storeBlob(ctx context.Context, content io.Reader, size int64, contentType string) (*domain.Blob, error) {
+	suffix, err := generateSecureRandomString(blobKeyRandomBytes)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("documents/%s/%s", time.Now().UTC().Format("2006/01/02"), suffix)
+
+	hash := sha256.New()
+	if err := s.blobStore.Put(ctx, key, io.TeeReader(content, hash), size, contentType); err != nil {
+		return nil, err
+	}
+
+	return &domain.Blob{
+		StorageKey:  key,
+		SHA256:      hex.EncodeToString(hash.Sum(nil)),
+		SizeBytes:   size,
+		ContentType: contentType,
+	}, nil
+}
+
+// deleteBlobObjects удаляет файлы из хранилища после того, как записи о них удалены из БД.
+// Ошибки только логируются: осиротевший объект в хранилище не должен ломать удаление документа.
+func (s *service) deleteBlobObjects(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			s.log.Err(err).Str("blob_key", key).Msg("Не удалось удалить файл из хранилища блобов")
+		}
+	}
+}
+
+// openBlobContent открывает оригинальный файл документа в хранилище блобов.
+func (s *service) openBlobContent(ctx context.Context, doc *domain.Document) (*domain.DocumentContent, error) {
+	blob, err := s.repo.GetBlobByID(ctx, *doc.BlobID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.blobStore.Get(ctx, blob.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DocumentContent{
+		Filename:    doc.Filename,
+		ContentType: blob.ContentType,
+		SizeBytes:   blob.SizeBytes,
+		Body:        body,
+	}, nil
+}
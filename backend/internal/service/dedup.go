@@ -0,0 +1,97 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// contentHasher считает SHA-256 нормализованного текста по мере записи в него:
+// без BOM, с переводами строк \n и без пробельных символов в начале и в конце.
+// Так повторная загрузка того же файла, сохранённого в другом редакторе, даёт тот же хеш.
+type contentHasher struct {
+	hash    hash.Hash
+	out     []byte
+	pending []byte
+	bomPos  int
+	started bool
+	prevCR  bool
+}
+
+func newContentHasher() *contentHasher {
+	return &contentHasher{hash: sha256.New()}
+}
+
+func (h *contentHasher) Write(p []byte) (int, error) {
+	for _, b := range p {
+		h.writeByte(b)
+	}
+	h.hash.Write(h.out)
+	h.out = h.out[:0]
+	return len(p), nil
+}
+
+func (h *contentHasher) writeByte(b byte) {
+	if h.bomPos >= 0 {
+		if b == utf8BOM[h.bomPos] {
+			h.bomPos++
+			if h.bomPos == len(utf8BOM) {
+				h.bomPos = -1
+			}
+			return
+		}
+		// Начало файла совпало с BOM лишь частично — это обычный текст.
+		consumed := utf8BOM[:h.bomPos]
+		h.bomPos = -1
+		for _, c := range consumed {
+			h.writeByte(c)
+		}
+	}
+
+	if h.prevCR {
+		h.prevCR = false
+		if b == '\n' {
+			return
+		}
+	}
+	if b == '\r' {
+		h.prevCR = true
+		b = '\n'
+	}
+
+	switch b {
+	case ' ', '\t', '\n', '\v', '\f':
+		if h.started {
+			h.pending = append(h.pending, b)
+		}
+		return
+	}
+
+	h.started = true
+	h.out = append(h.out, h.pending...)
+	h.pending = h.pending[:0]
+	h.out = append(h.out, b)
+}
+
+func (h *contentHasher) Sum() string {
+	return hex.EncodeToString(h.hash.Sum(nil))
+}
+
+// findDuplicateDocument ищет в рабочем пространстве документ с тем же хешем содержимого.
+func (s *service) findDuplicateDocument(ctx context.Context, userID, workspaceID int64, contentSHA256 string) (*domain.Document, error) {
+	id, err := s.repo.GetWorkspaceDocumentIDByContentHash(ctx, workspaceID, contentSHA256)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.GetDocumentByID(ctx, userID, id)
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func hashContent(chunks ...string) string {
+	h := newContentHasher()
+	for _, chunk := range chunks {
+		h.Write([]byte(chunk))
+	}
+	return h.Sum()
+}
+
+func TestContentHasher(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		// normalized — текст, SHA-256 которого должен получиться.
+		normalized string
+	}{
+		{name: "plain text", input: "hello\nworld", normalized: "hello\nworld"},
+		{name: "empty", input: "", normalized: ""},
+		{name: "only whitespace", input: " \t\r\n\n", normalized: ""},
+		{name: "BOM", input: "\xEF\xBB\xBFhello", normalized: "hello"},
+		{name: "CRLF", input: "a\r\nb\r\n", normalized: "a\nb"},
+		{name: "CR", input: "a\rb", normalized: "a\nb"},
+		{name: "CR CR LF", input: "a\r\r\nb", normalized: "a\n\nb"},
+		{name: "leading and trailing whitespace", input: "\n\t  hello world \n\n", normalized: "hello world"},
+		{name: "inner whitespace is kept", input: "a  \t\n\n b", normalized: "a  \t\n\n b"},
+		{name: "BOM with CRLF and padding", input: "\xEF\xBB\xBF\r\n a\r\nb \r\n", normalized: "a\nb"},
+		{name: "partial BOM is text", input: "\xEF\xBBx", normalized: "\xEF\xBBx"},
+		{name: "BOM in the middle is text", input: "a\xEF\xBB\xBFb", normalized: "a\xEF\xBB\xBFb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := sha256.Sum256([]byte(tt.normalized))
+			want := hex.EncodeToString(sum[:])
+
+			if got := hashContent(tt.input); got != want {
+				t.Errorf("hash of %q differs from hash of %q", tt.input, tt.normalized)
+			}
+
+			// Результат не зависит от того, как текст разбит на записи.
+			bytes := make([]string, len(tt.input))
+			for i := 0; i < len(tt.input); i++ {
+				bytes[i] = tt.input[i : i+1]
+			}
+			if got := hashContent(bytes...); got != want {
+				t.Errorf("byte-by-byte hash of %q differs from hash of %q", tt.input, tt.normalized)
+			}
+		})
+	}
+}
+
+func TestContentHasherDistinguishesContent(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"hello", "hello!"},
+		{"a b", "ab"},
+		{"a\nb", "a\n\nb"},
+		{"a\tb", "a b"},
+		{"Hello", "hello"},
+	}
+
+	for _, tt := range tests {
+		if hashContent(tt.a) == hashContent(tt.b) {
+			t.Errorf("%q and %q have the same hash", tt.a, tt.b)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package service
 
 import (
+	"backend/internal/blobstore"
 	"backend/internal/domain"
 	"backend/internal/repository"
+	"bufio"
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"strings"
 	"unicode/utf8"
 
@@ -12,12 +16,14 @@ import (
 )
 
 type DocumentService interface {
-	UploadDocument(ctx context.Context, userID int64, filename string, fileContent []byte) (*domain.Document, error)
-	Search(ctx context.Context, userID int64, query string) ([]domain.SearchResult, error)
+	UploadDocument(ctx context.Context, userID int64, workspaceID *int64, filename, contentType string, content io.ReadSeeker, size int64) (*domain.Document, error)
+	Search(ctx context.Context, userID int64, workspaceID *int64, query string, filter domain.SearchFilter) ([]domain.SearchResult, error)
 	SearchInDocument(ctx context.Context, userID, documentID int64, query string) ([]domain.SearchResult, error)
-	ListUserDocuments(ctx context.Context, userID int64) ([]domain.Document, error)
+	ListUserDocuments(ctx context.Context, userID int64, query domain.DocumentListQuery) (*domain.DocumentPage, error)
 	DeleteUserDocument(ctx context.Context, userID, documentID int64) error
 	GetDocumentByID(ctx context.Context, userID, documentID int64) (*domain.Document, error)
+	ListDocumentChunks(ctx context.Context, userID, documentID, page, size int64) ([]domain.Chunk, int64, error)
+	GetDocumentContent(ctx context.Context, userID, documentID int64) (*domain.DocumentContent, error)
 }
 
 var (
@@ -28,44 +34,105 @@ const (
 	searchLimit  = 10
 	chunkSize    = 1000
 	chunkOverlap = 50
+
+	// chunkInsertBatchSize — сколько чанков сохраняется одним запросом при загрузке документа.
+	chunkInsertBatchSize = 500
+
+	reconstructedContentType = "text/plain; charset=utf-8"
 )
 
-func (s *service) UploadDocument(ctx context.Context, userID int64, filename string, fileContent []byte) (*domain.Document, error) {
-	s.log.Info().Int64("user_id", userID).Str("filename", filename).Int("size_bytes", len(fileContent)).Msg("Начало загрузки документа")
+func (s *service) UploadDocument(ctx context.Context, userID int64, workspaceID *int64, filename, contentType string, content io.ReadSeeker, size int64) (*domain.Document, error) {
+	workspace, err := s.resolveWorkspace(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanWriteWorkspace(workspace.Role) {
+		return nil, ErrWorkspaceForbidden
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("workspace_id", workspace.ID).Str("filename", filename).Int64("size_bytes", size).Msg("Начало загрузки документа")
+
+	hasher := newContentHasher()
+	chunksCount, err := countChunks(io.TeeReader(content, hasher))
+	if err != nil {
+		s.log.Err(err).Msg("Ошибка чтения текста документа")
+		return nil, err
+	}
+	s.log.Info().Int64("chunks_count", chunksCount).Msg("Текст разбит на чанки")
+
+	contentSHA256 := hasher.Sum()
+	existing, err := s.findDuplicateDocument(ctx, userID, workspace.ID, contentSHA256)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		s.log.Info().Int64("doc_id", existing.ID).Str("filename", filename).Msg("Документ с таким содержимым уже загружен")
+		return nil, &domain.DuplicateDocumentError{Document: existing}
+	}
+
+	if err := s.checkUploadQuota(ctx, s.repo, userID, 1, size, chunksCount); err != nil {
+		return nil, err
+	}
+
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	blob, err := s.storeBlob(ctx, content, size, contentType)
+	if err != nil {
+		s.log.Err(err).Msg("Ошибка сохранения оригинального файла")
+		return nil, err
+	}
 
 	var doc *domain.Document
-	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
-		createdDoc, err := repo.CreateDocument(ctx, userID, filename)
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := s.checkUploadQuotaLocked(ctx, repo, userID, 1, size, chunksCount); err != nil {
+			return err
+		}
+
+		createdBlob, err := repo.CreateBlob(ctx, *blob)
+		if err != nil {
+			return err
+		}
+
+		createdDoc, err := repo.CreateDocument(ctx, userID, workspace.ID, filename, size, &createdBlob.ID, contentSHA256)
 		if err != nil {
 			s.log.Err(err).Msg("Ошибка создания документа в БД")
 			return err
 		}
 
-		s.log.Info().Int64("doc_id", createdDoc.ID).Msg("Документ создан, начинаем чанкование")
+		_, err = repo.CreateDocumentVersion(ctx, domain.DocumentVersion{
+			DocumentID:    createdDoc.ID,
+			Version:       createdDoc.CurrentVersion,
+			SizeBytes:     size,
+			BlobID:        &createdBlob.ID,
+			ContentSHA256: contentSHA256,
+			CreatedBy:     &userID,
+		})
+		if err != nil {
+			return err
+		}
 
-		semanticSplitter := NewTextSplitter(chunkSize, chunkOverlap)
-		chunks := semanticSplitter.SplitText(string(fileContent))
-		s.log.Info().Int("chunks_count", len(chunks)).Msg("Текст разбит на чанки")
+		s.log.Info().Int64("doc_id", createdDoc.ID).Msg("Документ создан, сохраняем чанки")
 
-		for i, chunkText := range chunks {
-			if _, err := repo.CreateChunk(ctx, userID, createdDoc.ID, filename, chunkText); err != nil {
-				s.log.Err(err).Int("chunk_index", i).Msg("Ошибка сохранения чанка")
-				return err
-			}
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		created, reused, err := s.createVersionChunks(ctx, repo, userID, createdDoc, createdDoc.CurrentVersion, content)
+		if err != nil {
+			return err
 		}
-
-		s.log.Debug().Msg("Чанки загружены")
 
 		doc = createdDoc
-		chunksLen := int64(len(chunks))
-		doc.TotalEmbeddings = chunksLen
-		doc.NullEmbeddings = chunksLen
+		doc.TotalEmbeddings = int64(created)
+		doc.NullEmbeddings = int64(created - reused)
 
 		return nil
 	})
 
 	if err != nil {
 		s.log.Err(err).Msg("Транзакция не удалась")
+		s.deleteBlobObjects(ctx, []string{blob.StorageKey})
 		return nil, err
 	}
 
@@ -73,18 +140,97 @@ func (s *service) UploadDocument(ctx context.Context, userID int64, filename str
 	return doc, nil
 }
 
-func (s *service) Search(ctx context.Context, userID int64, query string) ([]domain.SearchResult, error) {
-	searchEmbedding, err := s.embeddingClient.CreateSearchEmbedding(ctx, query)
+// countChunks возвращает, на сколько чанков разобьется текст из content, не сохраняя сами чанки.
+// Нужен для проверки квоты до того, как чанки начнут записываться.
+func countChunks(content io.Reader) (int64, error) {
+	var count int64
+	err := NewTextSplitter(chunkSize, chunkOverlap).SplitReader(content, func(domain.ChunkText) error {
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// createVersionChunks разбивает текст из content на чанки и сохраняет их как чанки версии документа.
+// Чанки вставляются пачками по chunkInsertBatchSize по мере разбиения, поэтому в памяти
+// держится не больше одной пачки.
+// Возвращает количество сохраненных чанков и сколько из них получили готовый эмбеддинг
+// от идентичного чанка того же рабочего пространства, например от неизменившейся части предыдущей версии.
+func (s *service) createVersionChunks(ctx context.Context, repo repository.Repository, userID int64, doc *domain.Document, version int32, content io.Reader) (int, int, error) {
+	var reusedChunkIDs []int64
+	batch := make([]domain.ChunkText, 0, chunkInsertBatchSize)
+	saved := 0
+
+	insertBatch := func() error {
+		created, err := repo.CreateChunks(ctx, userID, doc.WorkspaceID, doc.ID, version, doc.Filename, batch)
+		if err != nil {
+			s.log.Err(err).Int("chunk_index", saved).Int("batch_size", len(batch)).Msg("Ошибка сохранения чанков")
+			return err
+		}
+		for _, chunk := range created {
+			if chunk.Embedded {
+				reusedChunkIDs = append(reusedChunkIDs, chunk.ID)
+			}
+		}
+		saved += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	err := NewTextSplitter(chunkSize, chunkOverlap).SplitReader(content, func(chunk domain.ChunkText) error {
+		batch = append(batch, chunk)
+		if len(batch) < chunkInsertBatchSize {
+			return nil
+		}
+		return insertBatch()
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(batch) > 0 {
+		if err := insertBatch(); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Эмбеддинги идентичных чанков уже посчитаны — векторизатору их пересчитывать не нужно.
+	if len(reusedChunkIDs) > 0 {
+		if err := repo.DequeueChunkEmbeddings(ctx, reusedChunkIDs); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	s.log.Debug().Int64("doc_id", doc.ID).Int32("version", version).Int("chunks_count", saved).Int("reused_embeddings", len(reusedChunkIDs)).Msg("Чанки загружены")
+	return saved, len(reusedChunkIDs), nil
+}
+
+func (s *service) Search(ctx context.Context, userID int64, workspaceID *int64, query string, filter domain.SearchFilter) ([]domain.SearchResult, error) {
+	filter, err := normalizeSearchFilter(filter)
 	if err != nil {
-		s.log.Err(err).Msg("failed to get embedding for query")
 		return nil, err
 	}
-	if len(searchEmbedding.Data) == 0 {
-		return nil, errors.New("embedding service returned no embeddings for query")
+
+	if workspaceID != nil {
+		if _, err := s.GetWorkspace(ctx, userID, *workspaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	folderIDs, err := s.folderSubtree(ctx, userID, filter.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.consumeSearchQuota(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	embedding, err := s.embedQuery(ctx, query)
+	if err != nil {
+		return nil, err
 	}
 
-	embedding := searchEmbedding.Data[0].Embedding
-	return s.repo.SearchUserChunks(ctx, userID, embedding, searchLimit)
+	return s.repo.SearchUserChunks(ctx, userID, workspaceID, folderIDs, filter, embedding, searchLimit)
 }
 
 func (s *service) SearchInDocument(ctx context.Context, userID, documentID int64, query string) ([]domain.SearchResult, error) {
@@ -96,25 +242,39 @@ func (s *service) SearchInDocument(ctx context.Context, userID, documentID int64
 		return nil, err
 	}
 
-	searchEmbedding, err := s.embeddingClient.CreateSearchEmbedding(ctx, query)
-	if err != nil {
-		s.log.Err(err).Msg("failed to get embedding for query")
+	if err := s.consumeSearchQuota(ctx, userID); err != nil {
 		return nil, err
 	}
-	if len(searchEmbedding.Data) == 0 {
-		return nil, errors.New("embedding service returned no embeddings for query")
+
+	embedding, err := s.embedQuery(ctx, query)
+	if err != nil {
+		return nil, err
 	}
 
-	embedding := searchEmbedding.Data[0].Embedding
 	return s.repo.SearchChunksInDocument(ctx, userID, documentID, embedding, searchLimit)
 }
 
-func (s *service) ListUserDocuments(ctx context.Context, userID int64) ([]domain.Document, error) {
-	return s.repo.GetUserDocuments(ctx, userID)
-}
-
+// DeleteUserDocument перемещает документ в корзину. Окончательно он удаляется при очистке корзины
+// или фоновой очисткой после срока хранения.
 func (s *service) DeleteUserDocument(ctx context.Context, userID, documentID int64) error {
-	return s.repo.DeleteUserDocument(ctx, documentID, userID)
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return err
+	}
+	if !domain.CanWriteWorkspace(doc.WorkspaceRole) {
+		return ErrWorkspaceForbidden
+	}
+
+	trashed, err := s.repo.TrashUserDocument(ctx, documentID, userID)
+	if err != nil {
+		return err
+	}
+	if !trashed {
+		return ErrDocumentNotFound
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("doc_id", documentID).Msg("Документ перемещён в корзину")
+	return nil
 }
 
 func (s *service) GetDocumentByID(ctx context.Context, userID, documentID int64) (*domain.Document, error) {
@@ -128,6 +288,65 @@ func (s *service) GetDocumentByID(ctx context.Context, userID, documentID int64)
 	return doc, nil
 }
 
+func (s *service) ListDocumentChunks(ctx context.Context, userID, documentID, page, size int64) ([]domain.Chunk, int64, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	chunks, err := s.repo.GetChunksByDocumentIDPage(ctx, documentID, userID, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	return chunks, doc.TotalEmbeddings, nil
+}
+
+// GetDocumentContent отдаёт оригинальный файл, если он сохранён в хранилище блобов,
+// иначе восстанавливает текст документа из чанков.
+func (s *service) GetDocumentContent(ctx context.Context, userID, documentID int64) (*domain.DocumentContent, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.BlobID != nil {
+		content, err := s.openBlobContent(ctx, doc)
+		if err == nil {
+			return content, nil
+		}
+		if !errors.Is(err, blobstore.ErrNotFound) && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		s.log.Warn().Int64("doc_id", doc.ID).Msg("Оригинальный файл не найден в хранилище, восстанавливаем текст из чанков")
+	}
+
+	chunks, err := s.repo.GetChunksByDocumentID(ctx, documentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	text := JoinChunks(chunks)
+
+	return &domain.DocumentContent{
+		Filename:    doc.Filename,
+		ContentType: reconstructedContentType,
+		SizeBytes:   int64(len(text)),
+		Body:        io.NopCloser(strings.NewReader(text)),
+	}, nil
+}
+
+func (s *service) embedQuery(ctx context.Context, query string) ([]float32, error) {
+	searchEmbedding, err := s.embeddingClient.CreateSearchEmbedding(ctx, query)
+	if err != nil {
+		s.log.Err(err).Msg("failed to get embedding for query")
+		return nil, err
+	}
+	if len(searchEmbedding.Data) == 0 {
+		return nil, errors.New("embedding service returned no embeddings for query")
+	}
+	return searchEmbedding.Data[0].Embedding, nil
+}
+
 type TextSplitter struct {
 	ChunkSize    int
 	ChunkOverlap int
@@ -171,15 +390,15 @@ func (s *TextSplitter) splitTextWithSeparators(text string, separators []string)
 	separator := separators[0]
 	remainingSeparators := separators[1:]
 
+	// Разделитель остается в конце части, а подряд идущие разделители — отдельными частями,
+	// чтобы из частей можно было собрать исходный текст без потерь.
 	splits := strings.Split(text, separator)
 	var goodSplits []string
 	for i, split := range splits {
-		if split != "" {
-			if i < len(splits)-1 {
-				goodSplits = append(goodSplits, split+separator)
-			} else {
-				goodSplits = append(goodSplits, split)
-			}
+		if i < len(splits)-1 {
+			goodSplits = append(goodSplits, split+separator)
+		} else if split != "" {
+			goodSplits = append(goodSplits, split)
 		}
 	}
 
@@ -193,46 +412,165 @@ func (s *TextSplitter) splitTextWithSeparators(text string, separators []string)
 	return finalChunks
 }
 
-func (s *TextSplitter) mergeSplits(splits []string) []string {
-	var chunks []string
-	var currentChunk []string
-	currentLength := 0
-
-	for _, split := range splits {
-		splitLength := utf8.RuneCountInString(split)
-
-		if currentLength+splitLength > s.ChunkSize && len(currentChunk) > 0 {
-			chunkText := strings.Join(currentChunk, "")
-			chunks = append(chunks, chunkText)
-
-			var overlap []string
-			overlapLength := 0
-			for i := len(currentChunk) - 1; i >= 0; i-- {
-				part := currentChunk[i]
-				partLength := utf8.RuneCountInString(part)
-				if overlapLength+partLength > s.ChunkOverlap && len(overlap) > 0 {
-					break
-				}
-				overlapLength += partLength
-				overlap = append([]string{part}, overlap...)
-			}
-			currentChunk = overlap
-			currentLength = overlapLength
+// chunkMerger склеивает части текста в чанки размером до ChunkSize с перекрытием ChunkOverlap.
+// Части подаются по одной, а готовые чанки сразу передаются в emit, поэтому ни текст,
+// ни список чанков не нужно держать в памяти целиком.
+// Для каждого чанка запоминается длина перекрытия с предыдущим, чтобы JoinChunks собирал текст точно.
+type chunkMerger struct {
+	splitter       *TextSplitter
+	emit           func(domain.ChunkText) error
+	currentChunk   []string
+	currentLength  int
+	currentOverlap int
+}
+
+func (m *chunkMerger) add(split string) error {
+	splitLength := utf8.RuneCountInString(split)
+
+	if m.currentLength+splitLength > m.splitter.ChunkSize && len(m.currentChunk) > 0 {
+		if err := m.flush(); err != nil {
+			return err
 		}
 
-		currentChunk = append(currentChunk, split)
-		currentLength += splitLength
+		var overlap []string
+		overlapLength := 0
+		overlapBytes := 0
+		for i := len(m.currentChunk) - 1; i >= 0; i-- {
+			part := m.currentChunk[i]
+			partLength := utf8.RuneCountInString(part)
+			if overlapLength+partLength > m.splitter.ChunkOverlap && len(overlap) > 0 {
+				break
+			}
+			overlapLength += partLength
+			overlapBytes += len(part)
+			overlap = append([]string{part}, overlap...)
+		}
+		m.currentChunk = overlap
+		m.currentLength = overlapLength
+		m.currentOverlap = overlapBytes
 	}
 
-	if len(currentChunk) > 0 {
-		chunkText := strings.Join(currentChunk, "")
-		chunks = append(chunks, chunkText)
+	m.currentChunk = append(m.currentChunk, split)
+	m.currentLength += splitLength
+	return nil
+}
+
+// flush передает накопленные части в emit, не сбрасывая их: из них берется перекрытие.
+func (m *chunkMerger) flush() error {
+	return m.emit(domain.ChunkText{
+		Text:         strings.Join(m.currentChunk, ""),
+		OverlapBytes: int32(m.currentOverlap),
+	})
+}
+
+func (m *chunkMerger) finish() error {
+	if len(m.currentChunk) == 0 {
+		return nil
 	}
+	err := m.flush()
+	m.currentChunk = nil
+	return err
+}
 
+func (s *TextSplitter) SplitText(text string) []domain.ChunkText {
+	var chunks []domain.ChunkText
+	merger := &chunkMerger{splitter: s, emit: func(chunk domain.ChunkText) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}}
+	for _, split := range s.splitTextWithSeparators(text, s.Separators) {
+		_ = merger.add(split)
+	}
+	_ = merger.finish()
 	return chunks
 }
 
-func (s *TextSplitter) SplitText(text string) []string {
-	splits := s.splitTextWithSeparators(text, s.Separators)
-	return s.mergeSplits(splits)
+// SplitReader разбивает текст из r на чанки так же, как SplitText, но читает его по абзацам
+// и передает каждый готовый чанк в emit, не загружая в память ни весь файл, ни список чанков.
+// Ошибка emit прерывает разбиение и возвращается как есть.
+func (s *TextSplitter) SplitReader(r io.Reader, emit func(domain.ChunkText) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxParagraphBytes)
+	scanner.Split(scanParagraphs)
+
+	merger := &chunkMerger{splitter: s, emit: emit}
+	for scanner.Scan() {
+		for _, split := range s.splitTextWithSeparators(scanner.Text(), s.Separators) {
+			if err := merger.add(split); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return merger.finish()
+}
+
+// maxParagraphBytes ограничивает размер абзаца, который SplitReader держит в памяти целиком.
+// Более длинный текст без пустых строк режется на куски по границе руны.
+const maxParagraphBytes = 1024 * 1024
+
+// scanParagraphs — bufio.SplitFunc, возвращающая абзацы вместе с завершающим "\n\n".
+func scanParagraphs(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+		return i + 2, data[:i+2], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	if len(data) >= maxParagraphBytes {
+		// Последняя руна может быть прочитана не полностью — отрезаем перед ней.
+		n := len(data) - 1
+		for n > 0 && !utf8.RuneStart(data[n]) {
+			n--
+		}
+		if n == 0 {
+			n = len(data)
+		}
+		return n, data[:n], nil
+	}
+	return 0, nil, nil
+}
+
+// JoinChunks собирает исходный текст из чанков одной версии, отбрасывая у каждого чанка
+// перекрытие с предыдущим, записанное при разбиении.
+// У чанков, созданных до того, как перекрытие стало сохраняться, оно ищется как самый длинный
+// суффикс предыдущего чанка, совпадающий с началом следующего.
+func JoinChunks(chunks []domain.Chunk) string {
+	var b strings.Builder
+	for i, chunk := range chunks {
+		text := chunk.Text
+		if i > 0 {
+			text = text[chunkOverlapBytes(chunks[i-1].Text, chunk):]
+		}
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+// chunkOverlapBytes возвращает длину перекрытия чанка с предыдущим чанком prev.
+func chunkOverlapBytes(prev string, chunk domain.Chunk) int {
+	if chunk.OverlapBytes != nil {
+		return min(max(int(*chunk.OverlapBytes), 0), len(chunk.Text))
+	}
+	return overlapLength(prev, chunk.Text)
+}
+
+// overlapLength возвращает длину в байтах самого длинного суффикса prev, который является префиксом next
+// и заканчивается на границе руны.
+func overlapLength(prev, next string) int {
+	maxLen := min(len(prev), len(next))
+	for n := maxLen; n > 0; n-- {
+		if !utf8.RuneStart(next[0]) || (n < len(next) && !utf8.RuneStart(next[n])) {
+			continue
+		}
+		if strings.HasSuffix(prev, next[:n]) {
+			return n
+		}
+	}
+	return 0
 }
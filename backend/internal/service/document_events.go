@@ -0,0 +1,162 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type DocumentEventsService interface {
+	SubscribeDocumentEvents(userID int64) (<-chan domain.DocumentEvent, func(), error)
+	RunDocumentEvents(ctx context.Context)
+}
+
+var (
+	ErrTooManyEventStreams = errors.New("too many open event streams")
+)
+
+const (
+	// documentEventsBuffer — сколько событий может ждать отправки одному подписчику.
+	// Если клиент не успевает их читать, новые события для него отбрасываются.
+	documentEventsBuffer = 32
+
+	// maxDocumentEventStreams — сколько потоков событий один пользователь может держать открытыми одновременно.
+	maxDocumentEventStreams = 5
+
+	// documentEventsReconnectDelay — пауза перед повторной подпиской на изменения документов после обрыва соединения с БД.
+	documentEventsReconnectDelay = 5 * time.Second
+)
+
+// documentEventHub хранит открытые потоки событий по пользователям.
+type documentEventHub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan domain.DocumentEvent]struct{}
+	closed      bool
+}
+
+// SubscribeDocumentEvents открывает поток событий документов пользователя.
+// Канал закрывается вызовом возвращенной функции или при остановке сервиса.
+// Если у пользователя уже открыто maxDocumentEventStreams потоков, возвращает ErrTooManyEventStreams.
+func (s *service) SubscribeDocumentEvents(userID int64) (<-chan domain.DocumentEvent, func(), error) {
+	hub := &s.documentEvents
+	ch := make(chan domain.DocumentEvent, documentEventsBuffer)
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.closed {
+		close(ch)
+		return ch, func() {}, nil
+	}
+	if len(hub.subscribers[userID]) >= maxDocumentEventStreams {
+		return nil, nil, ErrTooManyEventStreams
+	}
+	if hub.subscribers == nil {
+		hub.subscribers = make(map[int64]map[chan domain.DocumentEvent]struct{})
+	}
+	if hub.subscribers[userID] == nil {
+		hub.subscribers[userID] = make(map[chan domain.DocumentEvent]struct{})
+	}
+	hub.subscribers[userID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+
+		if _, ok := hub.subscribers[userID][ch]; !ok {
+			return
+		}
+		delete(hub.subscribers[userID], ch)
+		if len(hub.subscribers[userID]) == 0 {
+			delete(hub.subscribers, userID)
+		}
+		close(ch)
+	}
+	return ch, unsubscribe, nil
+}
+
+// RunDocumentEvents слушает уведомления Postgres об изменениях документов и рассылает события подписчикам.
+// Уведомления пишут триггеры на счетчиках чанков, поэтому прогресс виден при любом способе
+// расчета эмбеддингов — воркером pgai или внутри приложения.
+func (s *service) RunDocumentEvents(ctx context.Context) {
+	defer s.closeDocumentEvents()
+
+	for {
+		err := s.repo.ListenDocumentChanges(ctx, func(documentID int64) {
+			if err := s.publishDocumentEvent(ctx, documentID); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Int64("doc_id", documentID).Msg("failed to publish document event")
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		s.log.Err(err).Msg("Подписка на изменения документов прервана, переподключение")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(documentEventsReconnectDelay):
+		}
+	}
+}
+
+func (s *service) publishDocumentEvent(ctx context.Context, documentID int64) error {
+	if !s.hasDocumentEventSubscribers() {
+		return nil
+	}
+
+	event, err := s.repo.GetDocumentProgress(ctx, documentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Документ уже удален окончательно — сообщать некому и не о чем.
+			return nil
+		}
+		return err
+	}
+
+	audience, err := s.repo.GetDocumentAudience(ctx, documentID)
+	if err != nil {
+		return err
+	}
+
+	hub := &s.documentEvents
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for _, userID := range audience {
+		for ch := range hub.subscribers[userID] {
+			select {
+			case ch <- *event:
+			default:
+				s.log.Debug().Int64("user_id", userID).Int64("doc_id", documentID).Msg("Поток событий переполнен, событие пропущено")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *service) hasDocumentEventSubscribers() bool {
+	hub := &s.documentEvents
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return len(hub.subscribers) > 0
+}
+
+// closeDocumentEvents закрывает все открытые потоки, чтобы HTTP-сервер мог завершить их при остановке.
+func (s *service) closeDocumentEvents() {
+	hub := &s.documentEvents
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.closed = true
+	for _, channels := range hub.subscribers {
+		for ch := range channels {
+			close(ch)
+		}
+	}
+	hub.subscribers = nil
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidDocumentSort   = errors.New("invalid sort: expected name, createdAt or size")
+	ErrInvalidDocumentStatus = errors.New("invalid status: expected processing or ready")
+	ErrInvalidCursor         = errors.New("invalid or stale cursor")
+	ErrFilenameFilterTooLong = errors.New("filename filter is too long")
+)
+
+const (
+	defaultDocumentPageSize  = 20
+	maxDocumentPageSize      = 100
+	maxFilenameFilterLength  = 255
+	defaultDocumentSort      = domain.DocumentSortCreatedAt
+	documentCursorTimeLayout = time.RFC3339Nano
+)
+
+// documentCursor — содержимое курсора страницы. Курсор привязан к сортировке,
+// чтобы его нельзя было продолжить с другим порядком.
+type documentCursor struct {
+	Sort       string `json:"s"`
+	Descending bool   `json:"d"`
+	Key        string `json:"k"`
+	ID         int64  `json:"i"`
+}
+
+// encodeDocumentCursor кодирует позицию последнего документа страницы для продолжения списка.
+func encodeDocumentCursor(sort string, descending bool, doc *domain.Document) string {
+	cursor := documentCursor{Sort: sort, Descending: descending, ID: doc.ID}
+	switch sort {
+	case domain.DocumentSortName:
+		cursor.Key = doc.Filename
+	case domain.DocumentSortSize:
+		cursor.Key = strconv.FormatInt(doc.SizeBytes, 10)
+	default:
+		cursor.Key = doc.CreatedAt.UTC().Format(documentCursorTimeLayout)
+	}
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeDocumentCursor(value, sort string, descending bool) (*domain.DocumentPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor documentCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != sort || cursor.Descending != descending || cursor.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	position := &domain.DocumentPosition{ID: cursor.ID}
+	switch sort {
+	case domain.DocumentSortName:
+		position.Name = cursor.Key
+	case domain.DocumentSortSize:
+		if position.SizeBytes, err = strconv.ParseInt(cursor.Key, 10, 64); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	default:
+		if position.CreatedAt, err = time.Parse(documentCursorTimeLayout, cursor.Key); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return position, nil
+}
+
+// ListUserDocuments возвращает документы пользователя вместе с общим числом документов, подходящих под фильтры.
+// Постраничный вывод включается параметрами Limit или Cursor, без них возвращаются все подходящие документы.
+// Если задан FolderID — только из этой папки и ее подпапок.
+// По умолчанию документы сортируются от новых к старым, по имени — по алфавиту.
+func (s *service) ListUserDocuments(ctx context.Context, userID int64, query domain.DocumentListQuery) (*domain.DocumentPage, error) {
+	sort := query.Sort
+	if sort == "" {
+		sort = defaultDocumentSort
+	}
+	if sort != domain.DocumentSortName && sort != domain.DocumentSortCreatedAt && sort != domain.DocumentSortSize {
+		return nil, ErrInvalidDocumentSort
+	}
+	descending := sort != domain.DocumentSortName
+	if query.Descending != nil {
+		descending = *query.Descending
+	}
+
+	if query.Status != "" && query.Status != domain.DocumentStatusProcessing && query.Status != domain.DocumentStatusReady {
+		return nil, ErrInvalidDocumentStatus
+	}
+	filename := strings.TrimSpace(query.Filename)
+	if utf8.RuneCountInString(filename) > maxFilenameFilterLength {
+		return nil, ErrFilenameFilterTooLong
+	}
+	var tags []string
+	if len(query.Tags) > 0 {
+		var err error
+		if tags, err = normalizeTags(query.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	paged := query.Limit > 0 || query.Cursor != ""
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultDocumentPageSize
+	}
+	limit = min(limit, maxDocumentPageSize)
+
+	var after *domain.DocumentPosition
+	if query.Cursor != "" {
+		var err error
+		if after, err = decodeDocumentCursor(query.Cursor, sort, descending); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.WorkspaceID != nil {
+		if _, err := s.GetWorkspace(ctx, userID, *query.WorkspaceID); err != nil {
+			return nil, err
+		}
+	}
+	folderIDs, err := s.folderSubtree(ctx, userID, query.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.DocumentFilter{
+		WorkspaceID: query.WorkspaceID,
+		FolderIDs:   folderIDs,
+		Filename:    filename,
+		Status:      query.Status,
+		Tags:        tags,
+	}
+
+	if !paged {
+		docs, err := s.repo.GetUserDocuments(ctx, userID, filter, sort, descending, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.DocumentPage{Items: docs, Total: int64(len(docs))}, nil
+	}
+
+	// Запрашиваем на один документ больше, чтобы понять, есть ли следующая страница.
+	docs, err := s.repo.GetUserDocuments(ctx, userID, filter, sort, descending, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repo.CountUserDocuments(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.DocumentPage{Items: docs, Total: total}
+	if len(docs) > int(limit) {
+		page.Items = docs[:limit]
+		page.NextCursor = encodeDocumentCursor(sort, descending, &page.Items[limit-1])
+	}
+	return page, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDocumentCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.FixedZone("MSK", 3*60*60))
+	doc := &domain.Document{ID: 42, Filename: "отчёт.txt", SizeBytes: 1 << 40, CreatedAt: createdAt}
+
+	tests := []struct {
+		sort       string
+		descending bool
+		want       domain.DocumentPosition
+	}{
+		{sort: domain.DocumentSortName, want: domain.DocumentPosition{ID: 42, Name: "отчёт.txt"}},
+		{sort: domain.DocumentSortName, descending: true, want: domain.DocumentPosition{ID: 42, Name: "отчёт.txt"}},
+		{sort: domain.DocumentSortSize, descending: true, want: domain.DocumentPosition{ID: 42, SizeBytes: 1 << 40}},
+		{sort: domain.DocumentSortCreatedAt, descending: true, want: domain.DocumentPosition{ID: 42, CreatedAt: createdAt}},
+	}
+
+	for _, tt := range tests {
+		cursor := encodeDocumentCursor(tt.sort, tt.descending, doc)
+		position, err := decodeDocumentCursor(cursor, tt.sort, tt.descending)
+		if err != nil {
+			t.Errorf("%s (descending %v): %v", tt.sort, tt.descending, err)
+			continue
+		}
+		if position.ID != tt.want.ID || position.Name != tt.want.Name || position.SizeBytes != tt.want.SizeBytes || !position.CreatedAt.Equal(tt.want.CreatedAt) {
+			t.Errorf("%s (descending %v): position = %+v, want %+v", tt.sort, tt.descending, position, tt.want)
+		}
+	}
+}
+
+func TestDecodeDocumentCursorRejectsInvalid(t *testing.T) {
+	doc := &domain.Document{ID: 42, Filename: "a.txt", SizeBytes: 10, CreatedAt: time.Now()}
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name       string
+		cursor     string
+		sort       string
+		descending bool
+	}{
+		{name: "other sort", cursor: encodeDocumentCursor(domain.DocumentSortName, false, doc), sort: domain.DocumentSortSize},
+		{name: "other direction", cursor: encodeDocumentCursor(domain.DocumentSortName, false, doc), sort: domain.DocumentSortName, descending: true},
+		{name: "not base64", cursor: "not a cursor!", sort: domain.DocumentSortName},
+		{name: "not JSON", cursor: encode("cursor"), sort: domain.DocumentSortName},
+		{name: "missing ID", cursor: encode(`{"s":"name","d":false,"k":"a.txt"}`), sort: domain.DocumentSortName},
+		{name: "negative ID", cursor: encode(`{"s":"name","d":false,"k":"a.txt","i":-1}`), sort: domain.DocumentSortName},
+		{name: "size is not a number", cursor: encode(`{"s":"size","d":true,"k":"ten","i":1}`), sort: domain.DocumentSortSize, descending: true},
+		{name: "bad time", cursor: encode(`{"s":"createdAt","d":true,"k":"yesterday","i":1}`), sort: domain.DocumentSortCreatedAt, descending: true},
+	}
+
+	for _, tt := range tests {
+		if _, err := decodeDocumentCursor(tt.cursor, tt.sort, tt.descending); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, ErrInvalidCursor)
+		}
+	}
+}
+
+// documentListRepository отдаёт документы, отсортированные по имени и ID, с продолжением после позиции.
+type documentListRepository struct {
+	fakeRepository
+	docs      []domain.Document
+	lastLimit int32
+}
+
+func (r *documentListRepository) GetUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter, sort string, descending bool, after *domain.DocumentPosition, limit int32) ([]domain.Document, error) {
+	r.lastLimit = limit
+
+	var docs []domain.Document
+	for _, doc := range r.docs {
+		if after != nil && (doc.Filename < after.Name || doc.Filename == after.Name && doc.ID <= after.ID) {
+			continue
+		}
+		if limit > 0 && len(docs) == int(limit) {
+			break
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func (r *documentListRepository) CountUserDocuments(ctx context.Context, userID int64, filter domain.DocumentFilter) (int64, error) {
+	return int64(len(r.docs)), nil
+}
+
+func TestListUserDocumentsPaging(t *testing.T) {
+	docs := []domain.Document{
+		{ID: 1, Filename: "a.txt"},
+		{ID: 2, Filename: "b.txt"},
+		{ID: 3, Filename: "b.txt"},
+		{ID: 4, Filename: "c.txt"},
+		{ID: 5, Filename: "d.txt"},
+	}
+
+	tests := []struct {
+		name  string
+		limit int32
+		// wantPages — ID документов на каждой странице при проходе по курсорам.
+		wantPages [][]int64
+		wantLimit int32
+	}{
+		{name: "without paging", limit: 0, wantPages: [][]int64{{1, 2, 3, 4, 5}}, wantLimit: 0},
+		{name: "pages of two", limit: 2, wantPages: [][]int64{{1, 2}, {3, 4}, {5}}, wantLimit: 3},
+		{name: "page size equals total", limit: 5, wantPages: [][]int64{{1, 2, 3, 4, 5}}, wantLimit: 6},
+		{name: "page size is capped", limit: 1000, wantPages: [][]int64{{1, 2, 3, 4, 5}}, wantLimit: maxDocumentPageSize + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &documentListRepository{docs: docs}
+			s := newTestService(repo)
+
+			query := domain.DocumentListQuery{Sort: domain.DocumentSortName, Limit: tt.limit}
+			for i, wantIDs := range tt.wantPages {
+				page, err := s.ListUserDocuments(context.Background(), 1, query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if repo.lastLimit != tt.wantLimit {
+					t.Errorf("page %d: repository limit = %d, want %d", i+1, repo.lastLimit, tt.wantLimit)
+				}
+				if page.Total != int64(len(docs)) {
+					t.Errorf("page %d: total = %d, want %d", i+1, page.Total, len(docs))
+				}
+
+				ids := make([]int64, len(page.Items))
+				for j, doc := range page.Items {
+					ids[j] = doc.ID
+				}
+				if !slices.Equal(ids, wantIDs) {
+					t.Fatalf("page %d = %v, want %v", i+1, ids, wantIDs)
+				}
+
+				last := i == len(tt.wantPages)-1
+				if (page.NextCursor == "") != last {
+					t.Fatalf("page %d: next cursor %q, want cursor only before the last page", i+1, page.NextCursor)
+				}
+				query.Cursor = page.NextCursor
+			}
+		})
+	}
+}
+
+func TestListUserDocumentsRejectsCursorOfOtherSort(t *testing.T) {
+	s := newTestService(&documentListRepository{})
+	cursor := encodeDocumentCursor(domain.DocumentSortName, false, &domain.Document{ID: 1, Filename: "a.txt"})
+
+	_, err := s.ListUserDocuments(context.Background(), 1, domain.DocumentListQuery{Sort: domain.DocumentSortSize, Cursor: cursor})
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("error = %v, want %v", err, ErrInvalidCursor)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+type DocumentMetadataService interface {
+	UpdateDocumentMetadata(ctx context.Context, userID, documentID int64, update domain.DocumentMetadataUpdate) (*domain.Document, error)
+}
+
+var (
+	ErrDescriptionTooLong    = errors.New("description is too long")
+	ErrTooManyTags           = errors.New("too many tags")
+	ErrInvalidTag            = errors.New("tags must be non-empty and not too long")
+	ErrTooManyMetadataFields = errors.New("too many metadata fields")
+	ErrInvalidMetadataField  = errors.New("metadata keys must be non-empty and unique after trimming, and keys and values not too long")
+)
+
+const (
+	maxDescriptionLength   = 4000
+	maxTags                = 50
+	maxTagLength           = 64
+	maxMetadataFields      = 50
+	maxMetadataKeyLength   = 64
+	maxMetadataValueLength = 1024
+)
+
+func (s *service) UpdateDocumentMetadata(ctx context.Context, userID, documentID int64, update domain.DocumentMetadataUpdate) (*domain.Document, error) {
+	if update.Description != nil {
+		description := strings.TrimSpace(*update.Description)
+		if utf8.RuneCountInString(description) > maxDescriptionLength {
+			return nil, ErrDescriptionTooLong
+		}
+		update.Description = &description
+	}
+	if update.Tags != nil {
+		tags, err := normalizeTags(update.Tags)
+		if err != nil {
+			return nil, err
+		}
+		update.Tags = tags
+	}
+	if update.Metadata != nil {
+		metadata, err := normalizeMetadata(update.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		update.Metadata = metadata
+	}
+
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !doc.CanEdit() {
+		return nil, ErrDocumentForbidden
+	}
+
+	updated, err := s.repo.UpdateDocumentMetadata(ctx, documentID, update)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrDocumentNotFound
+	}
+
+	return s.GetDocumentByID(ctx, userID, documentID)
+}
+
+// normalizeTags убирает пробелы по краям и повторы, сохраняя порядок тегов.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, ErrInvalidTag
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxTags {
+		return nil, ErrTooManyTags
+	}
+	return normalized, nil
+}
+
+// normalizeMetadata убирает пробелы по краям ключей и проверяет ограничения на размер полей.
+// Ключи, совпадающие после удаления пробелов, отклоняются: иначе значение выбиралось бы случайным порядком обхода карты.
+func normalizeMetadata(metadata map[string]string) (map[string]string, error) {
+	if len(metadata) > maxMetadataFields {
+		return nil, ErrTooManyMetadataFields
+	}
+	normalized := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		key = strings.TrimSpace(key)
+		if key == "" || utf8.RuneCountInString(key) > maxMetadataKeyLength || utf8.RuneCountInString(value) > maxMetadataValueLength {
+			return nil, ErrInvalidMetadataField
+		}
+		if _, ok := normalized[key]; ok {
+			return nil, ErrInvalidMetadataField
+		}
+		normalized[key] = value
+	}
+	return normalized, nil
+}
+
+// normalizeSearchFilter проверяет фильтр поиска по тем же правилам, что и сохраняемые теги и поля.
+func normalizeSearchFilter(filter domain.SearchFilter) (domain.SearchFilter, error) {
+	var err error
+	if len(filter.Tags) > 0 {
+		if filter.Tags, err = normalizeTags(filter.Tags); err != nil {
+			return filter, err
+		}
+	} else {
+		filter.Tags = nil
+	}
+	if len(filter.Metadata) > 0 {
+		if filter.Metadata, err = normalizeMetadata(filter.Metadata); err != nil {
+			return filter, err
+		}
+	} else {
+		filter.Metadata = nil
+	}
+	return filter, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeMetadata(t *testing.T) {
+	tooMany := make(map[string]string, maxMetadataFields+1)
+	for i := range maxMetadataFields + 1 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		want     map[string]string
+		wantErr  error
+	}{
+		{name: "keys are trimmed", metadata: map[string]string{" project ": "docs", "owner": " team "}, want: map[string]string{"project": "docs", "owner": " team "}},
+		{name: "empty", metadata: map[string]string{}, want: map[string]string{}},
+		{name: "keys equal after trimming", metadata: map[string]string{"project": "docs", "project ": "search"}, wantErr: ErrInvalidMetadataField},
+		{name: "blank key", metadata: map[string]string{"  ": "docs"}, wantErr: ErrInvalidMetadataField},
+		{name: "long key", metadata: map[string]string{strings.Repeat("k", maxMetadataKeyLength+1): "docs"}, wantErr: ErrInvalidMetadataField},
+		{name: "long value", metadata: map[string]string{"project": strings.Repeat("v", maxMetadataValueLength+1)}, wantErr: ErrInvalidMetadataField},
+		{name: "too many fields", metadata: tooMany, wantErr: ErrTooManyMetadataFields},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeMetadata(tt.metadata)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("normalizeMetadata error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !maps.Equal(got, tt.want) {
+				t.Errorf("normalizeMetadata = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
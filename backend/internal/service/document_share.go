@@ -0,0 +1,113 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type DocumentShareService interface {
+	ListDocumentShares(ctx context.Context, userID, documentID int64) ([]domain.DocumentShare, error)
+	ShareDocument(ctx context.Context, userID, documentID int64, email, permission string) (*domain.DocumentShare, error)
+	RevokeDocumentShare(ctx context.Context, userID, documentID, targetUserID int64) error
+}
+
+var (
+	ErrDocumentShareForbidden = errors.New("only workspace owners and editors can manage document shares")
+	ErrInvalidSharePermission = errors.New("invalid share permission")
+	ErrCannotShareWithSelf    = errors.New("cannot share a document with yourself")
+	ErrDocumentShareNotFound  = errors.New("document share not found")
+)
+
+// canManageDocumentShares: делиться документом могут владельцы и редакторы его рабочего пространства.
+// Авторство не учитывается: автор, которого исключили из пространства или понизили до читателя, доступом не управляет.
+func canManageDocumentShares(doc *domain.Document) bool {
+	return domain.CanWriteWorkspace(doc.WorkspaceRole)
+}
+
+func (s *service) ListDocumentShares(ctx context.Context, userID, documentID int64) ([]domain.DocumentShare, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageDocumentShares(doc) {
+		return nil, ErrDocumentShareForbidden
+	}
+
+	return s.repo.GetDocumentShares(ctx, documentID)
+}
+
+func (s *service) ShareDocument(ctx context.Context, userID, documentID int64, email, permission string) (*domain.DocumentShare, error) {
+	if permission != domain.SharePermissionRead && permission != domain.SharePermissionWrite {
+		return nil, ErrInvalidSharePermission
+	}
+
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageDocumentShares(doc) {
+		return nil, ErrDocumentShareForbidden
+	}
+
+	recipient, _, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if recipient.ID == userID {
+		return nil, ErrCannotShareWithSelf
+	}
+
+	if err := s.repo.UpsertDocumentShare(ctx, documentID, recipient.ID, permission, userID); err != nil {
+		return nil, err
+	}
+
+	s.log.Info().
+		Int64("user_id", userID).
+		Int64("document_id", documentID).
+		Int64("recipient_id", recipient.ID).
+		Str("permission", permission).
+		Msg("Пользователю выдан доступ к документу")
+
+	shares, err := s.repo.GetDocumentShares(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range shares {
+		if share.UserID == recipient.ID {
+			return &share, nil
+		}
+	}
+	return nil, ErrDocumentShareNotFound
+}
+
+func (s *service) RevokeDocumentShare(ctx context.Context, userID, documentID, targetUserID int64) error {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return err
+	}
+	// Получатель может сам отказаться от доступа, остальные отзывы — только для владельца или редактора пространства.
+	if targetUserID != userID && !canManageDocumentShares(doc) {
+		return ErrDocumentShareForbidden
+	}
+
+	deleted, err := s.repo.DeleteDocumentShare(ctx, documentID, targetUserID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrDocumentShareNotFound
+	}
+
+	s.log.Info().
+		Int64("user_id", userID).
+		Int64("document_id", documentID).
+		Int64("recipient_id", targetUserID).
+		Msg("Доступ к документу отозван")
+	return nil
+}
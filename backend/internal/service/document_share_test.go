@@ -0,0 +1,197 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// documentShareRepository хранит выданные доступы к одному документу.
+// roles задает роль пользователя в пространстве документа; получатель доступа видит документ без роли.
+type documentShareRepository struct {
+	fakeRepository
+	uploader int64
+	roles    map[int64]string
+	emails   map[string]int64
+	shares   map[int64]string
+}
+
+func (r *documentShareRepository) GetUserDocumentByID(ctx context.Context, id, userID int64) (*domain.Document, error) {
+	doc := &domain.Document{ID: id, UserID: &r.uploader}
+	if role, ok := r.roles[userID]; ok {
+		doc.WorkspaceRole = role
+		return doc, nil
+	}
+	if permission, ok := r.shares[userID]; ok {
+		doc.SharePermission = permission
+		doc.SharedWithMe = true
+		return doc, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *documentShareRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, string, error) {
+	id, ok := r.emails[email]
+	if !ok {
+		return nil, "", pgx.ErrNoRows
+	}
+	return &domain.User{ID: id, Email: email}, "", nil
+}
+
+func (r *documentShareRepository) UpsertDocumentShare(ctx context.Context, documentID, userID int64, permission string, grantedBy int64) error {
+	r.shares[userID] = permission
+	return nil
+}
+
+func (r *documentShareRepository) GetDocumentShares(ctx context.Context, documentID int64) ([]domain.DocumentShare, error) {
+	shares := make([]domain.DocumentShare, 0, len(r.shares))
+	for userID, permission := range r.shares {
+		shares = append(shares, domain.DocumentShare{UserID: userID, Permission: permission})
+	}
+	return shares, nil
+}
+
+func (r *documentShareRepository) DeleteDocumentShare(ctx context.Context, documentID, userID int64) (bool, error) {
+	if _, ok := r.shares[userID]; !ok {
+		return false, nil
+	}
+	delete(r.shares, userID)
+	return true, nil
+}
+
+func TestDocumentShares(t *testing.T) {
+	const (
+		documentID int64 = 10
+
+		owner    int64 = 1
+		editor   int64 = 2
+		viewer   int64 = 3
+		uploader int64 = 4
+		reader   int64 = 5
+		outsider int64 = 6
+	)
+
+	newRepo := func() *documentShareRepository {
+		return &documentShareRepository{
+			uploader: uploader,
+			roles: map[int64]string{
+				owner:  domain.WorkspaceRoleOwner,
+				editor: domain.WorkspaceRoleEditor,
+				viewer: domain.WorkspaceRoleViewer,
+				// Автор документа понижен до читателя после загрузки.
+				uploader: domain.WorkspaceRoleViewer,
+			},
+			emails: map[string]int64{
+				"owner@example.com":    owner,
+				"editor@example.com":   editor,
+				"reader@example.com":   reader,
+				"outsider@example.com": outsider,
+			},
+			shares: map[int64]string{reader: domain.SharePermissionRead},
+		}
+	}
+
+	t.Run("share", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			userID     int64
+			email      string
+			permission string
+			wantErr    error
+		}{
+			{name: "owner", userID: owner, email: "outsider@example.com", permission: domain.SharePermissionWrite},
+			{name: "editor", userID: editor, email: "outsider@example.com", permission: domain.SharePermissionRead},
+			{name: "viewer", userID: viewer, email: "outsider@example.com", permission: domain.SharePermissionRead, wantErr: ErrDocumentShareForbidden},
+			{name: "uploader demoted to viewer", userID: uploader, email: "outsider@example.com", permission: domain.SharePermissionRead, wantErr: ErrDocumentShareForbidden},
+			{name: "recipient reshares", userID: reader, email: "outsider@example.com", permission: domain.SharePermissionRead, wantErr: ErrDocumentShareForbidden},
+			{name: "no access", userID: outsider, email: "reader@example.com", permission: domain.SharePermissionRead, wantErr: ErrDocumentNotFound},
+			{name: "share with self", userID: owner, email: "owner@example.com", permission: domain.SharePermissionRead, wantErr: ErrCannotShareWithSelf},
+			{name: "unknown recipient", userID: owner, email: "nobody@example.com", permission: domain.SharePermissionRead, wantErr: ErrUserNotFound},
+			{name: "invalid permission", userID: owner, email: "outsider@example.com", permission: "admin", wantErr: ErrInvalidSharePermission},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newTestService(repo)
+
+				share, err := s.ShareDocument(context.Background(), tt.userID, documentID, tt.email, tt.permission)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ShareDocument error = %v, want %v", err, tt.wantErr)
+				}
+				if err != nil {
+					if _, ok := repo.shares[outsider]; ok {
+						t.Error("share is stored after an error")
+					}
+					return
+				}
+				if share.UserID != outsider || share.Permission != tt.permission {
+					t.Errorf("share = %+v, want user %d with %q", share, outsider, tt.permission)
+				}
+			})
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			userID  int64
+			wantErr error
+		}{
+			{name: "owner", userID: owner},
+			{name: "editor", userID: editor},
+			{name: "viewer", userID: viewer, wantErr: ErrDocumentShareForbidden},
+			{name: "uploader demoted to viewer", userID: uploader, wantErr: ErrDocumentShareForbidden},
+			{name: "recipient", userID: reader, wantErr: ErrDocumentShareForbidden},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				s := newTestService(newRepo())
+
+				shares, err := s.ListDocumentShares(context.Background(), tt.userID, documentID)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ListDocumentShares error = %v, want %v", err, tt.wantErr)
+				}
+				if err == nil && (len(shares) != 1 || shares[0].UserID != reader) {
+					t.Errorf("shares = %+v, want the share of user %d", shares, reader)
+				}
+			})
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			userID  int64
+			target  int64
+			wantErr error
+		}{
+			{name: "owner", userID: owner, target: reader},
+			{name: "editor", userID: editor, target: reader},
+			{name: "recipient revokes own share", userID: reader, target: reader},
+			{name: "viewer", userID: viewer, target: reader, wantErr: ErrDocumentShareForbidden},
+			{name: "uploader demoted to viewer", userID: uploader, target: reader, wantErr: ErrDocumentShareForbidden},
+			{name: "missing share", userID: owner, target: outsider, wantErr: ErrDocumentShareNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newTestService(repo)
+
+				err := s.RevokeDocumentShare(context.Background(), tt.userID, documentID, tt.target)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RevokeDocumentShare error = %v, want %v", err, tt.wantErr)
+				}
+				_, kept := repo.shares[reader]
+				if wantKept := err != nil; kept != wantKept {
+					t.Errorf("share of user %d kept = %v, want %v", reader, kept, wantKept)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func chunksFromTexts(texts []domain.ChunkText) []domain.Chunk {
+	chunks := make([]domain.Chunk, len(texts))
+	for i, text := range texts {
+		chunks[i] = domain.Chunk{Text: text.Text, OverlapBytes: &text.OverlapBytes}
+	}
+	return chunks
+}
+
+func TestSplitAndJoinChunks(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      int
+		overlap   int
+		text      string
+		minChunks int
+	}{
+		{name: "empty", size: 10, overlap: 3, text: ""},
+		{name: "single chunk", size: 100, overlap: 10, text: "short text", minChunks: 1},
+		{name: "paragraphs", size: 20, overlap: 5, text: "first paragraph\n\nsecond paragraph\n\nthird one", minChunks: 3},
+		{name: "repeated separators", size: 8, overlap: 3, text: "a\n\n\n\nb\n\n\n\n\nc  d   e", minChunks: 2},
+		{name: "sentences", size: 25, overlap: 10, text: "One sentence. Another sentence. And a third sentence.", minChunks: 2},
+		{name: "long word", size: 5, overlap: 2, text: "abcdefghijklmnopqrstuvwxyz", minChunks: 5},
+		{name: "multibyte runes", size: 6, overlap: 2, text: "привет мир, 你好世界 ещё текст", minChunks: 3},
+		{name: "repetitive text", size: 10, overlap: 4, text: strings.Repeat("ab ", 30), minChunks: 5},
+		{name: "leading and trailing whitespace", size: 10, overlap: 3, text: "\n\n  text with padding  \n\n", minChunks: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			splitter := NewTextSplitter(tt.size, tt.overlap)
+			texts := splitter.SplitText(tt.text)
+			if len(texts) < tt.minChunks {
+				t.Fatalf("got %d chunks, want at least %d", len(texts), tt.minChunks)
+			}
+
+			for i, text := range texts {
+				if i == 0 && text.OverlapBytes != 0 {
+					t.Errorf("first chunk overlap = %d, want 0", text.OverlapBytes)
+				}
+				if i > 0 && !strings.HasSuffix(texts[i-1].Text, text.Text[:text.OverlapBytes]) {
+					t.Errorf("chunk %d overlap %q is not a suffix of the previous chunk", i, text.Text[:text.OverlapBytes])
+				}
+			}
+
+			if got := JoinChunks(chunksFromTexts(texts)); got != tt.text {
+				t.Errorf("JoinChunks = %q, want %q", got, tt.text)
+			}
+
+			// Потоковое разбиение даёт те же чанки.
+			var streamed []domain.ChunkText
+			err := splitter.SplitReader(strings.NewReader(tt.text), func(chunk domain.ChunkText) error {
+				streamed = append(streamed, chunk)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(streamed, texts) {
+				t.Errorf("SplitReader chunks = %q, want %q", streamed, texts)
+			}
+		})
+	}
+}
+
+func TestJoinChunks(t *testing.T) {
+	overlap := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name   string
+		chunks []domain.Chunk
+		want   string
+	}{
+		{
+			name:   "no chunks",
+			chunks: nil,
+			want:   "",
+		},
+		{
+			name:   "stored overlap",
+			chunks: []domain.Chunk{{Text: "hello wor", OverlapBytes: overlap(0)}, {Text: "world", OverlapBytes: overlap(3)}},
+			want:   "hello world",
+		},
+		{
+			name: "stored overlap wins over a longer coincidence",
+			// Эвристика отбросила бы всё "ab ab", хотя при разбиении перекрытие было 3 байта.
+			chunks: []domain.Chunk{{Text: "ab ab", OverlapBytes: overlap(0)}, {Text: "ab ab", OverlapBytes: overlap(3)}},
+			want:   "ab abab",
+		},
+		{
+			name:   "stored zero overlap",
+			chunks: []domain.Chunk{{Text: "abc", OverlapBytes: overlap(0)}, {Text: "cde", OverlapBytes: overlap(0)}},
+			want:   "abccde",
+		},
+		{
+			name:   "overlap longer than chunk is clamped",
+			chunks: []domain.Chunk{{Text: "abc", OverlapBytes: overlap(0)}, {Text: "c", OverlapBytes: overlap(5)}},
+			want:   "abc",
+		},
+		{
+			name:   "legacy chunks without stored overlap",
+			chunks: []domain.Chunk{{Text: "hello wor"}, {Text: "world"}},
+			want:   "hello world",
+		},
+		{
+			name:   "legacy overlap ends on a rune boundary",
+			chunks: []domain.Chunk{{Text: "мир"}, {Text: "ир!"}},
+			want:   "мир!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinChunks(tt.chunks); got != tt.want {
+				t.Errorf("JoinChunks = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlapLength(t *testing.T) {
+	tests := []struct {
+		prev, next string
+		want       int
+	}{
+		{"hello", "world", 0},
+		{"hello wor", "world", 3},
+		{"abab", "abab", 4},
+		{"", "abc", 0},
+		{"abc", "", 0},
+		{"при", "ривет", len("ри")},
+	}
+
+	for _, tt := range tests {
+		if got := overlapLength(tt.prev, tt.next); got != tt.want {
+			t.Errorf("overlapLength(%q, %q) = %d, want %d", tt.prev, tt.next, got, tt.want)
+		}
+	}
+}
+
+func TestSplitReaderStopsOnEmitError(t *testing.T) {
+	splitter := NewTextSplitter(5, 1)
+	stop := errors.New("stop")
+
+	emitted := 0
+	err := splitter.SplitReader(strings.NewReader("one two three four five six"), func(chunk domain.ChunkText) error {
+		emitted++
+		if emitted == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("SplitReader error = %v, want %v", err, stop)
+	}
+	if emitted != 2 {
+		t.Errorf("emitted %d chunks, want 2", emitted)
+	}
+}
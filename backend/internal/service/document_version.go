@@ -0,0 +1,330 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type DocumentVersionService interface {
+	UploadDocumentVersion(ctx context.Context, userID, documentID int64, contentType string, content io.ReadSeeker, size int64) (*domain.Document, error)
+	ListDocumentVersions(ctx context.Context, userID, documentID int64) ([]domain.DocumentVersion, error)
+	RestoreDocumentVersion(ctx context.Context, userID, documentID int64, version int32) (*domain.Document, error)
+	DiffDocumentVersions(ctx context.Context, userID, documentID int64, from, to int32) (*domain.VersionDiff, error)
+}
+
+var (
+	ErrDocumentForbidden = errors.New("not enough permissions to modify document")
+	ErrVersionNotFound   = errors.New("document version not found")
+)
+
+// maxDiffCells ограничивает размер таблицы LCS при сравнении версий.
+// Если изменившаяся часть документов больше, она целиком показывается как удаленная и добавленная.
+const maxDiffCells = 1 << 22
+
+// UploadDocumentVersion загружает новое содержимое документа как его следующую версию.
+// Документ сохраняет ID, а чанки, текст которых не изменился, получают уже посчитанные эмбеддинги.
+// Если содержимое совпадает с текущей версией, новая версия не создается.
+func (s *service) UploadDocumentVersion(ctx context.Context, userID, documentID int64, contentType string, content io.ReadSeeker, size int64) (*domain.Document, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !doc.CanEdit() {
+		return nil, ErrDocumentForbidden
+	}
+
+	hasher := newContentHasher()
+	chunksCount, err := countChunks(io.TeeReader(content, hasher))
+	if err != nil {
+		s.log.Err(err).Msg("Ошибка чтения текста документа")
+		return nil, err
+	}
+
+	contentSHA256 := hasher.Sum()
+	if contentSHA256 == doc.ContentSHA256 {
+		s.log.Info().Int64("doc_id", doc.ID).Msg("Содержимое совпадает с текущей версией, новая версия не создается")
+		return doc, nil
+	}
+
+	if err := s.checkUploadQuota(ctx, s.repo, userID, 0, size, chunksCount); err != nil {
+		return nil, err
+	}
+
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	blob, err := s.storeBlob(ctx, content, size, contentType)
+	if err != nil {
+		s.log.Err(err).Msg("Ошибка сохранения оригинального файла")
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := s.checkUploadQuotaLocked(ctx, repo, userID, 0, size, chunksCount); err != nil {
+			return err
+		}
+
+		createdBlob, err := repo.CreateBlob(ctx, *blob)
+		if err != nil {
+			return err
+		}
+
+		version, err := s.beginDocumentVersion(ctx, repo, domain.DocumentVersion{
+			DocumentID:    doc.ID,
+			SizeBytes:     size,
+			BlobID:        &createdBlob.ID,
+			ContentSHA256: contentSHA256,
+			CreatedBy:     &userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if _, _, err := s.createVersionChunks(ctx, repo, userID, doc, version.Version, content); err != nil {
+			return err
+		}
+
+		_, err = repo.SetDocumentCurrentVersion(ctx, *version)
+		return err
+	})
+	if err != nil {
+		s.log.Err(err).Int64("doc_id", doc.ID).Msg("Не удалось сохранить новую версию документа")
+		s.deleteBlobObjects(ctx, []string{blob.StorageKey})
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("doc_id", doc.ID).Msg("Загружена новая версия документа")
+	return s.GetDocumentByID(ctx, userID, documentID)
+}
+
+func (s *service) ListDocumentVersions(ctx context.Context, userID, documentID int64) ([]domain.DocumentVersion, error) {
+	if _, err := s.GetDocumentByID(ctx, userID, documentID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDocumentVersions(ctx, documentID)
+}
+
+// RestoreDocumentVersion делает содержимое одной из прошлых версий текущим.
+// История не переписывается: восстановленное содержимое становится новой версией, чанки копируются вместе с эмбеддингами.
+func (s *service) RestoreDocumentVersion(ctx context.Context, userID, documentID int64, version int32) (*domain.Document, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !doc.CanEdit() {
+		return nil, ErrDocumentForbidden
+	}
+
+	target, err := s.getDocumentVersion(ctx, documentID, version)
+	if err != nil {
+		return nil, err
+	}
+	if target.Version == doc.CurrentVersion || (target.ContentSHA256 != "" && target.ContentSHA256 == doc.ContentSHA256) {
+		return doc, nil
+	}
+
+	if err := s.checkUploadQuota(ctx, s.repo, userID, 0, target.SizeBytes, target.Chunks); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := s.checkUploadQuotaLocked(ctx, repo, userID, 0, target.SizeBytes, target.Chunks); err != nil {
+			return err
+		}
+
+		restored, err := s.beginDocumentVersion(ctx, repo, domain.DocumentVersion{
+			DocumentID:    doc.ID,
+			SizeBytes:     target.SizeBytes,
+			BlobID:        target.BlobID,
+			ContentSHA256: target.ContentSHA256,
+			CreatedBy:     &userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		chunks, err := repo.CopyVersionChunks(ctx, userID, doc.ID, target.Version, restored.Version)
+		if err != nil {
+			return err
+		}
+		var reusedChunkIDs []int64
+		for _, c := range chunks {
+			if c.Embedded {
+				reusedChunkIDs = append(reusedChunkIDs, c.ID)
+			}
+		}
+		if len(reusedChunkIDs) > 0 {
+			if err := repo.DequeueChunkEmbeddings(ctx, reusedChunkIDs); err != nil {
+				return err
+			}
+		}
+
+		_, err = repo.SetDocumentCurrentVersion(ctx, *restored)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("doc_id", doc.ID).Int32("restored_version", target.Version).Msg("Восстановлена версия документа")
+	return s.GetDocumentByID(ctx, userID, documentID)
+}
+
+// DiffDocumentVersions сравнивает две версии документа по чанкам.
+func (s *service) DiffDocumentVersions(ctx context.Context, userID, documentID int64, from, to int32) (*domain.VersionDiff, error) {
+	if _, err := s.GetDocumentByID(ctx, userID, documentID); err != nil {
+		return nil, err
+	}
+
+	texts := make([][]string, 2)
+	for i, version := range []int32{from, to} {
+		if _, err := s.getDocumentVersion(ctx, documentID, version); err != nil {
+			return nil, err
+		}
+		chunks, err := s.repo.GetVersionChunkTexts(ctx, documentID, version)
+		if err != nil {
+			return nil, err
+		}
+		texts[i] = chunks
+	}
+
+	diff := &domain.VersionDiff{From: from, To: to, Chunks: diffChunks(texts[0], texts[1])}
+	for _, c := range diff.Chunks {
+		switch c.Op {
+		case domain.DiffEqual:
+			diff.Equal++
+		case domain.DiffAdded:
+			diff.Added++
+		case domain.DiffRemoved:
+			diff.Removed++
+		}
+	}
+	return diff, nil
+}
+
+func (s *service) getDocumentVersion(ctx context.Context, documentID int64, version int32) (*domain.DocumentVersion, error) {
+	v, err := s.repo.GetDocumentVersion(ctx, documentID, version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVersionNotFound
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// beginDocumentVersion блокирует документ, создает запись о его следующей версии
+// и убирает из очереди векторизатора чанки вытесняемой версии, которые еще не успели обработать:
+// по прошлым версиям не ищут, а при восстановлении их чанки снова попадут в очередь.
+func (s *service) beginDocumentVersion(ctx context.Context, repo repository.Repository, version domain.DocumentVersion) (*domain.DocumentVersion, error) {
+	current, err := repo.LockDocumentCurrentVersion(ctx, version.DocumentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDocumentNotFound
+		}
+		return nil, err
+	}
+
+	version.Version = current + 1
+	created, err := repo.CreateDocumentVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := repo.GetUnembeddedVersionChunkIDs(ctx, version.DocumentID, current)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		if err := repo.DequeueChunkEmbeddings(ctx, pending); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+// diffChunks строит последовательность изменений, превращающую чанки from в чанки to.
+// Общие начало и конец отбрасываются сразу, для оставшейся середины ищется наибольшая общая подпоследовательность.
+func diffChunks(from, to []string) []domain.ChunkDiff {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]domain.ChunkDiff, 0, len(from)+len(to)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		diff = append(diff, equalChunk(from, i, i))
+	}
+
+	a, b := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	if len(a)*len(b) > maxDiffCells {
+		for i := range a {
+			diff = append(diff, removedChunk(from, prefix+i))
+		}
+		for j := range b {
+			diff = append(diff, addedChunk(to, prefix+j))
+		}
+	} else {
+		// lcs[i][j] — длина наибольшей общей подпоследовательности a[i:] и b[j:].
+		lcs := make([][]int32, len(a)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(a) || j < len(b) {
+			switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				diff = append(diff, equalChunk(from, prefix+i, prefix+j))
+				i++
+				j++
+			case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+				diff = append(diff, removedChunk(from, prefix+i))
+				i++
+			default:
+				diff = append(diff, addedChunk(to, prefix+j))
+				j++
+			}
+		}
+	}
+
+	for k := suffix; k > 0; k-- {
+		diff = append(diff, equalChunk(from, len(from)-k, len(to)-k))
+	}
+	return diff
+}
+
+func equalChunk(from []string, i, j int) domain.ChunkDiff {
+	return domain.ChunkDiff{Op: domain.DiffEqual, FromIndex: &i, ToIndex: &j, Text: from[i]}
+}
+
+func addedChunk(to []string, j int) domain.ChunkDiff {
+	return domain.ChunkDiff{Op: domain.DiffAdded, ToIndex: &j, Text: to[j]}
+}
+
+func removedChunk(from []string, i int) domain.ChunkDiff {
+	return domain.ChunkDiff{Op: domain.DiffRemoved, FromIndex: &i, Text: from[i]}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// formatDiff записывает шаги сравнения как "=a -b +c".
+func formatDiff(diff []domain.ChunkDiff) string {
+	ops := map[string]string{domain.DiffEqual: "=", domain.DiffAdded: "+", domain.DiffRemoved: "-"}
+	steps := make([]string, len(diff))
+	for i, c := range diff {
+		steps[i] = ops[c.Op] + c.Text
+	}
+	return strings.Join(steps, " ")
+}
+
+// checkDiffIndexes проверяет, что индексы шагов идут по порядку и из шагов восстанавливаются обе версии.
+func checkDiffIndexes(t *testing.T, from, to []string, diff []domain.ChunkDiff) {
+	t.Helper()
+
+	var gotFrom, gotTo []string
+	for _, c := range diff {
+		if (c.FromIndex != nil) != (c.Op != domain.DiffAdded) || (c.ToIndex != nil) != (c.Op != domain.DiffRemoved) {
+			t.Fatalf("step %+v has wrong indexes for %s", c, c.Op)
+		}
+		if c.FromIndex != nil {
+			if *c.FromIndex != len(gotFrom) || from[*c.FromIndex] != c.Text {
+				t.Fatalf("step %q: FromIndex = %d, want %d", c.Text, *c.FromIndex, len(gotFrom))
+			}
+			gotFrom = append(gotFrom, c.Text)
+		}
+		if c.ToIndex != nil {
+			if *c.ToIndex != len(gotTo) || to[*c.ToIndex] != c.Text {
+				t.Fatalf("step %q: ToIndex = %d, want %d", c.Text, *c.ToIndex, len(gotTo))
+			}
+			gotTo = append(gotTo, c.Text)
+		}
+	}
+	if !slices.Equal(gotFrom, from) || !slices.Equal(gotTo, to) {
+		t.Fatalf("diff restores %v -> %v, want %v -> %v", gotFrom, gotTo, from, to)
+	}
+}
+
+func TestDiffChunks(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{name: "identical", from: "a b c", to: "a b c", want: "=a =b =c"},
+		{name: "both empty", from: "", to: "", want: ""},
+		{name: "all added", from: "", to: "a b", want: "+a +b"},
+		{name: "all removed", from: "a b", to: "", want: "-a -b"},
+		{name: "append", from: "a b", to: "a b c", want: "=a =b +c"},
+		{name: "prepend", from: "b c", to: "a b c", want: "+a =b =c"},
+		{name: "replace in the middle", from: "a b c", to: "a x c", want: "=a -b +x =c"},
+		{name: "insert in the middle", from: "a c", to: "a b c", want: "=a +b =c"},
+		{name: "remove in the middle", from: "a b c", to: "a c", want: "=a -b =c"},
+		{name: "moved chunk", from: "a b c d", to: "a c d b", want: "=a -b =c =d +b"},
+		{name: "repeated chunks", from: "a a b", to: "a b b", want: "=a -a +b =b"},
+		{name: "nothing in common", from: "a b", to: "c d", want: "-a -b +c +d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := strings.Fields(tt.from), strings.Fields(tt.to)
+
+			diff := diffChunks(from, to)
+			if got := formatDiff(diff); got != tt.want {
+				t.Errorf("diffChunks(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+			}
+			checkDiffIndexes(t, from, to, diff)
+		})
+	}
+}
+
+func TestDiffChunksLargeInput(t *testing.T) {
+	// Середина больше maxDiffCells: вместо LCS она целиком считается удалённой и добавленной заново.
+	size := 2049
+	from := make([]string, size+2)
+	to := make([]string, size+2)
+	from[0], to[0] = "head", "head"
+	from[size+1], to[size+1] = "tail", "tail"
+	for i := 1; i <= size; i++ {
+		from[i] = fmt.Sprintf("old-%d", i)
+		to[i] = fmt.Sprintf("new-%d", i)
+	}
+	if size*size <= maxDiffCells {
+		t.Fatalf("%d×%d chunks fit into maxDiffCells", size, size)
+	}
+
+	diff := diffChunks(from, to)
+	checkDiffIndexes(t, from, to, diff)
+
+	counts := make(map[string]int)
+	for _, c := range diff {
+		counts[c.Op]++
+	}
+	if counts[domain.DiffEqual] != 2 || counts[domain.DiffRemoved] != size || counts[domain.DiffAdded] != size {
+		t.Errorf("diff counts = %v, want 2 equal, %d removed and %d added", counts, size, size)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"backend/internal/repository"
+	"context"
+	"time"
+)
+
+type EmbeddingCountersService interface {
+	RunEmbeddingCountersReconcile(ctx context.Context)
+}
+
+// embeddingCountersBatchSize — сколько версий сверка счетчиков блокирует и пересчитывает за одну транзакцию.
+const embeddingCountersBatchSize = 200
+
+// RunEmbeddingCountersReconcile периодически сверяет счетчики чанков и необработанных эмбеддингов версий
+// с таблицей чанков. Счетчики ведет триггер, сверка исправляет расхождения, если они все же появились.
+func (s *service) RunEmbeddingCountersReconcile(ctx context.Context) {
+	if s.embeddingCountersCfg.ReconcileInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.embeddingCountersCfg.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.reconcileEmbeddingCounters(ctx); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Msg("failed to reconcile embedding counters")
+			}
+		}
+	}
+}
+
+func (s *service) reconcileEmbeddingCounters(ctx context.Context) error {
+	var afterID int64
+	fixed := 0
+	for {
+		var ids, reconciled []int64
+		err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+			var err error
+			ids, err = repo.LockDocumentVersionsBatch(ctx, afterID, embeddingCountersBatchSize)
+			if err != nil {
+				return err
+			}
+			// Пересчет идет отдельным запросом уже после блокировки, чтобы видеть все изменения чанков,
+			// зафиксированные до нее; незафиксированные изменения триггер учтет после нашей транзакции.
+			reconciled, err = repo.ReconcileVersionChunkCounters(ctx, ids)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		fixed += len(reconciled)
+
+		if len(ids) < embeddingCountersBatchSize {
+			break
+		}
+		afterID = ids[len(ids)-1]
+	}
+
+	if fixed > 0 {
+		s.log.Warn().Int("fixed", fixed).Msg("Исправлены разошедшиеся счетчики чанков у версий документов")
+	}
+	return nil
+}
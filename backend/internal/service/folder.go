@@ -0,0 +1,296 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type FolderService interface {
+	ListFolders(ctx context.Context, userID int64, workspaceID *int64) ([]domain.Folder, error)
+	GetFolder(ctx context.Context, userID, folderID int64) (*domain.Folder, error)
+	CreateFolder(ctx context.Context, userID int64, workspaceID, parentID *int64, name string) (*domain.Folder, error)
+	RenameFolder(ctx context.Context, userID, folderID int64, name string) (*domain.Folder, error)
+	MoveFolder(ctx context.Context, userID, folderID int64, parentID *int64) (*domain.Folder, error)
+	DeleteFolder(ctx context.Context, userID, folderID int64) (int, error)
+	SetDocumentFolder(ctx context.Context, userID, documentID int64, folderID *int64) (*domain.Document, error)
+}
+
+var (
+	ErrFolderNotFound          = errors.New("folder not found or access denied")
+	ErrFolderNameRequired      = errors.New("folder name is required")
+	ErrFolderNameTooLong       = errors.New("folder name is too long")
+	ErrInvalidFolderName       = errors.New("folder name must not contain slashes or control characters")
+	ErrFolderNameTaken         = errors.New("folder with this name already exists in the parent folder")
+	ErrFolderCycle             = errors.New("folder cannot be moved into itself or its subfolder")
+	ErrFolderWorkspaceMismatch = errors.New("folder belongs to another workspace")
+)
+
+const maxFolderNameLength = 255
+
+func normalizeFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return "", ErrFolderNameRequired
+	case utf8.RuneCountInString(name) > maxFolderNameLength:
+		return "", ErrFolderNameTooLong
+	case strings.ContainsAny(name, "/\\") || strings.IndexFunc(name, isControlRune) >= 0:
+		return "", ErrInvalidFolderName
+	}
+	return name, nil
+}
+
+func isControlRune(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
+
+func (s *service) ListFolders(ctx context.Context, userID int64, workspaceID *int64) ([]domain.Folder, error) {
+	if workspaceID != nil {
+		if _, err := s.GetWorkspace(ctx, userID, *workspaceID); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.GetUserFolders(ctx, userID, workspaceID)
+}
+
+func (s *service) GetFolder(ctx context.Context, userID, folderID int64) (*domain.Folder, error) {
+	folder, err := s.repo.GetUserFolderByID(ctx, folderID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrFolderNotFound
+		}
+		return nil, err
+	}
+	return folder, nil
+}
+
+// requireWritableFolder возвращает папку, если пользователь может менять содержимое ее рабочего пространства.
+func (s *service) requireWritableFolder(ctx context.Context, userID, folderID int64) (*domain.Folder, error) {
+	folder, err := s.GetFolder(ctx, userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanWriteWorkspace(folder.WorkspaceRole) {
+		return nil, ErrWorkspaceForbidden
+	}
+	return folder, nil
+}
+
+// CreateFolder создает папку в корне рабочего пространства или внутри parentID.
+// Вложенная папка всегда создается в пространстве родителя.
+func (s *service) CreateFolder(ctx context.Context, userID int64, workspaceID, parentID *int64, name string) (*domain.Folder, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetWorkspaceID int64
+	if parentID != nil {
+		parent, err := s.requireWritableFolder(ctx, userID, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if workspaceID != nil && *workspaceID != parent.WorkspaceID {
+			return nil, ErrFolderWorkspaceMismatch
+		}
+		targetWorkspaceID = parent.WorkspaceID
+	} else {
+		workspace, err := s.resolveWorkspace(ctx, userID, workspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if !domain.CanWriteWorkspace(workspace.Role) {
+			return nil, ErrWorkspaceForbidden
+		}
+		targetWorkspaceID = workspace.ID
+	}
+
+	var folder *domain.Folder
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := repo.LockWorkspaceFolders(ctx, targetWorkspaceID); err != nil {
+			return err
+		}
+		taken, err := repo.FolderNameExists(ctx, targetWorkspaceID, parentID, name, 0)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return ErrFolderNameTaken
+		}
+
+		folder, err = repo.CreateFolder(ctx, targetWorkspaceID, parentID, name, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("workspace_id", targetWorkspaceID).Int64("folder_id", folder.ID).Msg("Папка создана")
+	return s.GetFolder(ctx, userID, folder.ID)
+}
+
+func (s *service) RenameFolder(ctx context.Context, userID, folderID int64, name string) (*domain.Folder, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.requireWritableFolder(ctx, userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := repo.LockWorkspaceFolders(ctx, folder.WorkspaceID); err != nil {
+			return err
+		}
+		taken, err := repo.FolderNameExists(ctx, folder.WorkspaceID, folder.ParentID, name, folder.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return ErrFolderNameTaken
+		}
+		return repo.RenameFolder(ctx, folder.ID, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetFolder(ctx, userID, folderID)
+}
+
+// MoveFolder переносит папку вместе с содержимым в parentID или в корень пространства, если parentID nil.
+// Переносить папки между рабочими пространствами и внутрь собственных подпапок нельзя.
+func (s *service) MoveFolder(ctx context.Context, userID, folderID int64, parentID *int64) (*domain.Folder, error) {
+	folder, err := s.requireWritableFolder(ctx, userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != nil {
+		parent, err := s.GetFolder(ctx, userID, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.WorkspaceID != folder.WorkspaceID {
+			return nil, ErrFolderWorkspaceMismatch
+		}
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		// Блокировка пространства не дает двум встречным переносам замкнуть папки в цикл.
+		if err := repo.LockWorkspaceFolders(ctx, folder.WorkspaceID); err != nil {
+			return err
+		}
+
+		if parentID != nil {
+			subtree, err := repo.GetFolderSubtreeIDs(ctx, folder.ID)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(subtree, *parentID) {
+				return ErrFolderCycle
+			}
+		}
+
+		taken, err := repo.FolderNameExists(ctx, folder.WorkspaceID, parentID, folder.Name, folder.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return ErrFolderNameTaken
+		}
+		return repo.MoveFolder(ctx, folder.ID, parentID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("folder_id", folderID).Msg("Папка перемещена")
+	return s.GetFolder(ctx, userID, folderID)
+}
+
+// DeleteFolder удаляет папку вместе с подпапками. Документы из них перемещаются в корзину,
+// а после восстановления оказываются в корне рабочего пространства. Возвращает количество перемещенных в корзину документов.
+func (s *service) DeleteFolder(ctx context.Context, userID, folderID int64) (int, error) {
+	folder, err := s.requireWritableFolder(ctx, userID, folderID)
+	if err != nil {
+		return 0, err
+	}
+
+	var trashed []int64
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := repo.LockWorkspaceFolders(ctx, folder.WorkspaceID); err != nil {
+			return err
+		}
+
+		subtree, err := repo.GetFolderSubtreeIDs(ctx, folder.ID)
+		if err != nil {
+			return err
+		}
+		trashed, err = repo.TrashFolderDocuments(ctx, subtree, userID)
+		if err != nil {
+			return err
+		}
+		return repo.DeleteFolder(ctx, folder.ID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("folder_id", folderID).Int("trashed_documents", len(trashed)).Msg("Папка удалена")
+	return len(trashed), nil
+}
+
+// SetDocumentFolder переносит документ в папку его рабочего пространства или в корень, если folderID nil.
+// Раскладывать документы по папкам могут только владельцы и редакторы пространства: доступа на запись по ссылке недостаточно.
+func (s *service) SetDocumentFolder(ctx context.Context, userID, documentID int64, folderID *int64) (*domain.Document, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanWriteWorkspace(doc.WorkspaceRole) {
+		return nil, ErrDocumentForbidden
+	}
+
+	if folderID != nil {
+		folder, err := s.GetFolder(ctx, userID, *folderID)
+		if err != nil {
+			return nil, err
+		}
+		if folder.WorkspaceID != doc.WorkspaceID {
+			return nil, ErrFolderWorkspaceMismatch
+		}
+	}
+
+	updated, err := s.repo.SetDocumentFolder(ctx, doc.ID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrDocumentNotFound
+	}
+
+	return s.GetDocumentByID(ctx, userID, documentID)
+}
+
+// folderSubtree возвращает ID папки и всех ее подпапок для фильтрации документов.
+// Если папка не задана, возвращает nil — фильтр по папкам не применяется.
+func (s *service) folderSubtree(ctx context.Context, userID int64, folderID *int64) ([]int64, error) {
+	if folderID == nil {
+		return nil, nil
+	}
+	folder, err := s.GetFolder(ctx, userID, *folderID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetFolderSubtreeIDs(ctx, folder.ID)
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// folderRepository хранит дерево папок и привязку документов к ним в памяти.
+type folderRepository struct {
+	fakeRepository
+	members   map[int64]map[int64]string
+	folders   map[int64]*domain.Folder
+	documents map[int64]int64
+	trashed   []int64
+}
+
+func (r *folderRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *folderRepository) GetUserWorkspaceByID(ctx context.Context, id, userID int64) (*domain.Workspace, error) {
+	role, ok := r.members[id][userID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Workspace{ID: id, Role: role}, nil
+}
+
+func (r *folderRepository) GetUserFolderByID(ctx context.Context, id, userID int64) (*domain.Folder, error) {
+	folder, ok := r.folders[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	role, ok := r.members[folder.WorkspaceID][userID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	found := *folder
+	found.WorkspaceRole = role
+	return &found, nil
+}
+
+func (r *folderRepository) LockWorkspaceFolders(ctx context.Context, workspaceID int64) error {
+	return nil
+}
+
+func (r *folderRepository) FolderNameExists(ctx context.Context, workspaceID int64, parentID *int64, name string, excludeID int64) (bool, error) {
+	for _, f := range r.folders {
+		if f.ID != excludeID && f.WorkspaceID == workspaceID && f.Name == name && equalFolderParent(f.ParentID, parentID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *folderRepository) CreateFolder(ctx context.Context, workspaceID int64, parentID *int64, name string, createdBy int64) (*domain.Folder, error) {
+	folder := &domain.Folder{ID: int64(len(r.folders) + 100), WorkspaceID: workspaceID, ParentID: parentID, Name: name, CreatedBy: &createdBy}
+	r.folders[folder.ID] = folder
+	return folder, nil
+}
+
+func (r *folderRepository) MoveFolder(ctx context.Context, id int64, parentID *int64) error {
+	r.folders[id].ParentID = parentID
+	return nil
+}
+
+func (r *folderRepository) GetFolderSubtreeIDs(ctx context.Context, id int64) ([]int64, error) {
+	subtree := []int64{id}
+	for i := 0; i < len(subtree); i++ {
+		for _, f := range r.folders {
+			if f.ParentID != nil && *f.ParentID == subtree[i] {
+				subtree = append(subtree, f.ID)
+			}
+		}
+	}
+	return subtree, nil
+}
+
+func (r *folderRepository) DeleteFolder(ctx context.Context, id int64) error {
+	subtree, _ := r.GetFolderSubtreeIDs(ctx, id)
+	for _, folderID := range subtree {
+		delete(r.folders, folderID)
+	}
+	return nil
+}
+
+func (r *folderRepository) TrashFolderDocuments(ctx context.Context, folderIDs []int64, userID int64) ([]int64, error) {
+	var trashed []int64
+	for documentID, folderID := range r.documents {
+		if slices.Contains(folderIDs, folderID) {
+			trashed = append(trashed, documentID)
+		}
+	}
+	slices.Sort(trashed)
+	r.trashed = append(r.trashed, trashed...)
+	return trashed, nil
+}
+
+func equalFolderParent(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func TestFolders(t *testing.T) {
+	const (
+		editor int64 = 1
+		viewer int64 = 2
+
+		workspace      int64 = 1
+		otherWorkspace int64 = 2
+
+		// Дерево пространства workspace: reports/2024/q1 и archive; other лежит в otherWorkspace.
+		reports int64 = 10
+		year    int64 = 11
+		quarter int64 = 12
+		archive int64 = 13
+		other   int64 = 20
+	)
+
+	ptr := func(id int64) *int64 { return &id }
+	newRepo := func() *folderRepository {
+		return &folderRepository{
+			members: map[int64]map[int64]string{
+				workspace:      {editor: domain.WorkspaceRoleEditor, viewer: domain.WorkspaceRoleViewer},
+				otherWorkspace: {editor: domain.WorkspaceRoleOwner, viewer: domain.WorkspaceRoleOwner},
+			},
+			folders: map[int64]*domain.Folder{
+				reports: {ID: reports, WorkspaceID: workspace, Name: "reports"},
+				year:    {ID: year, WorkspaceID: workspace, ParentID: ptr(reports), Name: "2024"},
+				quarter: {ID: quarter, WorkspaceID: workspace, ParentID: ptr(year), Name: "q1"},
+				archive: {ID: archive, WorkspaceID: workspace, Name: "archive"},
+				other:   {ID: other, WorkspaceID: otherWorkspace, Name: "other"},
+			},
+			documents: map[int64]int64{100: year, 101: quarter, 102: archive},
+		}
+	}
+
+	t.Run("create", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			userID      int64
+			workspaceID *int64
+			parentID    *int64
+			folderName  string
+			wantErr     error
+		}{
+			{name: "editor in root", userID: editor, workspaceID: ptr(workspace), folderName: "drafts"},
+			{name: "editor in subfolder", userID: editor, parentID: ptr(year), folderName: "q2"},
+			{name: "viewer in root", userID: viewer, workspaceID: ptr(workspace), folderName: "drafts", wantErr: ErrWorkspaceForbidden},
+			{name: "viewer in subfolder", userID: viewer, parentID: ptr(year), folderName: "q2", wantErr: ErrWorkspaceForbidden},
+			{name: "parent from another workspace", userID: editor, workspaceID: ptr(otherWorkspace), parentID: ptr(year), folderName: "q2", wantErr: ErrFolderWorkspaceMismatch},
+			{name: "name taken", userID: editor, parentID: ptr(year), folderName: " q1 ", wantErr: ErrFolderNameTaken},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newTestService(repo)
+
+				_, err := s.CreateFolder(context.Background(), tt.userID, tt.workspaceID, tt.parentID, tt.folderName)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CreateFolder error = %v, want %v", err, tt.wantErr)
+				}
+				wantFolders := 5
+				if err == nil {
+					wantFolders++
+				}
+				if len(repo.folders) != wantFolders {
+					t.Errorf("folders = %d, want %d", len(repo.folders), wantFolders)
+				}
+			})
+		}
+	})
+
+	t.Run("move", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			userID   int64
+			folderID int64
+			parentID *int64
+			wantErr  error
+		}{
+			{name: "editor into another folder", userID: editor, folderID: year, parentID: ptr(archive)},
+			{name: "editor to root", userID: editor, folderID: quarter},
+			{name: "viewer", userID: viewer, folderID: year, parentID: ptr(archive), wantErr: ErrWorkspaceForbidden},
+			{name: "into itself", userID: editor, folderID: reports, parentID: ptr(reports), wantErr: ErrFolderCycle},
+			{name: "into own subfolder", userID: editor, folderID: reports, parentID: ptr(quarter), wantErr: ErrFolderCycle},
+			{name: "into another workspace", userID: editor, folderID: year, parentID: ptr(other), wantErr: ErrFolderWorkspaceMismatch},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newTestService(repo)
+				before := repo.folders[tt.folderID].ParentID
+
+				_, err := s.MoveFolder(context.Background(), tt.userID, tt.folderID, tt.parentID)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("MoveFolder error = %v, want %v", err, tt.wantErr)
+				}
+
+				want := tt.parentID
+				if err != nil {
+					want = before
+				}
+				if got := repo.folders[tt.folderID].ParentID; !equalFolderParent(got, want) {
+					t.Errorf("parent = %v, want %v", got, want)
+				}
+			})
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			userID      int64
+			folderID    int64
+			wantErr     error
+			wantTrashed []int64
+			wantFolders int
+		}{
+			{name: "editor trashes documents of the subtree", userID: editor, folderID: year, wantTrashed: []int64{100, 101}, wantFolders: 3},
+			{name: "viewer", userID: viewer, folderID: year, wantErr: ErrWorkspaceForbidden, wantFolders: 5},
+			{name: "missing folder", userID: editor, folderID: 99, wantErr: ErrFolderNotFound, wantFolders: 5},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newTestService(repo)
+
+				trashed, err := s.DeleteFolder(context.Background(), tt.userID, tt.folderID)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DeleteFolder error = %v, want %v", err, tt.wantErr)
+				}
+				if trashed != len(tt.wantTrashed) || !slices.Equal(repo.trashed, tt.wantTrashed) {
+					t.Errorf("trashed = %d %v, want %v", trashed, repo.trashed, tt.wantTrashed)
+				}
+				if len(repo.folders) != tt.wantFolders {
+					t.Errorf("folders = %d, want %d", len(repo.folders), tt.wantFolders)
+				}
+			})
+		}
+	})
+}
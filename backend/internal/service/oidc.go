@@ -0,0 +1,171 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/oidc_client"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type OIDCService interface {
+	BeginOIDCLogin(reauth bool) (*domain.OIDCLoginRequest, error)
+	OIDCLogin(ctx context.Context, code, verifier, nonce string) (*domain.LoginResult, error)
+}
+
+var (
+	ErrOIDCDisabled         = errors.New("oidc login is not configured")
+	ErrOIDCInvalidLogin     = errors.New("oidc login failed")
+	ErrOIDCEmailMissing     = errors.New("oidc provider did not return an email")
+	ErrOIDCEmailNotVerified = errors.New("account with this email already exists and provider email is not verified")
+)
+
+func (s *service) BeginOIDCLogin(reauth bool) (*domain.OIDCLoginRequest, error) {
+	if s.oidcClient == nil {
+		return nil, ErrOIDCDisabled
+	}
+
+	state, err := generateSecureRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := generateSecureRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	verifier := oidc_client.GenerateVerifier()
+
+	return &domain.OIDCLoginRequest{
+		AuthURL:  s.oidcClient.AuthCodeURL(state, nonce, verifier, reauth),
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+	}, nil
+}
+
+// OIDCLogin завершает вход через провайдера. Провайдер заменяет только пароль: если у пользователя
+// включена двухфакторная аутентификация, вместо токенов возвращается промежуточный шаг входа, как в Login.
+func (s *service) OIDCLogin(ctx context.Context, code, verifier, nonce string) (*domain.LoginResult, error) {
+	if s.oidcClient == nil {
+		return nil, ErrOIDCDisabled
+	}
+
+	identity, err := s.oidcClient.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("OIDC code exchange failed")
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidLogin, err)
+	}
+
+	return s.loginOIDCIdentity(ctx, identity)
+}
+
+// loginOIDCIdentity входит в аккаунт, связанный с проверенной учетной записью провайдера.
+func (s *service) loginOIDCIdentity(ctx context.Context, identity *oidc_client.Identity) (*domain.LoginResult, error) {
+	var result *domain.LoginResult
+
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		user, err := s.provisionOIDCUser(ctx, repo, identity)
+		if err != nil {
+			return err
+		}
+		if user.Disabled {
+			return ErrAccountDisabled
+		}
+
+		twoFactor, err := s.userHasTwoFactor(ctx, repo, user.ID)
+		if err != nil {
+			return err
+		}
+		if twoFactor {
+			result, err = s.createLoginChallenge(ctx, repo, user.ID)
+			return err
+		}
+
+		// Время входа у провайдера заменяет пароль при повторной аутентификации аккаунтов без пароля.
+		newAccessToken, newRefreshToken, err := s.generateTokenPairWithClaims(ctx, repo, user, map[string]interface{}{
+			OIDCAuthTimeClaim: identity.AuthTime.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+
+		result = &domain.LoginResult{
+			AccessToken:  newAccessToken,
+			RefreshToken: newRefreshToken,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *service) provisionOIDCUser(ctx context.Context, repo repository.Repository, identity *oidc_client.Identity) (*domain.User, error) {
+	user, err := repo.GetUserByIdentity(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if identity.Email == "" {
+		return nil, ErrOIDCEmailMissing
+	}
+
+	user, _, err = repo.GetUserByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		if !identity.EmailVerified {
+			return nil, ErrOIDCEmailNotVerified
+		}
+		if !user.EmailVerified {
+			// Адрес локального аккаунта не подтвержден, и зарегистрировать его мог кто угодно.
+			// Доступ к аккаунту остается только через провайдера: пароль, сессии и второй фактор сбрасываются.
+			if err := s.resetUnverifiedUserCredentials(ctx, repo, user.ID); err != nil {
+				return nil, err
+			}
+			s.log.Warn().Int64("user_id", user.ID).Str("issuer", identity.Issuer).Msg("Credentials of unverified account reset before linking OIDC identity")
+		}
+		s.log.Info().Int64("user_id", user.ID).Str("issuer", identity.Issuer).Msg("Linking OIDC identity to existing user")
+	case errors.Is(err, pgx.ErrNoRows):
+		user, err = s.createUser(ctx, repo, identity.Email, "")
+		if err != nil {
+			return nil, err
+		}
+		s.log.Info().Int64("user_id", user.ID).Str("issuer", identity.Issuer).Msg("Provisioned user from OIDC identity")
+	default:
+		return nil, err
+	}
+
+	if err := repo.CreateUserIdentity(ctx, user.ID, identity.Issuer, identity.Subject, identity.Email); err != nil {
+		return nil, err
+	}
+	if identity.EmailVerified && !user.EmailVerified {
+		if err := repo.SetUserEmailVerified(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		user.EmailVerified = true
+	}
+
+	return user, nil
+}
+
+// resetUnverifiedUserCredentials удаляет все способы входа в аккаунт, кроме привязываемой учетной записи провайдера.
+func (s *service) resetUnverifiedUserCredentials(ctx context.Context, repo repository.Repository, userID int64) error {
+	if err := repo.UpdateUserPassword(ctx, userID, ""); err != nil {
+		return err
+	}
+	if err := repo.DeleteAllUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+	if err := repo.DeleteUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+	return repo.DeleteUserRecoveryCodes(ctx, userID)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/oidc_client"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+// oidcLoginRepository хранит одного пользователя, его привязку к провайдеру и настройки TOTP.
+type oidcLoginRepository struct {
+	fakeRepository
+	user          domain.User
+	linked        bool
+	totp          *domain.UserTOTP
+	challenges    int
+	refreshTokens int
+}
+
+func (r *oidcLoginRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *oidcLoginRepository) GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error) {
+	if !r.linked {
+		return nil, pgx.ErrNoRows
+	}
+	user := r.user
+	return &user, nil
+}
+
+func (r *oidcLoginRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, string, error) {
+	if email != r.user.Email {
+		return nil, "", pgx.ErrNoRows
+	}
+	user := r.user
+	return &user, "password-hash", nil
+}
+
+func (r *oidcLoginRepository) CreateUserIdentity(ctx context.Context, userID int64, issuer, subject, email string) error {
+	r.linked = true
+	return nil
+}
+
+func (r *oidcLoginRepository) GetUserTOTP(ctx context.Context, userID int64) (*domain.UserTOTP, error) {
+	if r.totp == nil {
+		return nil, pgx.ErrNoRows
+	}
+	return r.totp, nil
+}
+
+func (r *oidcLoginRepository) DeleteExpiredLoginChallenges(ctx context.Context, userID int64) error {
+	return nil
+}
+
+func (r *oidcLoginRepository) CreateLoginChallenge(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	r.challenges++
+	return nil
+}
+
+func (r *oidcLoginRepository) CreateRefreshToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	r.refreshTokens++
+	return nil
+}
+
+func TestLoginOIDCIdentity(t *testing.T) {
+	identity := &oidc_client.Identity{
+		Issuer:        "https://idp.example.com",
+		Subject:       "user-1",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		AuthTime:      time.Now(),
+	}
+
+	tests := []struct {
+		name          string
+		linked        bool
+		disabled      bool
+		totp          *domain.UserTOTP
+		wantErr       error
+		wantChallenge bool
+	}{
+		{name: "linked user without two-factor", linked: true},
+		{name: "linked user with two-factor", linked: true, totp: &domain.UserTOTP{UserID: 1, Enabled: true}, wantChallenge: true},
+		{name: "linking by email keeps two-factor", totp: &domain.UserTOTP{UserID: 1, Enabled: true}, wantChallenge: true},
+		{name: "pending enrollment is not required", linked: true, totp: &domain.UserTOTP{UserID: 1}},
+		{name: "disabled user", linked: true, disabled: true, wantErr: ErrAccountDisabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &oidcLoginRepository{
+				user:   domain.User{ID: 1, Email: identity.Email, EmailVerified: true, Role: domain.RoleUser, Disabled: tt.disabled},
+				linked: tt.linked,
+				totp:   tt.totp,
+			}
+			s := newTestService(repo)
+			s.tokenAuth = jwtauth.New("HS256", []byte("test-secret"), nil)
+			s.twoFactorCfg = &config.TwoFactorConfig{ChallengeTTL: 5 * time.Minute}
+
+			result, err := s.loginOIDCIdentity(context.Background(), identity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if repo.refreshTokens != 0 || repo.challenges != 0 {
+					t.Error("tokens or challenge created after an error")
+				}
+				return
+			}
+
+			if result.TwoFactorRequired() != tt.wantChallenge {
+				t.Fatalf("TwoFactorRequired = %v, want %v", result.TwoFactorRequired(), tt.wantChallenge)
+			}
+			if tt.wantChallenge {
+				// Вход через провайдера не должен выдавать сессию в обход второго фактора.
+				if result.AccessToken != "" || result.RefreshToken != "" || repo.refreshTokens != 0 {
+					t.Error("tokens issued before the second factor")
+				}
+				if repo.challenges != 1 || result.ChallengeExpiresAt.IsZero() {
+					t.Errorf("challenges = %d (expires %v), want one", repo.challenges, result.ChallengeExpiresAt)
+				}
+				return
+			}
+			if result.AccessToken == "" || result.RefreshToken == "" || repo.refreshTokens != 1 {
+				t.Error("token pair is not issued")
+			}
+			if repo.challenges != 0 {
+				t.Error("challenge created for user without two-factor")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// newSecretCipher возвращает AES-GCM с ключом, выведенным из ключа конфигурации через SHA-256.
+func newSecretCipher(key string) (cipher.AEAD, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealSecret шифрует секрет и возвращает nonce вместе с шифротекстом в base64.
+func sealSecret(aead cipher.AEAD, secret string) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// openSecret расшифровывает секрет, зашифрованный sealSecret.
+func openSecret(aead cipher.AEAD, secretEncrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(secretEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	secret, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
@@ -1,8 +1,14 @@
 package service
 
 import (
+	"backend/internal/blobstore"
+	"backend/internal/config"
 	"backend/internal/embedding_client"
+	"backend/internal/mailer"
+	"backend/internal/oidc_client"
+	"backend/internal/ratelimit"
 	"backend/internal/repository"
+	"net/http"
 
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/rs/zerolog"
@@ -12,25 +18,76 @@ type Service interface {
 	AuthService
 	UserService
 	DocumentService
+	OIDCService
+	TwoFactorService
+	AdminService
+	WorkspaceService
+	DocumentShareService
+	ShareLinkService
+	UsageService
+	UploadService
+	DocumentVersionService
+	TrashService
+	DocumentMetadataService
+	FolderService
+	EmbeddingCountersService
+	DocumentEventsService
+	WebhookService
 }
 
 type service struct {
-	repo            repository.Repository
-	tokenAuth       *jwtauth.JWTAuth
-	embeddingClient *embedding_client.Client
-	log             *zerolog.Logger
+	repo                 repository.Repository
+	tokenAuth            *jwtauth.JWTAuth
+	embeddingClient      *embedding_client.Client
+	oidcClient           *oidc_client.Client
+	mailer               mailer.Mailer
+	mailCfg              *config.MailConfig
+	limiter              *ratelimit.Limiter
+	twoFactorCfg         *config.TwoFactorConfig
+	quotaCfg             *config.QuotaConfig
+	blobStore            blobstore.BlobStore
+	uploadCfg            *config.UploadConfig
+	trashCfg             *config.TrashConfig
+	embeddingCountersCfg *config.EmbeddingCountersConfig
+	webhookCfg           *config.WebhookConfig
+	webhookClient        *http.Client
+	documentEvents       documentEventHub
+	log                  *zerolog.Logger
 }
 
 func New(
 	repo repository.Repository,
 	tokenAuth *jwtauth.JWTAuth,
 	embeddingClient *embedding_client.Client,
+	oidcClient *oidc_client.Client,
+	mailer mailer.Mailer,
+	mailCfg *config.MailConfig,
+	limiter *ratelimit.Limiter,
+	twoFactorCfg *config.TwoFactorConfig,
+	quotaCfg *config.QuotaConfig,
+	blobStore blobstore.BlobStore,
+	uploadCfg *config.UploadConfig,
+	trashCfg *config.TrashConfig,
+	embeddingCountersCfg *config.EmbeddingCountersConfig,
+	webhookCfg *config.WebhookConfig,
 	log *zerolog.Logger,
 ) Service {
 	return &service{
-		repo:            repo,
-		tokenAuth:       tokenAuth,
-		embeddingClient: embeddingClient,
-		log:             log,
+		repo:                 repo,
+		tokenAuth:            tokenAuth,
+		embeddingClient:      embeddingClient,
+		oidcClient:           oidcClient,
+		mailer:               mailer,
+		mailCfg:              mailCfg,
+		limiter:              limiter,
+		twoFactorCfg:         twoFactorCfg,
+		quotaCfg:             quotaCfg,
+		blobStore:            blobStore,
+		uploadCfg:            uploadCfg,
+		trashCfg:             trashCfg,
+		embeddingCountersCfg: embeddingCountersCfg,
+		webhookCfg:           webhookCfg,
+		webhookClient:        newWebhookClient(webhookCfg),
+		log:                  log,
 	}
 }
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"backend/internal/repository"
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// fakeRepository встраивает интерфейс репозитория: тест переопределяет только нужные ему методы,
+// а вызов любого другого завершится паникой на nil-интерфейсе.
+type fakeRepository struct {
+	repository.Repository
+}
+
+func (r *fakeRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func newTestService(repo repository.Repository) *service {
+	log := zerolog.Nop()
+	return &service{repo: repo, log: &log}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ShareLinkService interface {
+	CreateShareLink(ctx context.Context, userID, documentID int64, expiresAt *time.Time) (*domain.ShareLink, string, error)
+	ListShareLinks(ctx context.Context, userID, documentID int64) ([]domain.ShareLink, error)
+	RevokeShareLink(ctx context.Context, userID, documentID, linkID int64) error
+	GetSharedDocument(ctx context.Context, token string) (*domain.Document, []domain.Chunk, error)
+	SearchSharedDocument(ctx context.Context, token, query string) ([]domain.SearchResult, error)
+}
+
+var (
+	ErrShareLinkNotFound      = errors.New("share link not found, expired or revoked")
+	ErrShareLinkExpiresInPast = errors.New("share link expiration must be in the future")
+)
+
+const shareLinkTokenBytes = 32
+
+func (s *service) CreateShareLink(ctx context.Context, userID, documentID int64, expiresAt *time.Time) (*domain.ShareLink, string, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", ErrShareLinkExpiresInPast
+	}
+
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !canManageDocumentShares(doc) {
+		return nil, "", ErrDocumentShareForbidden
+	}
+
+	rawToken, err := generateSecureRandomString(shareLinkTokenBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	link, err := s.repo.CreateShareLink(ctx, documentID, userID, hashToken(rawToken), expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("document_id", documentID).Int64("link_id", link.ID).Msg("Создана публичная ссылка на документ")
+	return link, rawToken, nil
+}
+
+func (s *service) ListShareLinks(ctx context.Context, userID, documentID int64) ([]domain.ShareLink, error) {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageDocumentShares(doc) {
+		return nil, ErrDocumentShareForbidden
+	}
+
+	return s.repo.GetDocumentShareLinks(ctx, documentID)
+}
+
+func (s *service) RevokeShareLink(ctx context.Context, userID, documentID, linkID int64) error {
+	doc, err := s.GetDocumentByID(ctx, userID, documentID)
+	if err != nil {
+		return err
+	}
+	if !canManageDocumentShares(doc) {
+		return ErrDocumentShareForbidden
+	}
+
+	revoked, err := s.repo.RevokeShareLink(ctx, linkID, documentID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return ErrShareLinkNotFound
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("document_id", documentID).Int64("link_id", linkID).Msg("Публичная ссылка на документ отозвана")
+	return nil
+}
+
+func (s *service) GetSharedDocument(ctx context.Context, token string) (*domain.Document, []domain.Chunk, error) {
+	link, err := s.resolveShareLink(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := s.repo.GetDocumentByID(ctx, link.DocumentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrShareLinkNotFound
+		}
+		return nil, nil, err
+	}
+
+	chunks, err := s.repo.GetDocumentChunks(ctx, link.DocumentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doc, chunks, nil
+}
+
+func (s *service) SearchSharedDocument(ctx context.Context, token, query string) ([]domain.SearchResult, error) {
+	link, err := s.resolveShareLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	embedding, err := s.embedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SearchDocumentChunks(ctx, link.DocumentID, embedding, searchLimit)
+}
+
+func (s *service) resolveShareLink(ctx context.Context, token string) (*domain.ShareLink, error) {
+	if token == "" {
+		return nil, ErrShareLinkNotFound
+	}
+
+	link, err := s.repo.GetActiveShareLinkByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrShareLinkNotFound
+		}
+		return nil, err
+	}
+	return link, nil
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// shareLinkRepository хранит ссылки по хэшу токена; доступ к документам задаётся ролью пользователя.
+type shareLinkRepository struct {
+	fakeRepository
+	roles   map[int64]string
+	deleted bool
+	links   map[string]*domain.ShareLink
+}
+
+func (r *shareLinkRepository) GetUserDocumentByID(ctx context.Context, id, userID int64) (*domain.Document, error) {
+	role, ok := r.roles[userID]
+	if !ok || r.deleted {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Document{ID: id, WorkspaceRole: role}, nil
+}
+
+func (r *shareLinkRepository) GetDocumentByID(ctx context.Context, id int64) (*domain.Document, error) {
+	if r.deleted {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Document{ID: id}, nil
+}
+
+func (r *shareLinkRepository) GetDocumentChunks(ctx context.Context, documentID int64) ([]domain.Chunk, error) {
+	return []domain.Chunk{{DocumentID: documentID, Text: "text"}}, nil
+}
+
+func (r *shareLinkRepository) CreateShareLink(ctx context.Context, documentID, createdBy int64, tokenHash string, expiresAt *time.Time) (*domain.ShareLink, error) {
+	link := &domain.ShareLink{ID: int64(len(r.links) + 1), DocumentID: documentID, CreatedBy: createdBy, ExpiresAt: expiresAt}
+	r.links[tokenHash] = link
+	return link, nil
+}
+
+func (r *shareLinkRepository) GetActiveShareLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.ShareLink, error) {
+	link, ok := r.links[tokenHash]
+	if !ok || !link.Active(time.Now()) {
+		return nil, pgx.ErrNoRows
+	}
+	return link, nil
+}
+
+func (r *shareLinkRepository) RevokeShareLink(ctx context.Context, id, documentID int64) (bool, error) {
+	for _, link := range r.links {
+		if link.ID == id && link.DocumentID == documentID && link.RevokedAt == nil {
+			now := time.Now()
+			link.RevokedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestShareLinks(t *testing.T) {
+	const (
+		documentID int64 = 10
+
+		owner  int64 = 1
+		editor int64 = 2
+		viewer int64 = 3
+	)
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		creator   int64
+		expiresAt *time.Time
+		wantErr   error
+		// prepare меняет состояние после создания ссылки и до открытия её по токену.
+		prepare    func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink)
+		token      func(token string) string
+		wantGetErr error
+	}{
+		{
+			name:    "owner creates link without expiration",
+			creator: owner,
+		},
+		{
+			name:      "editor creates link with expiration",
+			creator:   editor,
+			expiresAt: &future,
+		},
+		{
+			name:    "viewer cannot create link",
+			creator: viewer,
+			wantErr: ErrDocumentShareForbidden,
+		},
+		{
+			name:    "user without access",
+			creator: 4,
+			wantErr: ErrDocumentNotFound,
+		},
+		{
+			name:      "expiration in the past",
+			creator:   owner,
+			expiresAt: &past,
+			wantErr:   ErrShareLinkExpiresInPast,
+		},
+		{
+			name:    "link expires",
+			creator: owner,
+			prepare: func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink) {
+				expired := time.Now().Add(-time.Second)
+				link.ExpiresAt = &expired
+			},
+			wantGetErr: ErrShareLinkNotFound,
+		},
+		{
+			name:    "revoked link",
+			creator: owner,
+			prepare: func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink) {
+				if err := s.RevokeShareLink(context.Background(), editor, documentID, link.ID); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantGetErr: ErrShareLinkNotFound,
+		},
+		{
+			name:    "viewer cannot revoke link",
+			creator: owner,
+			prepare: func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink) {
+				if err := s.RevokeShareLink(context.Background(), viewer, documentID, link.ID); !errors.Is(err, ErrDocumentShareForbidden) {
+					t.Fatalf("RevokeShareLink error = %v, want %v", err, ErrDocumentShareForbidden)
+				}
+			},
+		},
+		{
+			name:    "revoke unknown link",
+			creator: owner,
+			prepare: func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink) {
+				if err := s.RevokeShareLink(context.Background(), owner, documentID, link.ID+1); !errors.Is(err, ErrShareLinkNotFound) {
+					t.Fatalf("RevokeShareLink error = %v, want %v", err, ErrShareLinkNotFound)
+				}
+			},
+		},
+		{
+			name:    "document deleted",
+			creator: owner,
+			prepare: func(t *testing.T, s *service, repo *shareLinkRepository, link *domain.ShareLink) {
+				repo.deleted = true
+			},
+			wantGetErr: ErrShareLinkNotFound,
+		},
+		{
+			name:       "tampered token",
+			creator:    owner,
+			token:      func(token string) string { return token + "x" },
+			wantGetErr: ErrShareLinkNotFound,
+		},
+		{
+			name:       "empty token",
+			creator:    owner,
+			token:      func(token string) string { return "" },
+			wantGetErr: ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &shareLinkRepository{
+				roles: map[int64]string{
+					owner:  domain.WorkspaceRoleOwner,
+					editor: domain.WorkspaceRoleEditor,
+					viewer: domain.WorkspaceRoleViewer,
+				},
+				links: make(map[string]*domain.ShareLink),
+			}
+			s := newTestService(repo)
+			ctx := context.Background()
+
+			link, token, err := s.CreateShareLink(ctx, tt.creator, documentID, tt.expiresAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateShareLink error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(repo.links) != 0 {
+					t.Error("link is stored after an error")
+				}
+				return
+			}
+
+			// В базе хранится только хэш токена.
+			if _, ok := repo.links[token]; ok || repo.links[hashToken(token)] != link {
+				t.Fatal("link is not stored by the token hash")
+			}
+
+			if tt.prepare != nil {
+				tt.prepare(t, s, repo, link)
+			}
+			if tt.token != nil {
+				token = tt.token(token)
+			}
+
+			doc, chunks, err := s.GetSharedDocument(ctx, token)
+			if !errors.Is(err, tt.wantGetErr) {
+				t.Fatalf("GetSharedDocument error = %v, want %v", err, tt.wantGetErr)
+			}
+			if err == nil && (doc.ID != documentID || len(chunks) != 1) {
+				t.Errorf("GetSharedDocument = document %d with %d chunks, want document %d with 1 chunk", doc.ID, len(chunks), documentID)
+			}
+		})
+	}
+}
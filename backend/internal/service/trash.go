@@ -0,0 +1,170 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"time"
+)
+
+type TrashService interface {
+	ListTrash(ctx context.Context, userID int64, workspaceID *int64) ([]domain.TrashedDocument, error)
+	RestoreDocument(ctx context.Context, userID, documentID int64) (*domain.Document, error)
+	EmptyTrash(ctx context.Context, userID int64, workspaceID *int64) (int, error)
+	RunTrashPurge(ctx context.Context)
+}
+
+var (
+	ErrTrashedDocumentNotFound = errors.New("document not found in trash or access denied")
+)
+
+// trashPurgeBatchSize — сколько документов фоновая очистка удаляет за одну транзакцию.
+const trashPurgeBatchSize = 100
+
+func (s *service) ListTrash(ctx context.Context, userID int64, workspaceID *int64) ([]domain.TrashedDocument, error) {
+	if workspaceID != nil {
+		if _, err := s.GetWorkspace(ctx, userID, *workspaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	docs, err := s.repo.GetUserTrashedDocuments(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range docs {
+		docs[i].PurgeAt = docs[i].DeletedAt.Add(s.trashCfg.Retention)
+	}
+	return docs, nil
+}
+
+// RestoreDocument возвращает документ из корзины. Документы в корзине не занимают квоты,
+// поэтому после восстановления квоты загрузившего и авторов версий проверяются заново.
+func (s *service) RestoreDocument(ctx context.Context, userID, documentID int64) (*domain.Document, error) {
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		restored, err := repo.RestoreUserDocument(ctx, documentID, userID)
+		if err != nil {
+			return err
+		}
+		if !restored {
+			return ErrTrashedDocumentNotFound
+		}
+
+		// Идентификаторы отсортированы, поэтому параллельные восстановления блокируют квоты в одном порядке.
+		quotaUserIDs, err := repo.GetDocumentQuotaUserIDs(ctx, documentID)
+		if err != nil {
+			return err
+		}
+		for _, quotaUserID := range quotaUserIDs {
+			if err := s.checkUploadQuotaLocked(ctx, repo, quotaUserID, 0, 0, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("doc_id", documentID).Msg("Документ восстановлен из корзины")
+	return s.GetDocumentByID(ctx, userID, documentID)
+}
+
+// EmptyTrash окончательно удаляет документы из корзины, которые пользователь может удалять, и возвращает их количество.
+func (s *service) EmptyTrash(ctx context.Context, userID int64, workspaceID *int64) (int, error) {
+	if workspaceID != nil {
+		if _, err := s.GetWorkspace(ctx, userID, *workspaceID); err != nil {
+			return 0, err
+		}
+	}
+
+	ids, err := s.repo.GetUserTrashedDocumentIDs(ctx, userID, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := s.purgeDocuments(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int("deleted", deleted).Msg("Корзина очищена")
+	return deleted, nil
+}
+
+// RunTrashPurge периодически окончательно удаляет документы, пролежавшие в корзине дольше срока хранения.
+func (s *service) RunTrashPurge(ctx context.Context) {
+	if s.trashCfg.PurgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.trashCfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.purgeExpiredTrash(ctx); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Msg("failed to purge expired trash")
+			}
+		}
+	}
+}
+
+func (s *service) purgeExpiredTrash(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-s.trashCfg.Retention)
+	for {
+		ids, err := s.repo.GetExpiredTrashedDocumentIDs(ctx, deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		deleted, err := s.purgeDocuments(ctx, ids)
+		if err != nil {
+			return err
+		}
+		s.log.Info().Int("deleted", deleted).Msg("Удалены документы с истекшим сроком хранения в корзине")
+
+		if len(ids) < trashPurgeBatchSize {
+			return nil
+		}
+	}
+}
+
+// purgeDocuments окончательно удаляет документы из корзины вместе с версиями, чанками и файлами,
+// на которые больше никто не ссылается.
+func (s *service) purgeDocuments(ctx context.Context, ids []int64) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var deleted []int64
+	var blobKeys []string
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		// Версии удаляются каскадно вместе с документами, поэтому их файлы нужно собрать заранее.
+		blobIDs, err := repo.GetDocumentsBlobIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		deleted, err = repo.DeleteTrashedDocuments(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		blobKeys, err = repo.DeleteOrphanBlobs(ctx, blobIDs)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.deleteBlobObjects(ctx, blobKeys)
+	return len(deleted), nil
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type trashedDocument struct {
+	workspaceID int64
+	uploader    int64
+	sizeBytes   int64
+	deletedAt   *time.Time
+}
+
+// trashRepository хранит документы и роли участников в памяти. Изменения транзакции,
+// завершившейся ошибкой, откатываются, как в базе.
+type trashRepository struct {
+	fakeRepository
+	members     map[int64]map[int64]string
+	documents   map[int64]*trashedDocument
+	lockedUsers []int64
+	// purgeBatches — размеры пачек, которые фоновая очистка получила от GetExpiredTrashedDocumentIDs.
+	purgeBatches []int
+}
+
+func (r *trashRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	snapshot := make(map[int64]trashedDocument, len(r.documents))
+	for id, doc := range r.documents {
+		snapshot[id] = *doc
+	}
+
+	err := fn(r)
+	if err != nil {
+		r.documents = make(map[int64]*trashedDocument, len(snapshot))
+		for id, doc := range snapshot {
+			r.documents[id] = &doc
+		}
+	}
+	return err
+}
+
+func (r *trashRepository) canWrite(workspaceID, userID int64) bool {
+	return domain.CanWriteWorkspace(r.members[workspaceID][userID])
+}
+
+func (r *trashRepository) GetUserWorkspaceByID(ctx context.Context, id, userID int64) (*domain.Workspace, error) {
+	role, ok := r.members[id][userID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Workspace{ID: id, Role: role}, nil
+}
+
+func (r *trashRepository) GetUserDocumentByID(ctx context.Context, id, userID int64) (*domain.Document, error) {
+	doc, ok := r.documents[id]
+	if !ok || doc.deletedAt != nil {
+		return nil, pgx.ErrNoRows
+	}
+	role, ok := r.members[doc.workspaceID][userID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Document{ID: id, WorkspaceID: doc.workspaceID, WorkspaceRole: role, UserID: &doc.uploader}, nil
+}
+
+func (r *trashRepository) RestoreUserDocument(ctx context.Context, id, userID int64) (bool, error) {
+	doc, ok := r.documents[id]
+	if !ok || doc.deletedAt == nil || !r.canWrite(doc.workspaceID, userID) {
+		return false, nil
+	}
+	doc.deletedAt = nil
+	return true, nil
+}
+
+func (r *trashRepository) GetDocumentQuotaUserIDs(ctx context.Context, id int64) ([]int64, error) {
+	return []int64{r.documents[id].uploader}, nil
+}
+
+func (r *trashRepository) LockUserQuota(ctx context.Context, userID int64) error {
+	r.lockedUsers = append(r.lockedUsers, userID)
+	return nil
+}
+
+func (r *trashRepository) GetUserUsage(ctx context.Context, userID int64, day time.Time) (*domain.Usage, error) {
+	usage := &domain.Usage{}
+	for _, doc := range r.documents {
+		if doc.uploader == userID && doc.deletedAt == nil {
+			usage.Documents++
+			usage.SizeBytes += doc.sizeBytes
+		}
+	}
+	return usage, nil
+}
+
+func (r *trashRepository) GetUserTrashedDocumentIDs(ctx context.Context, userID int64, workspaceID *int64) ([]int64, error) {
+	var ids []int64
+	for id, doc := range r.documents {
+		if doc.deletedAt != nil && r.canWrite(doc.workspaceID, userID) && (workspaceID == nil || *workspaceID == doc.workspaceID) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func (r *trashRepository) GetExpiredTrashedDocumentIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]int64, error) {
+	var ids []int64
+	for _, id := range slices.Sorted(maps.Keys(r.documents)) {
+		if doc := r.documents[id]; doc.deletedAt != nil && doc.deletedAt.Before(deletedBefore) && len(ids) < int(limit) {
+			ids = append(ids, id)
+		}
+	}
+	r.purgeBatches = append(r.purgeBatches, len(ids))
+	return ids, nil
+}
+
+func (r *trashRepository) GetDocumentsBlobIDs(ctx context.Context, documentIDs []int64) ([]int64, error) {
+	return nil, nil
+}
+
+func (r *trashRepository) DeleteTrashedDocuments(ctx context.Context, ids []int64) ([]int64, error) {
+	var deleted []int64
+	for _, id := range ids {
+		if doc, ok := r.documents[id]; ok && doc.deletedAt != nil {
+			delete(r.documents, id)
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+func (r *trashRepository) DeleteOrphanBlobs(ctx context.Context, ids []int64) ([]string, error) {
+	return nil, nil
+}
+
+func TestTrash(t *testing.T) {
+	const (
+		editor int64 = 1
+		viewer int64 = 2
+
+		workspace int64 = 1
+
+		trashed    int64 = 10
+		large      int64 = 11
+		kept       int64 = 12
+		sizeBytes  int64 = 100
+		largeBytes int64 = 1000
+	)
+
+	deletedAt := time.Now().Add(-time.Hour)
+	newRepo := func() *trashRepository {
+		return &trashRepository{
+			members: map[int64]map[int64]string{
+				workspace: {editor: domain.WorkspaceRoleEditor, viewer: domain.WorkspaceRoleViewer},
+			},
+			documents: map[int64]*trashedDocument{
+				trashed: {workspaceID: workspace, uploader: editor, sizeBytes: sizeBytes, deletedAt: &deletedAt},
+				large:   {workspaceID: workspace, uploader: editor, sizeBytes: largeBytes, deletedAt: &deletedAt},
+				kept:    {workspaceID: workspace, uploader: editor, sizeBytes: sizeBytes},
+			},
+		}
+	}
+	newService := func(repo *trashRepository) *service {
+		s := newTestService(repo)
+		// Квота вмещает восстановление небольшого документа, но не большого.
+		s.quotaCfg = &config.QuotaConfig{MaxBytes: sizeBytes + largeBytes - 1}
+		s.trashCfg = &config.TrashConfig{Retention: time.Minute}
+		return s
+	}
+
+	t.Run("restore", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			userID     int64
+			documentID int64
+			wantErr    error
+			wantQuota  bool
+		}{
+			{name: "editor", userID: editor, documentID: trashed},
+			{name: "viewer", userID: viewer, documentID: trashed, wantErr: ErrTrashedDocumentNotFound},
+			{name: "document not in trash", userID: editor, documentID: kept, wantErr: ErrTrashedDocumentNotFound},
+			{name: "over quota", userID: editor, documentID: large, wantQuota: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newService(repo)
+
+				_, err := s.RestoreDocument(context.Background(), tt.userID, tt.documentID)
+				if tt.wantQuota {
+					var quotaErr *domain.QuotaExceededError
+					if !errors.As(err, &quotaErr) || quotaErr.Resource != domain.QuotaStorage {
+						t.Fatalf("RestoreDocument error = %v, want storage quota error", err)
+					}
+					if repo.documents[tt.documentID].deletedAt == nil {
+						t.Error("document is restored although the quota check failed")
+					}
+					if !slices.Contains(repo.lockedUsers, editor) {
+						t.Error("quota of the uploader is not locked")
+					}
+					return
+				}
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RestoreDocument error = %v, want %v", err, tt.wantErr)
+				}
+
+				inTrash := repo.documents[tt.documentID].deletedAt != nil
+				if wantInTrash := tt.documentID != kept && err != nil; inTrash != wantInTrash {
+					t.Errorf("document in trash = %v, want %v", inTrash, wantInTrash)
+				}
+			})
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			userID      int64
+			wantDeleted int
+		}{
+			{name: "editor", userID: editor, wantDeleted: 2},
+			{name: "viewer", userID: viewer, wantDeleted: 0},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newService(repo)
+
+				workspaceID := workspace
+				deleted, err := s.EmptyTrash(context.Background(), tt.userID, &workspaceID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if deleted != tt.wantDeleted || len(repo.documents) != 3-tt.wantDeleted {
+					t.Errorf("deleted = %d, documents left = %d, want %d deleted", deleted, len(repo.documents), tt.wantDeleted)
+				}
+			})
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			expired     int
+			wantBatches []int
+		}{
+			{name: "nothing expired", expired: 0, wantBatches: []int{0}},
+			{name: "less than a batch", expired: 3, wantBatches: []int{3}},
+			{name: "exactly a batch", expired: trashPurgeBatchSize, wantBatches: []int{trashPurgeBatchSize, 0}},
+			{name: "several batches", expired: 2*trashPurgeBatchSize + 1, wantBatches: []int{trashPurgeBatchSize, trashPurgeBatchSize, 1}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newRepo()
+				s := newService(repo)
+
+				// Документы, удаленные только что, срок хранения еще не прошли и остаются в корзине.
+				now := time.Now()
+				repo.documents[trashed].deletedAt = &now
+				repo.documents[large].deletedAt = &now
+				for i := range tt.expired {
+					repo.documents[int64(100+i)] = &trashedDocument{workspaceID: workspace, uploader: editor, deletedAt: &deletedAt}
+				}
+
+				if err := s.purgeExpiredTrash(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+				if !slices.Equal(repo.purgeBatches, tt.wantBatches) {
+					t.Errorf("batches = %v, want %v", repo.purgeBatches, tt.wantBatches)
+				}
+				if len(repo.documents) != 3 {
+					t.Errorf("documents left = %d, want 3", len(repo.documents))
+				}
+			})
+		}
+	})
+}
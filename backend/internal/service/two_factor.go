@@ -0,0 +1,351 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"backend/pkg/totp"
+	"context"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type TwoFactorService interface {
+	BeginTOTPEnrollment(ctx context.Context, userID int64) (*domain.TOTPEnrollment, error)
+	ConfirmTOTPEnrollment(ctx context.Context, userID int64, code string) (recoveryCodes []string, err error)
+	DisableTOTP(ctx context.Context, userID int64, reauth domain.Reauthentication) error
+	VerifyLoginChallenge(ctx context.Context, challengeToken, code string) (accessToken, refreshToken string, err error)
+}
+
+const (
+	recoveryCodesCount = 10
+	totpSkew           = 1
+)
+
+var (
+	ErrTwoFactorNotConfigured  = errors.New("two-factor authentication is not configured")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTOTPEnrollmentNotFound  = errors.New("two-factor enrollment was not started")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrLoginChallengeNotFound  = errors.New("login challenge not found, expired or exhausted")
+)
+
+func (s *service) BeginTOTPEnrollment(ctx context.Context, userID int64) (*domain.TOTPEnrollment, error) {
+	if s.twoFactorCfg.EncryptionKey == "" {
+		return nil, ErrTwoFactorNotConfigured
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	secretEncrypted, err := s.encryptTOTPSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment *domain.TOTPEnrollment
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		user, _, err := repo.GetUserById(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		stored, err := repo.UpsertPendingUserTOTP(ctx, userID, secretEncrypted)
+		if err != nil {
+			return err
+		}
+		if !stored {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		enrollment = &domain.TOTPEnrollment{
+			Secret:          secret,
+			ProvisioningURI: totp.ProvisioningURI(s.twoFactorCfg.Issuer, user.Email, secret),
+		}
+		return nil
+	})
+
+	return enrollment, err
+}
+
+func (s *service) ConfirmTOTPEnrollment(ctx context.Context, userID int64, code string) ([]string, error) {
+	var recoveryCodes []string
+
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		userTOTP, err := repo.GetUserTOTP(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTOTPEnrollmentNotFound
+			}
+			return err
+		}
+		if userTOTP.Enabled {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		if err := s.checkTOTPCode(ctx, repo, userTOTP, code); err != nil {
+			return err
+		}
+
+		if err := repo.EnableUserTOTP(ctx, userID); err != nil {
+			return err
+		}
+
+		codes, err := s.regenerateRecoveryCodes(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		recoveryCodes = codes
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Msg("Two-factor authentication enabled")
+	return recoveryCodes, nil
+}
+
+// DisableTOTP отключает второй фактор. Код из reauth проверяется как второй фактор,
+// поэтому аккаунту без пароля он не заменяет повторный вход у провайдера.
+func (s *service) DisableTOTP(ctx context.Context, userID int64, reauth domain.Reauthentication) error {
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		_, passwordHash, err := repo.GetUserById(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+		code := reauth.Code
+		reauth.Code = ""
+		if err := s.reauthenticate(ctx, repo, userID, passwordHash, reauth); err != nil {
+			return err
+		}
+
+		userTOTP, err := repo.GetUserTOTP(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTwoFactorNotEnabled
+			}
+			return err
+		}
+		if !userTOTP.Enabled {
+			return ErrTwoFactorNotEnabled
+		}
+
+		if err := s.checkSecondFactor(ctx, repo, userTOTP, code); err != nil {
+			return err
+		}
+
+		if err := repo.DeleteUserTOTP(ctx, userID); err != nil {
+			return err
+		}
+		return repo.DeleteUserRecoveryCodes(ctx, userID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info().Int64("user_id", userID).Msg("Two-factor authentication disabled")
+	return nil
+}
+
+func (s *service) VerifyLoginChallenge(ctx context.Context, challengeToken, code string) (string, string, error) {
+	tokenHash := hashToken(challengeToken)
+
+	// Попытка засчитывается в отдельной транзакции, чтобы неверный код не откатывал счетчик.
+	challenge, err := s.repo.IncrLoginChallengeAttempts(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", "", ErrLoginChallengeNotFound
+		}
+		return "", "", err
+	}
+	if int(challenge.Attempts) > s.twoFactorCfg.MaxAttempts {
+		if err := s.repo.DeleteLoginChallenge(ctx, challenge.ID); err != nil {
+			s.log.Err(err).Msg("failed to delete exhausted login challenge")
+		}
+		return "", "", ErrLoginChallengeNotFound
+	}
+
+	var accessToken, refreshToken string
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		userTOTP, err := repo.GetUserTOTP(ctx, challenge.UserID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTwoFactorNotEnabled
+			}
+			return err
+		}
+
+		if err := s.checkSecondFactor(ctx, repo, userTOTP, code); err != nil {
+			return err
+		}
+
+		if err := repo.DeleteLoginChallenge(ctx, challenge.ID); err != nil {
+			return err
+		}
+
+		user, _, err := repo.GetUserById(ctx, challenge.UserID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrLoginChallengeNotFound
+			}
+			return err
+		}
+		if user.Disabled {
+			return ErrAccountDisabled
+		}
+
+		newAccessToken, newRefreshToken, err := s.generateTokenPair(ctx, repo, user)
+		if err != nil {
+			return err
+		}
+
+		accessToken = newAccessToken
+		refreshToken = newRefreshToken
+		return nil
+	})
+
+	return accessToken, refreshToken, err
+}
+
+func (s *service) createLoginChallenge(ctx context.Context, repo repository.Repository, userID int64) (*domain.LoginResult, error) {
+	if err := repo.DeleteExpiredLoginChallenges(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	rawToken, err := generateSecureRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(s.twoFactorCfg.ChallengeTTL)
+
+	if err := repo.CreateLoginChallenge(ctx, userID, hashToken(rawToken), expiresAt); err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		ChallengeToken:     rawToken,
+		ChallengeExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *service) userHasTwoFactor(ctx context.Context, repo repository.Repository, userID int64) (bool, error) {
+	userTOTP, err := repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return userTOTP.Enabled, nil
+}
+
+// checkSecondFactor принимает либо текущий TOTP-код, либо неиспользованный код восстановления.
+func (s *service) checkSecondFactor(ctx context.Context, repo repository.Repository, userTOTP *domain.UserTOTP, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.checkTOTPCode(ctx, repo, userTOTP, code)
+	}
+
+	used, err := repo.UseRecoveryCode(ctx, userTOTP.UserID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+
+	s.log.Info().Int64("user_id", userTOTP.UserID).Msg("Recovery code used")
+	return nil
+}
+
+func (s *service) checkTOTPCode(ctx context.Context, repo repository.Repository, userTOTP *domain.UserTOTP, code string) error {
+	secret, err := s.decryptTOTPSecret(userTOTP.SecretEncrypted)
+	if err != nil {
+		return err
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now(), totpSkew)
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+
+	fresh, err := repo.UseUserTOTPStep(ctx, userTOTP.UserID, step)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (s *service) regenerateRecoveryCodes(ctx context.Context, repo repository.Repository, userID int64) ([]string, error) {
+	if err := repo.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodesCount)
+	for i := range codes {
+		raw, err := generateSecureRandomString(5)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = raw[:5] + "-" + raw[5:]
+
+		if err := repo.CreateRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(codes[i]))); err != nil {
+			return nil, err
+		}
+	}
+
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+func (s *service) totpCipher() (cipher.AEAD, error) {
+	if s.twoFactorCfg.EncryptionKey == "" {
+		return nil, ErrTwoFactorNotConfigured
+	}
+	return newSecretCipher(s.twoFactorCfg.EncryptionKey)
+}
+
+func (s *service) encryptTOTPSecret(secret string) (string, error) {
+	aead, err := s.totpCipher()
+	if err != nil {
+		return "", err
+	}
+	return sealSecret(aead, secret)
+}
+
+func (s *service) decryptTOTPSecret(secretEncrypted string) (string, error) {
+	aead, err := s.totpCipher()
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := openSecret(aead, secretEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"backend/pkg/totp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type twoFactorRepository struct {
+	fakeRepository
+	lastUsedStep  int64
+	recoveryCodes map[string]bool
+}
+
+func newTwoFactorRepository() *twoFactorRepository {
+	return &twoFactorRepository{recoveryCodes: make(map[string]bool)}
+}
+
+func (r *twoFactorRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+// UseUserTOTPStep повторяет условие запроса: шаг принимается, только если он новее последнего использованного.
+func (r *twoFactorRepository) UseUserTOTPStep(ctx context.Context, userID, step int64) (bool, error) {
+	if step <= r.lastUsedStep {
+		return false, nil
+	}
+	r.lastUsedStep = step
+	return true, nil
+}
+
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	if !r.recoveryCodes[codeHash] {
+		return false, nil
+	}
+	delete(r.recoveryCodes, codeHash)
+	return true, nil
+}
+
+// totpCodeAt считает код по RFC 6238 независимо от пакета totp.
+func totpCodeAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(at.Unix()/totp.Period))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
+
+func TestCheckSecondFactor(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	current := totpCodeAt(t, secret, now)
+	previous := totpCodeAt(t, secret, now.Add(-totp.Period*time.Second))
+	stale := totpCodeAt(t, secret, now.Add(-3*totp.Period*time.Second))
+
+	tests := []struct {
+		name string
+		// codes — коды, которые вводятся по очереди в одном и том же аккаунте.
+		codes   []string
+		wantErr []error
+	}{
+		{
+			name:    "current code",
+			codes:   []string{current},
+			wantErr: []error{nil},
+		},
+		{
+			name:    "replay of the same code",
+			codes:   []string{current, current},
+			wantErr: []error{nil, ErrInvalidTwoFactorCode},
+		},
+		{
+			name:    "older code after a newer one",
+			codes:   []string{current, previous},
+			wantErr: []error{nil, ErrInvalidTwoFactorCode},
+		},
+		{
+			name:    "previous step within skew",
+			codes:   []string{previous},
+			wantErr: []error{nil},
+		},
+		{
+			name:    "code outside skew",
+			codes:   []string{stale},
+			wantErr: []error{ErrInvalidTwoFactorCode},
+		},
+		{
+			name:    "code with spaces around",
+			codes:   []string{" " + current + " "},
+			wantErr: []error{nil},
+		},
+		{
+			name:    "recovery code is single-use",
+			codes:   []string{"ABCDE-FGHIJ", "abcdefghij"},
+			wantErr: []error{nil, ErrInvalidTwoFactorCode},
+		},
+		{
+			name:    "unknown recovery code",
+			codes:   []string{"zzzzz-zzzzz"},
+			wantErr: []error{ErrInvalidTwoFactorCode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTwoFactorRepository()
+			repo.recoveryCodes[hashToken("abcdefghij")] = true
+
+			s := newTestService(repo)
+			s.twoFactorCfg = &config.TwoFactorConfig{EncryptionKey: "test-key"}
+			secretEncrypted, err := s.encryptTOTPSecret(secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			userTOTP := &domain.UserTOTP{UserID: 1, SecretEncrypted: secretEncrypted, Enabled: true}
+
+			for i, code := range tt.codes {
+				err := s.checkSecondFactor(context.Background(), repo, userTOTP, code)
+				if !errors.Is(err, tt.wantErr[i]) {
+					t.Fatalf("code #%d: error = %v, want %v", i+1, err, tt.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecryptTOTPSecretWithAnotherKey(t *testing.T) {
+	s := newTestService(nil)
+	s.twoFactorCfg = &config.TwoFactorConfig{EncryptionKey: "key-1"}
+	secretEncrypted, err := s.encryptTOTPSecret("SECRET")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.twoFactorCfg = &config.TwoFactorConfig{EncryptionKey: "key-2"}
+	if _, err := s.decryptTOTPSecret(secretEncrypted); err == nil {
+		t.Error("secret is decrypted with another key")
+	}
+}
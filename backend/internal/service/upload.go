@@ -0,0 +1,482 @@
+package service
+
+import (
+	"backend/internal/blobstore"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type UploadService interface {
+	CreateUpload(ctx context.Context, userID int64, workspaceID *int64, filename, contentType string, length int64) (*domain.Upload, error)
+	GetUpload(ctx context.Context, userID int64, id string) (*domain.Upload, error)
+	AppendUpload(ctx context.Context, userID int64, id string, offset int64, r io.Reader, checksum *UploadChecksum) (*domain.Upload, error)
+	DeleteUpload(ctx context.Context, userID int64, id string) error
+	RunUploadCleanup(ctx context.Context)
+}
+
+var (
+	ErrUploadNotFound         = errors.New("upload not found or expired")
+	ErrUploadOffsetMismatch   = errors.New("upload offset does not match")
+	ErrUploadLocked           = errors.New("upload is being written by another request")
+	ErrUploadChecksumMismatch = errors.New("upload checksum mismatch")
+	ErrUnsupportedChecksum    = errors.New("unsupported checksum algorithm")
+)
+
+// UploadChecksumAlgorithms — алгоритмы, поддерживаемые расширением checksum протокола tus.
+var UploadChecksumAlgorithms = []string{"sha1", "sha256", "md5"}
+
+const (
+	uploadIDBytes            = 16
+	uploadPartContentType    = "application/octet-stream"
+	defaultUploadLockTimeout = time.Minute
+)
+
+// UploadChecksum — контрольная сумма тела одного PATCH-запроса из заголовка Upload-Checksum.
+type UploadChecksum struct {
+	Algorithm string
+	Sum       []byte
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "sha1":
+		return sha1.New(), nil
+	case "sha256":
+		return sha256.New(), nil
+	case "md5":
+		return md5.New(), nil
+	}
+	return nil, ErrUnsupportedChecksum
+}
+
+func (s *service) CreateUpload(ctx context.Context, userID int64, workspaceID *int64, filename, contentType string, length int64) (*domain.Upload, error) {
+	workspace, err := s.resolveWorkspace(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanWriteWorkspace(workspace.Role) {
+		return nil, ErrWorkspaceForbidden
+	}
+
+	// Размер известен заранее, поэтому квоту на хранилище проверяем до приёма данных.
+	if err := s.checkUploadQuota(ctx, s.repo, userID, 1, length, 0); err != nil {
+		return nil, err
+	}
+
+	id, err := generateSecureRandomString(uploadIDBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.repo.CreateUpload(ctx, domain.Upload{
+		ID:          id,
+		UserID:      userID,
+		WorkspaceID: workspace.ID,
+		Filename:    filename,
+		ContentType: contentType,
+		Length:      length,
+		ExpiresAt:   time.Now().Add(s.uploadCfg.ExpiresIn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Str("upload_id", id).Int64("length", length).Msg("Создана возобновляемая загрузка")
+
+	if upload.Complete() {
+		return s.finishUpload(ctx, upload)
+	}
+	return upload, nil
+}
+
+func (s *service) GetUpload(ctx context.Context, userID int64, id string) (*domain.Upload, error) {
+	upload, err := s.repo.GetUserUpload(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, err
+	}
+	return upload, nil
+}
+
+// AppendUpload дописывает данные из r в загрузку начиная с offset.
+// Тело запроса сначала принимается во временный файл, а затем сохраняется отдельной частью в хранилище блобов,
+// поэтому запросы одной загрузки могут обрабатывать разные реплики.
+// Без контрольной суммы принятые байты сохраняются даже при обрыве соединения, чтобы клиент мог продолжить с них.
+// С контрольной суммой данные сохраняются только целиком и только при совпадении суммы.
+func (s *service) AppendUpload(ctx context.Context, userID int64, id string, offset int64, r io.Reader, checksum *UploadChecksum) (*domain.Upload, error) {
+	var checksumHash hash.Hash
+	if checksum != nil {
+		var err error
+		checksumHash, err = newChecksumHash(checksum.Algorithm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	upload, lease, err := s.lockUpload(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer s.unlockUpload(id, lease)
+
+	if upload.Offset != offset {
+		return nil, ErrUploadOffsetMismatch
+	}
+	if upload.Complete() {
+		if upload.DocumentID == nil {
+			return s.finishUpload(ctx, upload)
+		}
+		return upload, nil
+	}
+
+	if err := os.MkdirAll(s.uploadCfg.Dir, 0o750); err != nil {
+		return nil, err
+	}
+	part, err := os.CreateTemp(s.uploadCfg.Dir, "part-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		part.Close()
+		if err := os.Remove(part.Name()); err != nil {
+			s.log.Err(err).Str("upload_id", id).Msg("Не удалось удалить временный файл загрузки")
+		}
+	}()
+
+	src := io.LimitReader(r, upload.Length-offset)
+	if checksumHash != nil {
+		src = io.TeeReader(src, checksumHash)
+	}
+	written, copyErr := io.Copy(part, src)
+
+	if checksumHash != nil && (copyErr != nil || !bytes.Equal(checksumHash.Sum(nil), checksum.Sum)) {
+		if copyErr != nil {
+			return nil, copyErr
+		}
+		return nil, ErrUploadChecksumMismatch
+	}
+
+	if written > 0 {
+		// Клиент мог оборвать соединение, но принятые байты всё равно нужно сохранить.
+		if err := s.storeUploadPart(context.WithoutCancel(ctx), upload, lease.token, part, written); err != nil {
+			return nil, err
+		}
+		upload.Offset += written
+	}
+	if copyErr != nil {
+		s.log.Warn().Err(copyErr).Str("upload_id", id).Int64("offset", upload.Offset).Msg("Загрузка прервана, принятые данные сохранены")
+		return nil, copyErr
+	}
+
+	if upload.Complete() {
+		return s.finishUpload(ctx, upload)
+	}
+	return upload, nil
+}
+
+// storeUploadPart сохраняет принятые данные частью в хранилище блобов и сдвигает смещение загрузки.
+// Если смещение сдвинуть не удалось (загрузку удалили или аренду перехватил другой запрос), объект удаляется.
+func (s *service) storeUploadPart(ctx context.Context, upload *domain.Upload, lockToken string, part *os.File, size int64) error {
+	if _, err := part.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	suffix, err := generateSecureRandomString(blobKeyRandomBytes)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("uploads/%s/%d-%s", upload.ID, upload.Offset, suffix)
+
+	if err := s.blobStore.Put(ctx, key, part, size, uploadPartContentType); err != nil {
+		return err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		advanced, err := repo.AdvanceUploadOffset(ctx, upload.ID, lockToken, upload.Offset, upload.Offset+size)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return ErrUploadOffsetMismatch
+		}
+		return repo.CreateUploadPart(ctx, domain.UploadPart{
+			UploadID:   upload.ID,
+			Offset:     upload.Offset,
+			SizeBytes:  size,
+			StorageKey: key,
+		})
+	})
+	if err != nil {
+		s.deleteBlobObjects(ctx, []string{key})
+		return err
+	}
+	return nil
+}
+
+// uploadLease — аренда на запись в загрузку. Пока запрос держит ее, фоновая горутина продлевает срок аренды,
+// а если процесс упадет, аренда истечет сама через LockTimeout.
+type uploadLease struct {
+	token string
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// lockUpload берет аренду на запись в загрузку пользователя. Возвращает ErrUploadLocked,
+// если загрузку сейчас пишет другой запрос, в том числе на другой реплике.
+func (s *service) lockUpload(ctx context.Context, userID int64, id string) (*domain.Upload, *uploadLease, error) {
+	token, err := generateSecureRandomString(uploadIDBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upload, err := s.repo.LockUserUpload(ctx, id, userID, token, time.Now().Add(s.uploadLockTimeout()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if _, err := s.GetUpload(ctx, userID, id); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, ErrUploadLocked
+		}
+		return nil, nil, err
+	}
+
+	lease := &uploadLease{token: token, stop: make(chan struct{}), done: make(chan struct{})}
+	go s.extendUploadLease(id, lease)
+	return upload, lease, nil
+}
+
+func (s *service) extendUploadLease(id string, lease *uploadLease) {
+	defer close(lease.done)
+
+	timeout := s.uploadLockTimeout()
+	ticker := time.NewTicker(timeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-lease.stop:
+			return
+		case <-ticker.C:
+			extended, err := s.repo.ExtendUploadLock(context.Background(), id, lease.token, time.Now().Add(timeout))
+			if err != nil {
+				s.log.Err(err).Str("upload_id", id).Msg("Не удалось продлить аренду загрузки")
+				continue
+			}
+			if !extended {
+				// Загрузку удалили или аренда истекла и ее взял другой запрос: сохранить данные он уже не сможет.
+				return
+			}
+		}
+	}
+}
+
+func (s *service) unlockUpload(id string, lease *uploadLease) {
+	close(lease.stop)
+	<-lease.done
+
+	if err := s.repo.UnlockUpload(context.Background(), id, lease.token); err != nil {
+		s.log.Err(err).Str("upload_id", id).Msg("Не удалось снять аренду загрузки")
+	}
+}
+
+func (s *service) uploadLockTimeout() time.Duration {
+	if s.uploadCfg.LockTimeout <= 0 {
+		return defaultUploadLockTimeout
+	}
+	return s.uploadCfg.LockTimeout
+}
+
+// finishUpload передаёт полностью принятый файл, собранный из частей, в обычную загрузку документа.
+func (s *service) finishUpload(ctx context.Context, upload *domain.Upload) (*domain.Upload, error) {
+	parts, err := s.repo.GetUploadParts(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUploadParts(parts, upload.Length); err != nil {
+		return nil, fmt.Errorf("upload %s: %w", upload.ID, err)
+	}
+
+	content := newUploadPartsReader(ctx, s.blobStore, parts)
+	defer content.Close()
+
+	contentType := upload.ContentType
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(content, head)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		contentType = http.DetectContentType(head[:n])
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err := s.UploadDocument(ctx, upload.UserID, &upload.WorkspaceID, upload.Filename, contentType, content, upload.Length)
+	var duplicateErr *domain.DuplicateDocumentError
+	if errors.As(err, &duplicateErr) {
+		// Такой файл уже загружен: загрузка завершается ссылкой на существующий документ.
+		doc, err = duplicateErr.Document, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CompleteUpload(ctx, upload.ID, doc.ID); err != nil {
+		return nil, err
+	}
+	s.deleteUploadParts(ctx, upload.ID)
+
+	upload.DocumentID = &doc.ID
+	return upload, nil
+}
+
+// checkUploadParts проверяет, что части идут подряд без пропусков и вместе дают весь файл.
+func checkUploadParts(parts []domain.UploadPart, length int64) error {
+	var next int64
+	for _, part := range parts {
+		if part.Offset != next {
+			return fmt.Errorf("upload data missing at offset %d", next)
+		}
+		next += part.SizeBytes
+	}
+	if next != length {
+		return fmt.Errorf("upload data has %d bytes, want %d", next, length)
+	}
+	return nil
+}
+
+// uploadPartsReader последовательно читает части загрузки из хранилища блобов, открывая их по одной.
+// Seek поддерживает только возврат в начало: UploadDocument читает файл дважды.
+type uploadPartsReader struct {
+	ctx   context.Context
+	store blobstore.BlobStore
+	parts []domain.UploadPart
+	next  int
+	cur   io.ReadCloser
+}
+
+func newUploadPartsReader(ctx context.Context, store blobstore.BlobStore, parts []domain.UploadPart) *uploadPartsReader {
+	return &uploadPartsReader{ctx: ctx, store: store, parts: parts}
+}
+
+func (r *uploadPartsReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if r.next == len(r.parts) {
+				return 0, io.EOF
+			}
+			body, err := r.store.Get(r.ctx, r.parts[r.next].StorageKey)
+			if err != nil {
+				return 0, err
+			}
+			r.cur = body
+			r.next++
+		}
+
+		n, err := r.cur.Read(p)
+		if errors.Is(err, io.EOF) {
+			err = r.cur.Close()
+			r.cur = nil
+			if n > 0 || err != nil {
+				return n, err
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *uploadPartsReader) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("upload parts reader can only seek to start")
+	}
+	if err := r.Close(); err != nil {
+		return 0, err
+	}
+	r.next = 0
+	return 0, nil
+}
+
+func (r *uploadPartsReader) Close() error {
+	if r.cur == nil {
+		return nil
+	}
+	err := r.cur.Close()
+	r.cur = nil
+	return err
+}
+
+func (s *service) DeleteUpload(ctx context.Context, userID int64, id string) error {
+	deleted, err := s.repo.DeleteUserUpload(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrUploadNotFound
+	}
+	s.deleteUploadParts(ctx, id)
+	return nil
+}
+
+// RunUploadCleanup периодически удаляет истекшие загрузки и их данные в хранилище блобов.
+func (s *service) RunUploadCleanup(ctx context.Context) {
+	if s.uploadCfg.CleanupInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.uploadCfg.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.cleanupUploads(ctx); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Msg("failed to cleanup expired uploads")
+			}
+		}
+	}
+}
+
+func (s *service) cleanupUploads(ctx context.Context) error {
+	if _, err := s.repo.DeleteExpiredUploads(ctx); err != nil {
+		return err
+	}
+
+	// Записи о загрузках удаляются и каскадно вместе с пользователем или рабочим пространством,
+	// поэтому части ищутся по отсутствию загрузки, а не по списку удаленных.
+	keys, err := s.repo.DeleteOrphanUploadParts(ctx)
+	if err != nil {
+		return err
+	}
+	s.deleteBlobObjects(ctx, keys)
+	return nil
+}
+
+func (s *service) deleteUploadParts(ctx context.Context, id string) {
+	keys, err := s.repo.DeleteUploadParts(ctx, id)
+	if err != nil {
+		// Части останутся до фоновой очистки.
+		s.log.Err(err).Str("upload_id", id).Msg("Не удалось удалить данные загрузки")
+		return
+	}
+	s.deleteBlobObjects(ctx, keys)
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// memoryBlobStore — хранилище блобов в памяти.
+type memoryBlobStore struct {
+	objects map[string][]byte
+}
+
+func (b *memoryBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return errors.New("size mismatch")
+	}
+	b.objects[key] = data
+	return nil
+}
+
+func (b *memoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (b *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	delete(b.objects, key)
+	return nil
+}
+
+// uploadRepository хранит одну загрузку с арендой и частями в памяти.
+type uploadRepository struct {
+	fakeRepository
+	upload    domain.Upload
+	lockToken string
+	parts     []domain.UploadPart
+}
+
+func (r *uploadRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *uploadRepository) GetUserUpload(ctx context.Context, id string, userID int64) (*domain.Upload, error) {
+	if id != r.upload.ID || userID != r.upload.UserID {
+		return nil, pgx.ErrNoRows
+	}
+	upload := r.upload
+	return &upload, nil
+}
+
+func (r *uploadRepository) LockUserUpload(ctx context.Context, id string, userID int64, lockToken string, lockedUntil time.Time) (*domain.Upload, error) {
+	if r.lockToken != "" {
+		return nil, pgx.ErrNoRows
+	}
+	upload, err := r.GetUserUpload(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	r.lockToken = lockToken
+	return upload, nil
+}
+
+func (r *uploadRepository) ExtendUploadLock(ctx context.Context, id, lockToken string, lockedUntil time.Time) (bool, error) {
+	return r.lockToken == lockToken, nil
+}
+
+func (r *uploadRepository) UnlockUpload(ctx context.Context, id, lockToken string) error {
+	if r.lockToken == lockToken {
+		r.lockToken = ""
+	}
+	return nil
+}
+
+func (r *uploadRepository) AdvanceUploadOffset(ctx context.Context, id, lockToken string, oldOffset, newOffset int64) (bool, error) {
+	if r.lockToken != lockToken || r.upload.Offset != oldOffset {
+		return false, nil
+	}
+	r.upload.Offset = newOffset
+	return true, nil
+}
+
+func (r *uploadRepository) CreateUploadPart(ctx context.Context, part domain.UploadPart) error {
+	r.parts = append(r.parts, part)
+	return nil
+}
+
+// brokenReader отдаёт данные, а затем ошибку, как оборванное соединение.
+type brokenReader struct {
+	data string
+	read bool
+}
+
+func (r *brokenReader) Read(p []byte) (int, error) {
+	if r.read {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.read = true
+	return copy(p, r.data), nil
+}
+
+func TestAppendUpload(t *testing.T) {
+	const (
+		uploadID       = "upload-1"
+		userID   int64 = 1
+		// Загрузка длиной 16 байт, из которых 4 уже приняты.
+		length int64 = 16
+		offset int64 = 4
+	)
+
+	sum := func(data string) []byte {
+		s := sha256.Sum256([]byte(data))
+		return s[:]
+	}
+
+	tests := []struct {
+		name       string
+		lockedBy   string
+		offset     int64
+		body       io.Reader
+		checksum   *UploadChecksum
+		wantErr    error
+		wantAnyErr bool
+		// wantOffset — смещение загрузки после запроса; wantStored — сохранённые данные новой части.
+		wantOffset int64
+		wantStored string
+	}{
+		{
+			name:       "append without checksum",
+			offset:     offset,
+			body:       strings.NewReader("abcdef"),
+			wantOffset: offset + 6,
+			wantStored: "abcdef",
+		},
+		{
+			name:       "append with valid checksum",
+			offset:     offset,
+			body:       strings.NewReader("abcdef"),
+			checksum:   &UploadChecksum{Algorithm: "sha256", Sum: sum("abcdef")},
+			wantOffset: offset + 6,
+			wantStored: "abcdef",
+		},
+		{
+			name:       "checksum mismatch",
+			offset:     offset,
+			body:       strings.NewReader("abcdef"),
+			checksum:   &UploadChecksum{Algorithm: "sha256", Sum: sum("abcdeg")},
+			wantErr:    ErrUploadChecksumMismatch,
+			wantOffset: offset,
+		},
+		{
+			name:       "unsupported checksum algorithm",
+			offset:     offset,
+			body:       strings.NewReader("abcdef"),
+			checksum:   &UploadChecksum{Algorithm: "crc32", Sum: []byte{1}},
+			wantErr:    ErrUnsupportedChecksum,
+			wantOffset: offset,
+		},
+		{
+			name:       "offset behind",
+			offset:     0,
+			body:       strings.NewReader("abcdef"),
+			wantErr:    ErrUploadOffsetMismatch,
+			wantOffset: offset,
+		},
+		{
+			name:       "offset ahead",
+			offset:     offset + 1,
+			body:       strings.NewReader("abcdef"),
+			wantErr:    ErrUploadOffsetMismatch,
+			wantOffset: offset,
+		},
+		{
+			name:       "upload locked by another request",
+			lockedBy:   "other",
+			offset:     offset,
+			body:       strings.NewReader("abcdef"),
+			wantErr:    ErrUploadLocked,
+			wantOffset: offset,
+		},
+		{
+			name:       "interrupted body is kept",
+			offset:     offset,
+			body:       &brokenReader{data: "abc"},
+			wantAnyErr: true,
+			wantOffset: offset + 3,
+			wantStored: "abc",
+		},
+		{
+			name:       "interrupted body with checksum is dropped",
+			offset:     offset,
+			body:       &brokenReader{data: "abc"},
+			checksum:   &UploadChecksum{Algorithm: "sha256", Sum: sum("abcdef")},
+			wantAnyErr: true,
+			wantOffset: offset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &uploadRepository{
+				upload:    domain.Upload{ID: uploadID, UserID: userID, Length: length, Offset: offset},
+				lockToken: tt.lockedBy,
+			}
+			blobs := &memoryBlobStore{objects: make(map[string][]byte)}
+			s := newTestService(repo)
+			s.blobStore = blobs
+			s.uploadCfg = &config.UploadConfig{Dir: t.TempDir()}
+
+			_, err := s.AppendUpload(context.Background(), userID, uploadID, tt.offset, tt.body, tt.checksum)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AppendUpload error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantAnyErr:
+				if err == nil {
+					t.Fatal("AppendUpload succeeded, want error")
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if repo.upload.Offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", repo.upload.Offset, tt.wantOffset)
+			}
+			if repo.lockToken != tt.lockedBy {
+				t.Errorf("lock token = %q, want %q", repo.lockToken, tt.lockedBy)
+			}
+
+			if tt.wantStored == "" {
+				if len(repo.parts) != 0 || len(blobs.objects) != 0 {
+					t.Errorf("stored %d parts and %d objects, want none", len(repo.parts), len(blobs.objects))
+				}
+				return
+			}
+			if len(repo.parts) != 1 {
+				t.Fatalf("stored %d parts, want 1", len(repo.parts))
+			}
+			part := repo.parts[0]
+			if part.Offset != offset || part.SizeBytes != int64(len(tt.wantStored)) {
+				t.Errorf("part = offset %d size %d, want offset %d size %d", part.Offset, part.SizeBytes, offset, len(tt.wantStored))
+			}
+			if got := string(blobs.objects[part.StorageKey]); got != tt.wantStored {
+				t.Errorf("stored data = %q, want %q", got, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestCheckUploadParts(t *testing.T) {
+	tests := []struct {
+		name    string
+		parts   []domain.UploadPart
+		length  int64
+		wantErr bool
+	}{
+		{
+			name:   "contiguous parts",
+			parts:  []domain.UploadPart{{Offset: 0, SizeBytes: 4}, {Offset: 4, SizeBytes: 6}},
+			length: 10,
+		},
+		{
+			name:   "empty upload",
+			length: 0,
+		},
+		{
+			name:    "gap between parts",
+			parts:   []domain.UploadPart{{Offset: 0, SizeBytes: 4}, {Offset: 5, SizeBytes: 5}},
+			length:  10,
+			wantErr: true,
+		},
+		{
+			name:    "overlapping parts",
+			parts:   []domain.UploadPart{{Offset: 0, SizeBytes: 4}, {Offset: 2, SizeBytes: 8}},
+			length:  10,
+			wantErr: true,
+		},
+		{
+			name:    "missing tail",
+			parts:   []domain.UploadPart{{Offset: 0, SizeBytes: 4}},
+			length:  10,
+			wantErr: true,
+		},
+		{
+			name:    "more data than length",
+			parts:   []domain.UploadPart{{Offset: 0, SizeBytes: 12}},
+			length:  10,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		if err := checkUploadParts(tt.parts, tt.length); (err != nil) != tt.wantErr {
+			t.Errorf("%s: checkUploadParts error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
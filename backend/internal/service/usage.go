@@ -0,0 +1,84 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"time"
+)
+
+type UsageService interface {
+	GetUserUsage(ctx context.Context, userID int64) (*domain.Usage, error)
+}
+
+func (s *service) GetUserUsage(ctx context.Context, userID int64) (*domain.Usage, error) {
+	return s.getUserUsage(ctx, s.repo, userID)
+}
+
+func (s *service) getUserUsage(ctx context.Context, repo repository.Repository, userID int64) (*domain.Usage, error) {
+	usage, err := repo.GetUserUsage(ctx, userID, usageDay(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	usage.MaxBytes = s.quotaCfg.MaxBytes
+	usage.MaxDocuments = s.quotaCfg.MaxDocuments
+	usage.MaxChunks = s.quotaCfg.MaxChunks
+	usage.MaxSearchesPerDay = s.quotaCfg.MaxSearchesPerDay
+	return usage, nil
+}
+
+// checkUploadQuota проверяет, что новые документы или версии с заданным размером и количеством чанков уместятся в квоты пользователя.
+// Проверка через s.repo — предварительная, до сохранения файла. Окончательная выполняется в транзакции записи
+// после LockUserQuota, иначе параллельные загрузки могут вместе превысить квоту.
+func (s *service) checkUploadQuota(ctx context.Context, repo repository.Repository, userID, documents, sizeBytes, chunks int64) error {
+	usage, err := s.getUserUsage(ctx, repo, userID)
+	if err != nil {
+		return err
+	}
+
+	checks := []struct {
+		resource string
+		used     int64
+		add      int64
+		limit    int64
+	}{
+		{domain.QuotaDocuments, usage.Documents, documents, usage.MaxDocuments},
+		{domain.QuotaStorage, usage.SizeBytes, sizeBytes, usage.MaxBytes},
+		{domain.QuotaChunks, usage.Chunks, chunks, usage.MaxChunks},
+	}
+	for _, c := range checks {
+		if c.limit > 0 && c.used+c.add > c.limit {
+			return &domain.QuotaExceededError{Resource: c.resource, Limit: c.limit, Used: c.used}
+		}
+	}
+
+	return nil
+}
+
+// checkUploadQuotaLocked блокирует квоты пользователя до конца транзакции repo и проверяет их.
+func (s *service) checkUploadQuotaLocked(ctx context.Context, repo repository.Repository, userID, documents, sizeBytes, chunks int64) error {
+	if err := repo.LockUserQuota(ctx, userID); err != nil {
+		return err
+	}
+	return s.checkUploadQuota(ctx, repo, userID, documents, sizeBytes, chunks)
+}
+
+// consumeSearchQuota учитывает очередной поиск пользователя и проверяет дневной лимит.
+func (s *service) consumeSearchQuota(ctx context.Context, userID int64) error {
+	count, err := s.repo.IncrSearchUsage(ctx, userID, usageDay(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	limit := s.quotaCfg.MaxSearchesPerDay
+	if limit > 0 && count > limit {
+		return &domain.QuotaExceededError{Resource: domain.QuotaSearches, Limit: limit, Used: limit}
+	}
+	return nil
+}
+
+// usageDay возвращает календарный день (UTC), к которому относится дневной счетчик.
+func usageDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// quotaRepository отдает потребление квот из памяти. concurrent — загрузка, которая
+// успевает завершиться, пока UploadDocument ждет блокировку квот.
+type quotaRepository struct {
+	fakeRepository
+	usage       domain.Usage
+	concurrent  domain.Usage
+	lockedUsers []int64
+}
+
+func (r *quotaRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *quotaRepository) GetPersonalWorkspace(ctx context.Context, userID int64) (*domain.Workspace, error) {
+	return &domain.Workspace{ID: userID, Personal: true, Role: domain.WorkspaceRoleOwner}, nil
+}
+
+func (r *quotaRepository) GetWorkspaceDocumentIDByContentHash(ctx context.Context, workspaceID int64, contentSHA256 string) (int64, error) {
+	return 0, pgx.ErrNoRows
+}
+
+func (r *quotaRepository) GetUserUsage(ctx context.Context, userID int64, day time.Time) (*domain.Usage, error) {
+	usage := r.usage
+	return &usage, nil
+}
+
+func (r *quotaRepository) LockUserQuota(ctx context.Context, userID int64) error {
+	r.lockedUsers = append(r.lockedUsers, userID)
+	r.usage.Documents += r.concurrent.Documents
+	r.usage.SizeBytes += r.concurrent.SizeBytes
+	r.usage.Chunks += r.concurrent.Chunks
+	return nil
+}
+
+func TestCheckUploadQuota(t *testing.T) {
+	const userID int64 = 1
+
+	tests := []struct {
+		name         string
+		cfg          config.QuotaConfig
+		usage        domain.Usage
+		documents    int64
+		sizeBytes    int64
+		chunks       int64
+		wantResource string
+	}{
+		{name: "unlimited", usage: domain.Usage{Documents: 1000, SizeBytes: 1 << 30}, documents: 1, sizeBytes: 1 << 30},
+		{name: "up to the limit", cfg: config.QuotaConfig{MaxBytes: 100}, usage: domain.Usage{SizeBytes: 60}, sizeBytes: 40},
+		{name: "storage over the limit", cfg: config.QuotaConfig{MaxBytes: 100}, usage: domain.Usage{SizeBytes: 60}, sizeBytes: 41, wantResource: domain.QuotaStorage},
+		{name: "documents over the limit", cfg: config.QuotaConfig{MaxDocuments: 2}, usage: domain.Usage{Documents: 2}, documents: 1, wantResource: domain.QuotaDocuments},
+		{name: "chunks over the limit", cfg: config.QuotaConfig{MaxChunks: 10}, usage: domain.Usage{Chunks: 8}, chunks: 3, wantResource: domain.QuotaChunks},
+		{name: "already over the limit without additions", cfg: config.QuotaConfig{MaxBytes: 100}, usage: domain.Usage{SizeBytes: 101}, wantResource: domain.QuotaStorage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &quotaRepository{usage: tt.usage}
+			s := newTestService(repo)
+			s.quotaCfg = &tt.cfg
+
+			err := s.checkUploadQuota(context.Background(), repo, userID, tt.documents, tt.sizeBytes, tt.chunks)
+			if tt.wantResource == "" {
+				if err != nil {
+					t.Fatalf("checkUploadQuota error = %v, want nil", err)
+				}
+				return
+			}
+			var quotaErr *domain.QuotaExceededError
+			if !errors.As(err, &quotaErr) || quotaErr.Resource != tt.wantResource {
+				t.Fatalf("checkUploadQuota error = %v, want %s quota error", err, tt.wantResource)
+			}
+		})
+	}
+}
+
+func TestUploadDocumentRechecksQuotaUnderLock(t *testing.T) {
+	const userID int64 = 1
+	content := "quota recheck"
+	size := int64(len(content))
+
+	tests := []struct {
+		name         string
+		cfg          config.QuotaConfig
+		concurrent   domain.Usage
+		wantResource string
+	}{
+		{
+			name:         "concurrent upload takes the rest of the storage",
+			cfg:          config.QuotaConfig{MaxBytes: 2 * size},
+			concurrent:   domain.Usage{Documents: 1, SizeBytes: size + 1},
+			wantResource: domain.QuotaStorage,
+		},
+		{
+			name:         "concurrent upload takes the last document",
+			cfg:          config.QuotaConfig{MaxDocuments: 1},
+			concurrent:   domain.Usage{Documents: 1, SizeBytes: 1},
+			wantResource: domain.QuotaDocuments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &quotaRepository{concurrent: tt.concurrent}
+			blobs := &memoryBlobStore{objects: make(map[string][]byte)}
+			s := newTestService(repo)
+			s.quotaCfg = &tt.cfg
+			s.blobStore = blobs
+
+			// Предварительная проверка проходит, повторная под блокировкой видит завершившуюся параллельную загрузку.
+			// До создания документа дело не доходит: вызов CreateBlob на fakeRepository завершился бы паникой.
+			_, err := s.UploadDocument(context.Background(), userID, nil, "notes.txt", "text/plain", strings.NewReader(content), size)
+
+			var quotaErr *domain.QuotaExceededError
+			if !errors.As(err, &quotaErr) || quotaErr.Resource != tt.wantResource {
+				t.Fatalf("UploadDocument error = %v, want %s quota error", err, tt.wantResource)
+			}
+			if len(repo.lockedUsers) != 1 || repo.lockedUsers[0] != userID {
+				t.Errorf("locked quotas = %v, want [%d]", repo.lockedUsers, userID)
+			}
+			if len(blobs.objects) != 0 {
+				t.Errorf("stored blobs = %d, want 0 after the failed upload", len(blobs.objects))
+			}
+		})
+	}
+}
@@ -2,18 +2,24 @@ package service
 
 import (
 	"backend/internal/domain"
+	"backend/internal/repository"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService interface {
 	GetUserProfile(ctx context.Context, userID int64) (*domain.User, error)
+	ChangePassword(ctx context.Context, userID int64, reauth domain.Reauthentication, newPassword string) (accessToken, refreshToken string, err error)
+	DeleteAccount(ctx context.Context, userID int64, reauth domain.Reauthentication) error
 }
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrReauthenticationRequired = errors.New("sign in with the identity provider again or provide a two-factor code")
 )
 
 func (s *service) GetUserProfile(ctx context.Context, userID int64) (*domain.User, error) {
@@ -25,5 +31,155 @@ func (s *service) GetUserProfile(ctx context.Context, userID int64) (*domain.Use
 		return nil, err
 	}
 
+	twoFactor, err := s.userHasTwoFactor(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	user.TwoFactorEnabled = twoFactor
+
 	return user, nil
 }
+
+func (s *service) ChangePassword(ctx context.Context, userID int64, reauth domain.Reauthentication, newPassword string) (string, string, error) {
+	newPasswordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+
+	var accessToken, refreshToken string
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		user, passwordHash, err := repo.GetUserById(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		if err := s.reauthenticate(ctx, repo, userID, passwordHash, reauth); err != nil {
+			return err
+		}
+
+		if err := repo.UpdateUserPassword(ctx, userID, string(newPasswordHash)); err != nil {
+			return err
+		}
+
+		// Завершаем все сессии, а текущей выдаем новую пару токенов.
+		if err := repo.DeleteAllUserRefreshTokens(ctx, userID); err != nil {
+			return err
+		}
+
+		newAccessToken, newRefreshToken, err := s.generateTokenPair(ctx, repo, user)
+		if err != nil {
+			return err
+		}
+		accessToken = newAccessToken
+		refreshToken = newRefreshToken
+
+		return repo.CreateAuditEvent(ctx, domain.AuditEvent{
+			UserID: userID,
+			Action: domain.AuditPasswordChanged,
+		})
+	})
+
+	return accessToken, refreshToken, err
+}
+
+func (s *service) DeleteAccount(ctx context.Context, userID int64, reauth domain.Reauthentication) error {
+	var blobKeys []string
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		user, passwordHash, err := repo.GetUserById(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		if err := s.reauthenticate(ctx, repo, userID, passwordHash, reauth); err != nil {
+			return err
+		}
+
+		// Документы общих пространств остаются в них, поэтому пространство не должно остаться без владельца.
+		if _, err := repo.LockOwnedSharedWorkspaces(ctx, userID); err != nil {
+			return err
+		}
+		soleOwned, err := repo.CountSoleOwnedWorkspaces(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if soleOwned > 0 {
+			return ErrSoleWorkspaceOwner
+		}
+
+		personal, err := repo.GetPersonalWorkspace(ctx, userID)
+		if err != nil {
+			return err
+		}
+		documentsCount, err := repo.CountUserDocuments(ctx, userID, domain.DocumentFilter{WorkspaceID: &personal.ID})
+		if err != nil {
+			return err
+		}
+
+		// Вместе с аккаунтом удаляется только личное пространство, поэтому и файлы проверяются только его.
+		blobIDs, err := repo.GetWorkspaceBlobIDs(ctx, personal.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+
+		blobKeys, err = repo.DeleteOrphanBlobs(ctx, blobIDs)
+		if err != nil {
+			return err
+		}
+
+		return repo.CreateAuditEvent(ctx, domain.AuditEvent{
+			UserID: userID,
+			Action: domain.AuditAccountDeleted,
+			Details: map[string]any{
+				"email":           user.Email,
+				"documents_count": documentsCount,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobObjects(ctx, blobKeys)
+
+	s.log.Info().Int64("user_id", userID).Msg("Аккаунт пользователя удален")
+	return nil
+}
+
+// reauthenticate подтверждает личность пользователя перед опасным действием с аккаунтом.
+// Аккаунт с паролем подтверждается паролем. Аккаунт без пароля входит только через провайдера,
+// поэтому ему нужен недавний вход у провайдера либо TOTP-код или код восстановления.
+func (s *service) reauthenticate(ctx context.Context, repo repository.Repository, userID int64, passwordHash string, reauth domain.Reauthentication) error {
+	if passwordHash != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(reauth.Password)); err != nil {
+			return ErrInvalidCredentials
+		}
+		return nil
+	}
+
+	if !reauth.OIDCAuthTime.IsZero() && time.Since(reauth.OIDCAuthTime) <= oidcReauthMaxAge {
+		return nil
+	}
+
+	if reauth.Code != "" {
+		userTOTP, err := repo.GetUserTOTP(ctx, userID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if err == nil && userTOTP.Enabled {
+			return s.checkSecondFactor(ctx, repo, userTOTP, reauth.Code)
+		}
+	}
+
+	return ErrReauthenticationRequired
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"context"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, userID, workspaceID int64, rawURL string, events []string, secret *string) (*domain.Webhook, error)
+	ListWebhooks(ctx context.Context, userID, workspaceID int64) ([]domain.Webhook, error)
+	GetWebhook(ctx context.Context, userID, workspaceID, webhookID int64) (*domain.Webhook, error)
+	UpdateWebhook(ctx context.Context, userID, workspaceID, webhookID int64, update domain.WebhookUpdate) (*domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, workspaceID, webhookID int64) error
+	ListWebhookDeliveries(ctx context.Context, userID, workspaceID, webhookID, page, size int64) ([]domain.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, userID, workspaceID, webhookID, deliveryID int64) (*domain.WebhookDelivery, error)
+	RunWebhookDelivery(ctx context.Context)
+	RunWebhookMaintenance(ctx context.Context)
+}
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL       = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookPrivateAddress   = errors.New("webhook url must not point to a private network address")
+	ErrInvalidWebhookEvent     = errors.New("unknown webhook event type")
+	ErrInvalidWebhookSecret    = errors.New("webhook secret must be between 16 and 256 characters")
+	ErrTooManyWebhooks         = errors.New("too many webhooks in workspace")
+	ErrWebhooksNotConfigured   = errors.New("webhook secret encryption key is not configured")
+)
+
+const (
+	maxWorkspaceWebhooks = 20
+	maxWebhookURLLength  = 2048
+	minWebhookSecretLen  = 16
+	maxWebhookSecretLen  = 256
+	webhookSecretBytes   = 32
+)
+
+func (s *service) CreateWebhook(ctx context.Context, userID, workspaceID int64, rawURL string, events []string, secret *string) (*domain.Webhook, error) {
+	if _, err := s.requireWorkspaceOwner(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	webhookURL, err := s.normalizeWebhookURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	events, err = normalizeWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhookSecret string
+	if secret != nil {
+		if err := validateWebhookSecret(*secret); err != nil {
+			return nil, err
+		}
+		webhookSecret = *secret
+	} else {
+		webhookSecret, err = generateSecureRandomString(webhookSecretBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secretEncrypted, err := s.encryptWebhookSecret(webhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountWorkspaceWebhooks(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxWorkspaceWebhooks {
+		return nil, ErrTooManyWebhooks
+	}
+
+	webhook, err := s.repo.CreateWebhook(ctx, domain.Webhook{
+		WorkspaceID:     workspaceID,
+		URL:             webhookURL,
+		SecretEncrypted: secretEncrypted,
+		Events:          events,
+		CreatedBy:       &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = webhookSecret
+
+	s.log.Info().Int64("user_id", userID).Int64("workspace_id", workspaceID).Int64("webhook_id", webhook.ID).Msg("Зарегистрирован вебхук")
+	return webhook, nil
+}
+
+func (s *service) ListWebhooks(ctx context.Context, userID, workspaceID int64) ([]domain.Webhook, error) {
+	if _, err := s.requireWorkspaceOwner(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWorkspaceWebhooks(ctx, workspaceID)
+}
+
+func (s *service) GetWebhook(ctx context.Context, userID, workspaceID, webhookID int64) (*domain.Webhook, error) {
+	if _, err := s.requireWorkspaceOwner(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+	return s.getWorkspaceWebhook(ctx, workspaceID, webhookID)
+}
+
+func (s *service) UpdateWebhook(ctx context.Context, userID, workspaceID, webhookID int64, update domain.WebhookUpdate) (*domain.Webhook, error) {
+	if _, err := s.requireWorkspaceOwner(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	if update.URL != nil {
+		webhookURL, err := s.normalizeWebhookURL(*update.URL)
+		if err != nil {
+			return nil, err
+		}
+		update.URL = &webhookURL
+	}
+	if update.Events != nil {
+		events, err := normalizeWebhookEvents(update.Events)
+		if err != nil {
+			return nil, err
+		}
+		update.Events = events
+	}
+	if update.Secret != nil {
+		if err := validateWebhookSecret(*update.Secret); err != nil {
+			return nil, err
+		}
+		secretEncrypted, err := s.encryptWebhookSecret(*update.Secret)
+		if err != nil {
+			return nil, err
+		}
+		update.SecretEncrypted = &secretEncrypted
+	}
+
+	webhook, err := s.repo.UpdateWebhook(ctx, webhookID, workspaceID, update)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("webhook_id", webhookID).Msg("Вебхук изменен")
+	return webhook, nil
+}
+
+func (s *service) DeleteWebhook(ctx context.Context, userID, workspaceID, webhookID int64) error {
+	if _, err := s.requireWorkspaceOwner(ctx, userID, workspaceID); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteWebhook(ctx, webhookID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("webhook_id", webhookID).Msg("Вебхук удален")
+	return nil
+}
+
+func (s *service) ListWebhookDeliveries(ctx context.Context, userID, workspaceID, webhookID, page, size int64) ([]domain.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(ctx, userID, workspaceID, webhookID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWebhookDeliveries(ctx, webhookID, page, size)
+}
+
+// RedeliverWebhook ставит событие доставки в очередь еще раз. Повторная доставка подписывается заново
+// текущим секретом и уходит на текущий адрес вебхука.
+func (s *service) RedeliverWebhook(ctx context.Context, userID, workspaceID, webhookID, deliveryID int64) (*domain.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(ctx, userID, workspaceID, webhookID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.repo.RedeliverWebhookEvent(ctx, deliveryID, webhookID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("webhook_id", webhookID).Int64("event_id", delivery.EventID).Msg("Событие вебхука поставлено на повторную доставку")
+	return delivery, nil
+}
+
+func (s *service) getWorkspaceWebhook(ctx context.Context, workspaceID, webhookID int64) (*domain.Webhook, error) {
+	webhook, err := s.repo.GetWorkspaceWebhook(ctx, webhookID, workspaceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// normalizeWebhookURL проверяет адрес вебхука. Имена хостов не разрешаются здесь:
+// адреса, в которые они указывают, проверяются при каждом соединении.
+func (s *service) normalizeWebhookURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" || len(rawURL) > maxWebhookURLLength {
+		return "", ErrInvalidWebhookURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return "", ErrInvalidWebhookURL
+	}
+	if !s.webhookCfg.AllowPrivateNetworks {
+		if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !isPublicAddr(addr) {
+			return "", ErrWebhookPrivateAddress
+		}
+		if strings.EqualFold(u.Hostname(), "localhost") {
+			return "", ErrWebhookPrivateAddress
+		}
+	}
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// normalizeWebhookEvents убирает повторы и проверяет типы событий.
+// Всегда возвращает срез, а не nil: пустой список означает подписку на все события, а nil в UpdateWebhook — что подписка не меняется.
+func normalizeWebhookEvents(events []string) ([]string, error) {
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		if !slices.Contains(domain.WebhookEventTypes, event) {
+			return nil, ErrInvalidWebhookEvent
+		}
+		if !slices.Contains(normalized, event) {
+			normalized = append(normalized, event)
+		}
+	}
+	return normalized, nil
+}
+
+func validateWebhookSecret(secret string) error {
+	if len(secret) < minWebhookSecretLen || len(secret) > maxWebhookSecretLen {
+		return ErrInvalidWebhookSecret
+	}
+	return nil
+}
+
+func (s *service) webhookCipher() (cipher.AEAD, error) {
+	if s.webhookCfg.EncryptionKey == "" {
+		return nil, ErrWebhooksNotConfigured
+	}
+	return newSecretCipher(s.webhookCfg.EncryptionKey)
+}
+
+func (s *service) encryptWebhookSecret(secret string) (string, error) {
+	aead, err := s.webhookCipher()
+	if err != nil {
+		return "", err
+	}
+	return sealSecret(aead, secret)
+}
+
+func (s *service) decryptWebhookSecret(secretEncrypted string) (string, error) {
+	aead, err := s.webhookCipher()
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := openSecret(aead, secretEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+	return secret, nil
+}
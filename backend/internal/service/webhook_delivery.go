@@ -0,0 +1,296 @@
+package service
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain"
+	"backend/pkg/webhooksig"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// webhookResponseBodyLimit — сколько байт ответа получателя сохраняется в журнале доставок.
+const webhookResponseBodyLimit = 1024
+
+const webhookUserAgent = "semantic-service-webhooks/1.0"
+
+var errWebhookPrivateAddress = errors.New("connection to a private network address is not allowed")
+
+// webhookPayload — тело запроса вебхука. ID события не меняется при повторных попытках и ручной
+// повторной доставке, поэтому получатель может по нему отбрасывать дубликаты.
+type webhookPayload struct {
+	ID          int64           `json:"id"`
+	Type        string          `json:"type"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	WorkspaceID int64           `json:"workspaceID"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// newWebhookClient создает HTTP-клиент для доставки вебхуков. Редиректы не выполняются,
+// а без AllowPrivateNetworks соединения с внутренними адресами запрещены уже после разрешения имени,
+// чтобы вебхуком нельзя было обратиться к сервисам внутри сети.
+func newWebhookClient(cfg *config.WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if addr, err := netip.ParseAddr(host); err != nil || !isPublicAddr(addr) {
+				return errWebhookPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDeniedPrefixes — диапазоны специального назначения из реестров IANA, куда вебхуки не отправляются:
+// частные, служебные, документационные, трансляционные и групповые адреса.
+var webhookDeniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "эта" сеть
+	netip.MustParsePrefix("10.0.0.0/8"),      // частная сеть
+	netip.MustParsePrefix("100.64.0.0/10"),   // shared address space (CGNAT)
+	netip.MustParsePrefix("127.0.0.0/8"),     // loopback
+	netip.MustParsePrefix("169.254.0.0/16"),  // link-local, в том числе метаданные облаков
+	netip.MustParsePrefix("172.16.0.0/12"),   // частная сеть
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("192.88.99.0/24"),  // 6to4 relay anycast
+	netip.MustParsePrefix("192.168.0.0/16"),  // частная сеть
+	netip.MustParsePrefix("198.18.0.0/15"),   // тестирование производительности
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("224.0.0.0/4"),     // multicast
+	netip.MustParsePrefix("240.0.0.0/4"),     // зарезервировано, включая broadcast
+	netip.MustParsePrefix("::/128"),          // неуказанный адрес
+	netip.MustParsePrefix("::1/128"),         // loopback
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // локальный NAT64
+	netip.MustParsePrefix("100::/64"),        // discard-only
+	netip.MustParsePrefix("2001::/23"),       // IETF protocol assignments, в том числе Teredo
+	netip.MustParsePrefix("2001:db8::/32"),   // документация
+	netip.MustParsePrefix("2002::/16"),       // 6to4
+	netip.MustParsePrefix("3fff::/20"),       // документация
+	netip.MustParsePrefix("5f00::/16"),       // SRv6 SID
+	netip.MustParsePrefix("fc00::/7"),        // unique local
+	netip.MustParsePrefix("fe80::/10"),       // link-local
+	netip.MustParsePrefix("fec0::/10"),       // site-local
+	netip.MustParsePrefix("ff00::/8"),        // multicast
+}
+
+// isPublicAddr сообщает, можно ли отправить вебхук на адрес. IPv4-mapped адреса проверяются как IPv4,
+// чтобы ::ffff:127.0.0.1 не обходил запрет на loopback.
+func isPublicAddr(addr netip.Addr) bool {
+	if !addr.IsValid() || addr.Zone() != "" {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range webhookDeniedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// RunWebhookDelivery периодически отправляет события из исходящего журнала на адреса вебхуков.
+func (s *service) RunWebhookDelivery(ctx context.Context) {
+	if s.webhookCfg.PollInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.webhookCfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.deliverWebhooks(ctx); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Msg("failed to deliver webhooks")
+			}
+		}
+	}
+}
+
+// RunWebhookMaintenance периодически отмечает документы, эмбеддинги которых не посчитаны за ProcessingTimeout,
+// как необработанные (событие document.failed) и удаляет события старше срока хранения.
+func (s *service) RunWebhookMaintenance(ctx context.Context) {
+	if s.webhookCfg.MaintenanceInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.webhookCfg.MaintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.maintainWebhooks(ctx); err != nil && ctx.Err() == nil {
+				s.log.Err(err).Msg("failed to maintain webhooks")
+			}
+		}
+	}
+}
+
+func (s *service) deliverWebhooks(ctx context.Context) error {
+	for {
+		// Пока доставка отправляется, ее следующая попытка отложена; если процесс упадет, она повторится после аренды.
+		leaseUntil := time.Now().Add(2 * s.webhookCfg.Timeout)
+		deliveries, err := s.repo.ClaimWebhookDeliveries(ctx, leaseUntil, int32(s.webhookCfg.BatchSize))
+		if err != nil {
+			return err
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Go(func() {
+				attempt := s.sendWebhook(ctx, delivery)
+				if ctx.Err() != nil {
+					// Отправку прервала остановка сервиса — попытка не считается, доставка повторится после аренды.
+					return
+				}
+				if err := s.repo.RecordWebhookDeliveryAttempt(ctx, delivery.ID, attempt); err != nil {
+					s.log.Err(err).Int64("delivery_id", delivery.ID).Msg("Не удалось сохранить результат доставки вебхука")
+				}
+			})
+		}
+		wg.Wait()
+
+		if len(deliveries) < s.webhookCfg.BatchSize || ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// sendWebhook выполняет одну попытку доставки и решает, что делать дальше:
+// ответ 2xx завершает доставку, иначе она повторяется с экспоненциальной задержкой, пока не кончатся попытки.
+func (s *service) sendWebhook(ctx context.Context, delivery domain.PendingWebhookDelivery) domain.WebhookAttempt {
+	body, err := json.Marshal(webhookPayload{
+		ID:          delivery.Event.ID,
+		Type:        delivery.Event.Type,
+		CreatedAt:   delivery.Event.CreatedAt,
+		WorkspaceID: delivery.WorkspaceID,
+		Data:        delivery.Event.Data,
+	})
+	if err != nil {
+		return s.retryWebhook(delivery, domain.WebhookAttempt{Error: err.Error()})
+	}
+
+	secret, err := s.decryptWebhookSecret(delivery.SecretEncrypted)
+	if err != nil {
+		return s.retryWebhook(delivery, domain.WebhookAttempt{Error: err.Error()})
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return s.retryWebhook(delivery, domain.WebhookAttempt{Error: err.Error()})
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhooksig.EventIDHeader, strconv.FormatInt(delivery.Event.ID, 10))
+	req.Header.Set(webhooksig.EventHeader, delivery.Event.Type)
+	req.Header.Set(webhooksig.DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(webhooksig.TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhooksig.SignatureHeader, webhooksig.Sign(secret, timestamp, body))
+
+	resp, err := s.webhookClient.Do(req)
+	if err != nil {
+		return s.retryWebhook(delivery, domain.WebhookAttempt{Error: err.Error()})
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseBodyLimit))
+	status := int32(resp.StatusCode)
+	attempt := domain.WebhookAttempt{ResponseStatus: &status, ResponseBody: string(bytes.ToValidUTF8(responseBody, nil))}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		attempt.Status = domain.WebhookDeliverySucceeded
+		attempt.NextAttemptAt = time.Now()
+		return attempt
+	}
+	attempt.Error = "unexpected response status " + resp.Status
+	return s.retryWebhook(delivery, attempt)
+}
+
+// retryWebhook планирует следующую попытку доставки или завершает доставку с ошибкой,
+// если это была последняя попытка.
+func (s *service) retryWebhook(delivery domain.PendingWebhookDelivery, attempt domain.WebhookAttempt) domain.WebhookAttempt {
+	attempts := int(delivery.Attempts) + 1
+	if attempts >= s.webhookCfg.MaxAttempts {
+		attempt.Status = domain.WebhookDeliveryFailed
+		attempt.NextAttemptAt = time.Now()
+		s.log.Warn().Int64("delivery_id", delivery.ID).Int64("webhook_id", delivery.WebhookID).Int("attempts", attempts).Str("error", attempt.Error).Msg("Доставка вебхука не удалась")
+		return attempt
+	}
+
+	attempt.Status = domain.WebhookDeliveryPending
+	attempt.NextAttemptAt = time.Now().Add(webhookBackoff(s.webhookCfg, attempts))
+	return attempt
+}
+
+// webhookBackoff возвращает задержку перед следующей попыткой: InitialBackoff, удваивающийся с каждой
+// неудачной попыткой, но не больше MaxBackoff, плюс до 10% случайного разброса, чтобы повторы
+// к одному получателю не шли одновременно.
+func webhookBackoff(cfg *config.WebhookConfig, attempts int) time.Duration {
+	backoff := cfg.InitialBackoff
+	for i := 1; i < attempts && backoff < cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, cfg.MaxBackoff)
+	if backoff > 0 {
+		backoff += rand.N(backoff/10 + 1)
+	}
+	return backoff
+}
+
+func (s *service) maintainWebhooks(ctx context.Context) error {
+	if s.webhookCfg.ProcessingTimeout > 0 {
+		failed, err := s.repo.FailStalledDocumentVersions(ctx, time.Now().Add(-s.webhookCfg.ProcessingTimeout))
+		if err != nil {
+			return err
+		}
+		if len(failed) > 0 {
+			s.log.Warn().Int("documents", len(failed)).Msg("Эмбеддинги документов не посчитаны вовремя, документы отмечены как необработанные")
+		}
+	}
+
+	if s.webhookCfg.Retention > 0 {
+		deleted, err := s.repo.DeleteOldWebhookEvents(ctx, time.Now().Add(-s.webhookCfg.Retention))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			s.log.Info().Int64("events", deleted).Msg("Удалены устаревшие события вебхуков")
+		}
+	}
+	return nil
+}
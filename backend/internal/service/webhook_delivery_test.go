@@ -0,0 +1,119 @@
+package service
+
+import (
+	"backend/internal/config"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	cfg := &config.WebhookConfig{InitialBackoff: time.Second, MaxBackoff: time.Minute}
+
+	tests := []struct {
+		name     string
+		cfg      *config.WebhookConfig
+		attempts int
+		want     time.Duration
+	}{
+		{name: "first attempt", cfg: cfg, attempts: 1, want: time.Second},
+		{name: "no attempts yet", cfg: cfg, attempts: 0, want: time.Second},
+		{name: "doubles", cfg: cfg, attempts: 2, want: 2 * time.Second},
+		{name: "doubles again", cfg: cfg, attempts: 4, want: 8 * time.Second},
+		{name: "capped", cfg: cfg, attempts: 7, want: time.Minute},
+		{name: "many attempts do not overflow", cfg: cfg, attempts: 1000, want: time.Minute},
+		{name: "initial above max", cfg: &config.WebhookConfig{InitialBackoff: time.Hour, MaxBackoff: time.Minute}, attempts: 1, want: time.Minute},
+		{name: "zero backoff", cfg: &config.WebhookConfig{}, attempts: 3, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Разброс случайный, поэтому проверяем диапазон на нескольких вызовах.
+			for range 20 {
+				got := webhookBackoff(tt.cfg, tt.attempts)
+				if got < tt.want || got > tt.want+tt.want/10 {
+					t.Fatalf("webhookBackoff = %v, want within [%v, %v]", got, tt.want, tt.want+tt.want/10)
+				}
+			}
+		})
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"1.1.1.1", true},
+		{"2606:4700:4700::1111", true},
+		{"0.0.0.0", false},
+		{"10.1.2.3", false},
+		{"100.64.0.1", false},
+		{"127.0.0.1", false},
+		{"127.255.255.254", false},
+		{"169.254.169.254", false},
+		{"172.16.0.1", false},
+		{"172.31.255.255", false},
+		{"172.32.0.1", true},
+		{"192.0.2.10", false},
+		{"192.168.1.1", false},
+		{"198.18.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"::ffff:8.8.8.8", true},
+		{"64:ff9b::7f00:1", false},
+		{"2001:db8::1", false},
+		{"2002:7f00:1::", false},
+		{"fc00::1", false},
+		{"fd12:3456::1", false},
+		{"fe80::1", false},
+		{"fe80::1%eth0", false},
+		{"ff02::1", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+
+	if isPublicAddr(netip.Addr{}) {
+		t.Error("isPublicAddr(zero Addr) = true, want false")
+	}
+}
+
+func TestWebhookClientRefusesPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		allow   bool
+		wantErr error
+	}{
+		{name: "private networks denied", wantErr: errWebhookPrivateAddress},
+		{name: "private networks allowed", allow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newWebhookClient(&config.WebhookConfig{Timeout: time.Second, AllowPrivateNetworks: tt.allow})
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
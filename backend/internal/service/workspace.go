@@ -0,0 +1,312 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type WorkspaceService interface {
+	CreateWorkspace(ctx context.Context, userID int64, name string) (*domain.Workspace, error)
+	ListWorkspaces(ctx context.Context, userID int64) ([]domain.Workspace, error)
+	GetWorkspace(ctx context.Context, userID, workspaceID int64) (*domain.Workspace, error)
+	RenameWorkspace(ctx context.Context, userID, workspaceID int64, name string) (*domain.Workspace, error)
+	DeleteWorkspace(ctx context.Context, userID, workspaceID int64) error
+	ListWorkspaceMembers(ctx context.Context, userID, workspaceID int64) ([]domain.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, userID, workspaceID int64, email, role string) (*domain.WorkspaceMember, error)
+	UpdateWorkspaceMemberRole(ctx context.Context, userID, workspaceID, memberID int64, role string) (*domain.WorkspaceMember, error)
+	RemoveWorkspaceMember(ctx context.Context, userID, workspaceID, memberID int64) error
+}
+
+var (
+	ErrWorkspaceNotFound       = errors.New("workspace not found or access denied")
+	ErrWorkspaceForbidden      = errors.New("insufficient workspace role")
+	ErrWorkspaceNameRequired   = errors.New("workspace name is required")
+	ErrPersonalWorkspace       = errors.New("personal workspace cannot be shared or deleted")
+	ErrInvalidWorkspaceRole    = errors.New("invalid workspace role")
+	ErrWorkspaceMemberNotFound = errors.New("workspace member not found")
+	ErrLastWorkspaceOwner      = errors.New("workspace must keep at least one owner")
+	ErrSoleWorkspaceOwner      = errors.New("transfer ownership or delete shared workspaces you solely own before deleting the account")
+)
+
+const personalWorkspaceName = "Personal"
+
+// createUser создает пользователя вместе с его личным рабочим пространством.
+func (s *service) createUser(ctx context.Context, repo repository.Repository, email, passwordHash string) (*domain.User, error) {
+	user, err := repo.CreateUser(ctx, email, passwordHash)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := repo.CreateWorkspace(ctx, personalWorkspaceName, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.UpsertWorkspaceMember(ctx, workspace.ID, user.ID, domain.WorkspaceRoleOwner); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// resolveWorkspace возвращает рабочее пространство пользователя по ID,
+// а если ID не передан — его личное пространство.
+func (s *service) resolveWorkspace(ctx context.Context, userID int64, workspaceID *int64) (*domain.Workspace, error) {
+	if workspaceID == nil {
+		workspace, err := s.repo.GetPersonalWorkspace(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrWorkspaceNotFound
+			}
+			return nil, err
+		}
+		return workspace, nil
+	}
+	return s.GetWorkspace(ctx, userID, *workspaceID)
+}
+
+func (s *service) CreateWorkspace(ctx context.Context, userID int64, name string) (*domain.Workspace, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrWorkspaceNameRequired
+	}
+
+	var workspace *domain.Workspace
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		createdWorkspace, err := repo.CreateWorkspace(ctx, name, 0)
+		if err != nil {
+			return err
+		}
+		if err := repo.UpsertWorkspaceMember(ctx, createdWorkspace.ID, userID, domain.WorkspaceRoleOwner); err != nil {
+			return err
+		}
+
+		workspace = createdWorkspace
+		workspace.Role = domain.WorkspaceRoleOwner
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info().Int64("user_id", userID).Int64("workspace_id", workspace.ID).Msg("Рабочее пространство создано")
+	return workspace, nil
+}
+
+func (s *service) ListWorkspaces(ctx context.Context, userID int64) ([]domain.Workspace, error) {
+	return s.repo.GetUserWorkspaces(ctx, userID)
+}
+
+func (s *service) GetWorkspace(ctx context.Context, userID, workspaceID int64) (*domain.Workspace, error) {
+	workspace, err := s.repo.GetUserWorkspaceByID(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWorkspaceNotFound
+		}
+		return nil, err
+	}
+	return workspace, nil
+}
+
+func (s *service) RenameWorkspace(ctx context.Context, userID, workspaceID int64, name string) (*domain.Workspace, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrWorkspaceNameRequired
+	}
+
+	workspace, err := s.requireWorkspaceOwner(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RenameWorkspace(ctx, workspaceID, name); err != nil {
+		return nil, err
+	}
+
+	workspace.Name = name
+	return workspace, nil
+}
+
+func (s *service) DeleteWorkspace(ctx context.Context, userID, workspaceID int64) error {
+	workspace, err := s.requireWorkspaceOwner(ctx, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.Personal {
+		return ErrPersonalWorkspace
+	}
+
+	var blobKeys []string
+	err = s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		blobIDs, err := repo.GetWorkspaceBlobIDs(ctx, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.DeleteWorkspace(ctx, workspaceID); err != nil {
+			return err
+		}
+
+		blobKeys, err = repo.DeleteOrphanBlobs(ctx, blobIDs)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobObjects(ctx, blobKeys)
+
+	s.log.Info().Int64("user_id", userID).Int64("workspace_id", workspaceID).Msg("Рабочее пространство удалено")
+	return nil
+}
+
+func (s *service) ListWorkspaceMembers(ctx context.Context, userID, workspaceID int64) ([]domain.WorkspaceMember, error) {
+	if _, err := s.GetWorkspace(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWorkspaceMembers(ctx, workspaceID)
+}
+
+func (s *service) AddWorkspaceMember(ctx context.Context, userID, workspaceID int64, email, role string) (*domain.WorkspaceMember, error) {
+	if !isValidWorkspaceRole(role) {
+		return nil, ErrInvalidWorkspaceRole
+	}
+
+	workspace, err := s.requireWorkspaceOwner(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.Personal {
+		return nil, ErrPersonalWorkspace
+	}
+
+	member, _, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return s.setWorkspaceMemberRole(ctx, workspaceID, member.ID, role)
+}
+
+func (s *service) UpdateWorkspaceMemberRole(ctx context.Context, userID, workspaceID, memberID int64, role string) (*domain.WorkspaceMember, error) {
+	if !isValidWorkspaceRole(role) {
+		return nil, ErrInvalidWorkspaceRole
+	}
+
+	workspace, err := s.requireWorkspaceOwner(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.Personal {
+		return nil, ErrPersonalWorkspace
+	}
+
+	if _, err := s.repo.GetUserWorkspaceByID(ctx, workspaceID, memberID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWorkspaceMemberNotFound
+		}
+		return nil, err
+	}
+
+	return s.setWorkspaceMemberRole(ctx, workspaceID, memberID, role)
+}
+
+func (s *service) RemoveWorkspaceMember(ctx context.Context, userID, workspaceID, memberID int64) error {
+	workspace, err := s.GetWorkspace(ctx, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.Personal {
+		return ErrPersonalWorkspace
+	}
+	// Участник может покинуть пространство сам, остальных удаляет только владелец.
+	if memberID != userID && workspace.Role != domain.WorkspaceRoleOwner {
+		return ErrWorkspaceForbidden
+	}
+
+	return s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := repo.LockWorkspace(ctx, workspaceID); err != nil {
+			return err
+		}
+
+		deleted, err := repo.DeleteWorkspaceMember(ctx, workspaceID, memberID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return ErrWorkspaceMemberNotFound
+		}
+
+		owners, err := repo.CountWorkspaceOwners(ctx, workspaceID)
+		if err != nil {
+			return err
+		}
+		if owners == 0 {
+			return ErrLastWorkspaceOwner
+		}
+
+		return nil
+	})
+}
+
+// setWorkspaceMemberRole назначает роль участнику, не позволяя оставить пространство без владельца.
+func (s *service) setWorkspaceMemberRole(ctx context.Context, workspaceID, memberID int64, role string) (*domain.WorkspaceMember, error) {
+	err := s.repo.WithTransaction(ctx, func(repo repository.Repository) error {
+		if err := repo.LockWorkspace(ctx, workspaceID); err != nil {
+			return err
+		}
+		if err := repo.UpsertWorkspaceMember(ctx, workspaceID, memberID, role); err != nil {
+			return err
+		}
+
+		owners, err := repo.CountWorkspaceOwners(ctx, workspaceID)
+		if err != nil {
+			return err
+		}
+		if owners == 0 {
+			return ErrLastWorkspaceOwner
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.GetWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.UserID == memberID {
+			return &m, nil
+		}
+	}
+	return nil, ErrWorkspaceMemberNotFound
+}
+
+func (s *service) requireWorkspaceOwner(ctx context.Context, userID, workspaceID int64) (*domain.Workspace, error) {
+	workspace, err := s.GetWorkspace(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.Role != domain.WorkspaceRoleOwner {
+		return nil, ErrWorkspaceForbidden
+	}
+	return workspace, nil
+}
+
+func isValidWorkspaceRole(role string) bool {
+	switch role {
+	case domain.WorkspaceRoleOwner, domain.WorkspaceRoleEditor, domain.WorkspaceRoleViewer:
+		return true
+	}
+	return false
+}
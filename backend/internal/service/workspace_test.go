@@ -0,0 +1,267 @@
+package service
+
+import (
+	"backend/internal/domain"
+	"backend/internal/repository"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// workspaceRepository хранит участников пространств в памяти.
+type workspaceRepository struct {
+	fakeRepository
+	personal map[int64]bool
+	members  map[int64]map[int64]string
+	users    map[string]int64
+}
+
+func (r *workspaceRepository) WithTransaction(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return fn(r)
+}
+
+func (r *workspaceRepository) GetUserWorkspaceByID(ctx context.Context, id, userID int64) (*domain.Workspace, error) {
+	role, ok := r.members[id][userID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return &domain.Workspace{ID: id, Personal: r.personal[id], Role: role}, nil
+}
+
+func (r *workspaceRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, string, error) {
+	id, ok := r.users[email]
+	if !ok {
+		return nil, "", pgx.ErrNoRows
+	}
+	return &domain.User{ID: id, Email: email}, "", nil
+}
+
+func (r *workspaceRepository) RenameWorkspace(ctx context.Context, id int64, name string) error {
+	return nil
+}
+
+func (r *workspaceRepository) LockWorkspace(ctx context.Context, workspaceID int64) error {
+	return nil
+}
+
+func (r *workspaceRepository) UpsertWorkspaceMember(ctx context.Context, workspaceID, userID int64, role string) error {
+	r.members[workspaceID][userID] = role
+	return nil
+}
+
+func (r *workspaceRepository) DeleteWorkspaceMember(ctx context.Context, workspaceID, userID int64) (bool, error) {
+	if _, ok := r.members[workspaceID][userID]; !ok {
+		return false, nil
+	}
+	delete(r.members[workspaceID], userID)
+	return true, nil
+}
+
+func (r *workspaceRepository) CountWorkspaceOwners(ctx context.Context, workspaceID int64) (int64, error) {
+	var owners int64
+	for _, role := range r.members[workspaceID] {
+		if role == domain.WorkspaceRoleOwner {
+			owners++
+		}
+	}
+	return owners, nil
+}
+
+func (r *workspaceRepository) GetWorkspaceMembers(ctx context.Context, workspaceID int64) ([]domain.WorkspaceMember, error) {
+	var members []domain.WorkspaceMember
+	for userID, role := range r.members[workspaceID] {
+		members = append(members, domain.WorkspaceMember{UserID: userID, Role: role})
+	}
+	return members, nil
+}
+
+func TestWorkspaceAuthorization(t *testing.T) {
+	const (
+		sharedID   int64 = 1
+		personalID int64 = 2
+
+		owner    int64 = 1
+		editor   int64 = 2
+		viewer   int64 = 3
+		stranger int64 = 4
+	)
+
+	tests := []struct {
+		name    string
+		op      func(ctx context.Context, s *service) error
+		wantErr error
+		// wantRole — роль участника target в общем пространстве после операции; "" — участника нет.
+		target   int64
+		wantRole string
+	}{
+		{
+			name: "owner renames",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.RenameWorkspace(ctx, owner, sharedID, "Team")
+				return err
+			},
+		},
+		{
+			name: "editor cannot rename",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.RenameWorkspace(ctx, editor, sharedID, "Team")
+				return err
+			},
+			wantErr: ErrWorkspaceForbidden,
+		},
+		{
+			name: "stranger does not see the workspace",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.RenameWorkspace(ctx, stranger, sharedID, "Team")
+				return err
+			},
+			wantErr: ErrWorkspaceNotFound,
+		},
+		{
+			name: "owner adds member",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.AddWorkspaceMember(ctx, owner, sharedID, "stranger@example.com", domain.WorkspaceRoleViewer)
+				return err
+			},
+			target:   stranger,
+			wantRole: domain.WorkspaceRoleViewer,
+		},
+		{
+			name: "editor cannot add member",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.AddWorkspaceMember(ctx, editor, sharedID, "stranger@example.com", domain.WorkspaceRoleViewer)
+				return err
+			},
+			wantErr: ErrWorkspaceForbidden,
+			target:  stranger,
+		},
+		{
+			name: "unknown role",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.AddWorkspaceMember(ctx, owner, sharedID, "stranger@example.com", "admin")
+				return err
+			},
+			wantErr: ErrInvalidWorkspaceRole,
+			target:  stranger,
+		},
+		{
+			name: "personal workspace cannot be shared",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.AddWorkspaceMember(ctx, owner, personalID, "stranger@example.com", domain.WorkspaceRoleViewer)
+				return err
+			},
+			wantErr: ErrPersonalWorkspace,
+		},
+		{
+			name: "owner promotes editor",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.UpdateWorkspaceMemberRole(ctx, owner, sharedID, editor, domain.WorkspaceRoleOwner)
+				return err
+			},
+			target:   editor,
+			wantRole: domain.WorkspaceRoleOwner,
+		},
+		{
+			name: "viewer cannot promote self",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.UpdateWorkspaceMemberRole(ctx, viewer, sharedID, viewer, domain.WorkspaceRoleOwner)
+				return err
+			},
+			wantErr:  ErrWorkspaceForbidden,
+			target:   viewer,
+			wantRole: domain.WorkspaceRoleViewer,
+		},
+		{
+			name: "role of non-member",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.UpdateWorkspaceMemberRole(ctx, owner, sharedID, stranger, domain.WorkspaceRoleEditor)
+				return err
+			},
+			wantErr: ErrWorkspaceMemberNotFound,
+			target:  stranger,
+		},
+		{
+			name: "sole owner cannot step down",
+			op: func(ctx context.Context, s *service) error {
+				_, err := s.UpdateWorkspaceMemberRole(ctx, owner, sharedID, owner, domain.WorkspaceRoleEditor)
+				return err
+			},
+			wantErr: ErrLastWorkspaceOwner,
+		},
+		{
+			name: "viewer leaves",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, viewer, sharedID, viewer)
+			},
+			target: viewer,
+		},
+		{
+			name: "editor cannot remove others",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, editor, sharedID, viewer)
+			},
+			wantErr:  ErrWorkspaceForbidden,
+			target:   viewer,
+			wantRole: domain.WorkspaceRoleViewer,
+		},
+		{
+			name: "owner removes editor",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, owner, sharedID, editor)
+			},
+			target: editor,
+		},
+		{
+			name: "remove non-member",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, owner, sharedID, stranger)
+			},
+			wantErr: ErrWorkspaceMemberNotFound,
+			target:  stranger,
+		},
+		{
+			name: "sole owner cannot leave",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, owner, sharedID, owner)
+			},
+			wantErr: ErrLastWorkspaceOwner,
+		},
+		{
+			name: "personal workspace cannot be left",
+			op: func(ctx context.Context, s *service) error {
+				return s.RemoveWorkspaceMember(ctx, owner, personalID, owner)
+			},
+			wantErr: ErrPersonalWorkspace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &workspaceRepository{
+				personal: map[int64]bool{personalID: true},
+				members: map[int64]map[int64]string{
+					sharedID: {
+						owner:  domain.WorkspaceRoleOwner,
+						editor: domain.WorkspaceRoleEditor,
+						viewer: domain.WorkspaceRoleViewer,
+					},
+					personalID: {owner: domain.WorkspaceRoleOwner},
+				},
+				users: map[string]int64{"stranger@example.com": stranger},
+			}
+			s := newTestService(repo)
+
+			err := tt.op(context.Background(), s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.target != 0 {
+				if role := repo.members[sharedID][tt.target]; role != tt.wantRole {
+					t.Errorf("role of user %d = %q, want %q", tt.target, role, tt.wantRole)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret — ключ SHA-1 из тестовых векторов RFC 6238 ("12345678901234567890").
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestValidateRFCVectors(t *testing.T) {
+	// Коды — младшие шесть цифр восьмизначных значений из приложения B RFC 6238.
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		step, ok := Validate(rfcSecret, tt.code, time.Unix(tt.unix, 0), 0)
+		if !ok {
+			t.Errorf("Validate(%q at %d) = false, want true", tt.code, tt.unix)
+			continue
+		}
+		if want := tt.unix / Period; step != want {
+			t.Errorf("Validate(%q at %d) step = %d, want %d", tt.code, tt.unix, step, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := rfcSecret
+	key := []byte("12345678901234567890")
+
+	now := time.Unix(1_700_000_000, 0)
+	current := now.Unix() / Period
+	codeAt := func(offset int64) string {
+		return generate(key, uint64(current+offset))
+	}
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		skew     int
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", secret: secret, code: codeAt(0), skew: 1, wantOK: true, wantStep: current},
+		{name: "previous step within skew", secret: secret, code: codeAt(-1), skew: 1, wantOK: true, wantStep: current - 1},
+		{name: "next step within skew", secret: secret, code: codeAt(1), skew: 1, wantOK: true, wantStep: current + 1},
+		{name: "step outside skew", secret: secret, code: codeAt(-2), skew: 1},
+		{name: "previous step without skew", secret: secret, code: codeAt(-1), skew: 0},
+		{name: "spaces are ignored", secret: secret, code: codeAt(0)[:3] + " " + codeAt(0)[3:], wantOK: true, wantStep: current},
+		{name: "lowercase secret", secret: strings.ToLower(secret), code: codeAt(0), wantOK: true, wantStep: current},
+		{name: "short code", secret: secret, code: codeAt(0)[:Digits-1]},
+		{name: "long code", secret: secret, code: codeAt(0) + "0"},
+		{name: "invalid secret", secret: "not base32!", code: codeAt(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(tt.secret, tt.code, now, tt.skew)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != tt.wantStep {
+				t.Errorf("Validate step = %d, want %d", step, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestValidateRejectsWrongCode(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	code := generate([]byte("12345678901234567890"), uint64(now.Unix()/Period))
+
+	// Код другого секрета не подходит, даже с допуском по времени.
+	other := encoding.EncodeToString([]byte("09876543210987654321"))
+	if _, ok := Validate(other, code, now, 1); ok {
+		t.Error("code of another secret is accepted")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Docs", "user@example.com", rfcSecret)
+
+	for _, part := range []string{
+		"otpauth://totp/Docs:user@example.com?",
+		"secret=" + rfcSecret,
+		"issuer=Docs",
+		"digits=6",
+		"period=30",
+	} {
+		if !strings.Contains(uri, part) {
+			t.Errorf("URI %q does not contain %q", uri, part)
+		}
+	}
+}
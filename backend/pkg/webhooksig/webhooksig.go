@@ -0,0 +1,68 @@
+// Package webhooksig подписывает и проверяет тела запросов исходящих вебхуков.
+//
+// Подпись — HMAC-SHA256 секрета вебхука от строки "<timestamp>.<body>", где timestamp — значение
+// заголовка X-Webhook-Timestamp (Unix-время в секундах). В заголовке X-Webhook-Signature она передается
+// как "sha256=" и hex. Время входит в подпись, чтобы перехваченный запрос нельзя было повторить позже.
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	EventIDHeader   = "X-Webhook-ID"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidTimestamp = errors.New("invalid or expired webhook timestamp")
+)
+
+// Sign возвращает значение заголовка X-Webhook-Signature для тела запроса.
+func Sign(secret string, timestamp int64, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp, 10), body))
+}
+
+// Verify проверяет подпись тела и что время подписи отличается от now не больше чем на tolerance.
+// Нулевой tolerance отключает проверку времени.
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if tolerance > 0 {
+		if diff := now.Sub(time.Unix(unix, 0)); diff > tolerance || diff < -tolerance {
+			return ErrInvalidTimestamp
+		}
+	}
+
+	encoded, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return ErrInvalidSignature
+	}
+	sum, err := hex.DecodeString(encoded)
+	if err != nil || !hmac.Equal(sum, mac(secret, timestamp, body)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte{'.'})
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,60 @@
+package webhooksig
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// Эталон посчитан независимо: printf '1700000000.{"id":1}' | openssl dgst -sha256 -hmac secret
+	const want = "sha256=3dd1b9aef568d75f6790a84bd2e5dfa1f44409eef3cbdbd3f10b837376100c11"
+	if got := Sign("secret", 1700000000, []byte(`{"id":1}`)); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const secret = "secret"
+	body := []byte(`{"id":1,"type":"document.ready"}`)
+	now := time.Unix(1_700_000_000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signature := Sign(secret, now.Unix(), body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		tolerance time.Duration
+		now       time.Time
+		wantErr   error
+	}{
+		{name: "valid", secret: secret, timestamp: timestamp, signature: signature, body: body, tolerance: 5 * time.Minute, now: now},
+		{name: "within tolerance", secret: secret, timestamp: timestamp, signature: signature, body: body, tolerance: 5 * time.Minute, now: now.Add(5 * time.Minute)},
+		{name: "clock behind within tolerance", secret: secret, timestamp: timestamp, signature: signature, body: body, tolerance: 5 * time.Minute, now: now.Add(-5 * time.Minute)},
+		{name: "tolerance disabled", secret: secret, timestamp: timestamp, signature: signature, body: body, now: now.Add(24 * time.Hour)},
+		{name: "expired", secret: secret, timestamp: timestamp, signature: signature, body: body, tolerance: 5 * time.Minute, now: now.Add(5*time.Minute + time.Second), wantErr: ErrInvalidTimestamp},
+		{name: "from the future", secret: secret, timestamp: timestamp, signature: signature, body: body, tolerance: 5 * time.Minute, now: now.Add(-6 * time.Minute), wantErr: ErrInvalidTimestamp},
+		{name: "timestamp is not a number", secret: secret, timestamp: "yesterday", signature: signature, body: body, now: now, wantErr: ErrInvalidTimestamp},
+		{name: "other timestamp", secret: secret, timestamp: strconv.FormatInt(now.Unix()+1, 10), signature: signature, body: body, tolerance: 5 * time.Minute, now: now, wantErr: ErrInvalidSignature},
+		{name: "other secret", secret: "other", timestamp: timestamp, signature: signature, body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "tampered body", secret: secret, timestamp: timestamp, signature: signature, body: []byte(`{"id":2,"type":"document.ready"}`), now: now, wantErr: ErrInvalidSignature},
+		{name: "missing prefix", secret: secret, timestamp: timestamp, signature: strings.TrimPrefix(signature, "sha256="), body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "not hex", secret: secret, timestamp: timestamp, signature: "sha256=zz", body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "truncated", secret: secret, timestamp: timestamp, signature: signature[:len(signature)-2], body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "empty", secret: secret, timestamp: timestamp, signature: "", body: body, now: now, wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.timestamp, tt.signature, tt.body, tt.tolerance, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}